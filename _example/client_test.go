@@ -3,8 +3,18 @@ package eudore_test
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,7 +23,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	. "github.com/eudore/eudore"
@@ -245,6 +258,792 @@ func TestClientHooks(t *testing.T) {
 	app.Run()
 }
 
+func TestClientHookBreaker(t *testing.T) {
+	app := NewApp()
+	client := NewClientCustom(NewClientHookBreaker(2, 2, 20*time.Millisecond, nil))
+	app.SetValue(ContextKeyClient, client)
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+
+	var status int64 = StatusServiceUnavailable
+	app.GetFunc("/status", func(ctx Context) {
+		ctx.WriteHeader(int(atomic.LoadInt64(&status)))
+	})
+
+	state := func(s string) {
+		meta := client.(interface{ Metadata() any }).Metadata().(MetadataClient)
+		if len(meta.Breakers) != 1 || meta.Breakers[0].State != s {
+			t.Error("breaker state", meta.Breakers, s)
+		}
+	}
+	isOpen := func(err error) {
+		var berr *ClientBreakerError
+		if !errors.Is(err, ErrClientBreakerOpen) || !errors.As(err, &berr) {
+			t.Error("breaker error", err)
+		} else if berr.Key != DefaultClientInternalHost {
+			t.Error("breaker key", berr.Key)
+		}
+	}
+
+	// closed -> open
+	app.GetRequest("/status")
+	state("closed")
+	app.GetRequest("/status")
+	state("open")
+	isOpen(app.GetRequest("/status"))
+
+	// half-open -> open
+	time.Sleep(30 * time.Millisecond)
+	app.GetRequest("/status")
+	state("open")
+	isOpen(app.GetRequest("/status"))
+
+	// half-open -> closed
+	time.Sleep(30 * time.Millisecond)
+	atomic.StoreInt64(&status, StatusOK)
+	app.GetRequest("/status")
+	state("half-open")
+	app.GetRequest("/status")
+	state("closed")
+	if err := app.GetRequest("/status"); err != nil {
+		t.Error(err)
+	}
+
+	app.GetRequest("/status", NewClientHookBreaker(0, 0, 0,
+		func(*http.Request) string { return "" },
+	))
+
+	// open wait starts when the slow request fails
+	app.GetFunc("/slow", func(ctx Context) {
+		time.Sleep(30 * time.Millisecond)
+		ctx.WriteHeader(StatusServiceUnavailable)
+	})
+	slow := NewClientHookBreaker(1, 1, 20*time.Millisecond, nil)
+	app.GetRequest("/slow", slow)
+	isOpen(app.GetRequest("/slow", slow))
+
+	app.CancelFunc()
+	app.Run()
+}
+
+type clientTransportHosts struct {
+	sync.Mutex
+	Hosts  []string
+	Status map[string]int
+}
+
+func (tp *clientTransportHosts) RoundTrip(req *http.Request) (*http.Response, error) {
+	tp.Lock()
+	tp.Hosts = append(tp.Hosts, req.URL.Host)
+	tp.Unlock()
+	if req.URL.Host == "error" {
+		return nil, io.ErrUnexpectedEOF
+	}
+	status := GetAnyDefault(tp.Status[req.URL.Host], StatusOK)
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+func (tp *clientTransportHosts) Reset() []string {
+	tp.Lock()
+	defer tp.Unlock()
+	hosts := tp.Hosts
+	tp.Hosts = nil
+	return hosts
+}
+
+func TestClientHookBalancer(t *testing.T) {
+	tp := &clientTransportHosts{Status: map[string]int{"c": StatusBadGateway}}
+	resolver := NewClientResolverStatic(map[string][]string{
+		"svc":   {"a", "b", "c"},
+		"error": {"error"},
+	})
+	check := func(hosts []string, want string) {
+		if strings.Join(hosts, ",") != want {
+			t.Error("balancer hosts", hosts, want)
+		}
+	}
+
+	// round-robin and passive eject
+	client := NewClientCustom(tp, NewClientHookBalancer(resolver, "", nil))
+	for i := 0; i < 12; i++ {
+		client.GetRequest("http://svc/")
+	}
+	client.GetRequest("http://other/")
+	check(tp.Reset(), "a,b,c,a,b,c,a,b,c,a,b,a,other")
+
+	// least
+	client = NewClientCustom(tp, NewClientHookBalancer(resolver, "least", nil))
+	client.GetRequest("http://svc/")
+	client.GetRequest("http://svc/")
+	check(tp.Reset(), "a,b")
+
+	// hash
+	client = NewClientCustom(tp, NewClientHookBalancer(resolver, "hash", nil))
+	for i := 0; i < 4; i++ {
+		client.GetRequest("http://svc/hash")
+	}
+	hosts := tp.Reset()
+	if hosts[0] != hosts[1] || hosts[0] != hosts[2] || hosts[0] != hosts[3] {
+		t.Error("balancer hash", hosts)
+	}
+
+	// retry to different endpoints
+	tp.Status = map[string]int{"a": StatusServiceUnavailable, "b": StatusServiceUnavailable}
+	client = NewClientCustom(tp,
+		NewClientHookBalancer(resolver, "", nil),
+		NewClientHookRetry(2, []time.Duration{0, 0}, nil),
+	)
+	client.GetRequest("http://svc/", NewClientOptionHost("svc.local"))
+	check(tp.Reset(), "a,b,c")
+	client.GetRequest("http://error/")
+	check(tp.Reset(), "error,error,error,error")
+
+	// resolver
+	client = NewClientCustom(tp, NewClientHookBalancer(
+		func(context.Context, string) ([]string, error) {
+			return nil, io.EOF
+		}, "", nil,
+	))
+	if err := client.GetRequest("http://svc/"); !errors.Is(err, io.EOF) {
+		t.Error("balancer resolver", err)
+	}
+	srv := NewClientResolverSRV(time.Second)
+	hosts, err := srv(context.Background(), "svc")
+	if hosts != nil || err != nil {
+		t.Error("balancer srv", hosts, err)
+	}
+}
+
+type clientTransportCache struct {
+	Count int
+	Body  *clientCacheBodyClose
+}
+
+type clientCacheBodyClose struct {
+	io.Reader
+	Closed bool
+}
+
+func (b *clientCacheBodyClose) Close() error {
+	b.Closed = true
+	return nil
+}
+
+func (tp *clientTransportCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	tp.Count++
+	return &http.Response{
+		StatusCode: StatusOK,
+		Header:     http.Header{HeaderCacheControl: {"max-age=60"}},
+		Body:       tp.Body,
+		Request:    req,
+	}, nil
+}
+
+func TestClientHookCache(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	var count, status int64
+	app.AddMiddleware(func(ctx Context) {
+		atomic.AddInt64(&count, 1)
+	})
+	app.GetFunc("/fresh", func(ctx Context) {
+		ctx.SetHeader(HeaderCacheControl, "max-age=60")
+		ctx.WriteString("fresh")
+	})
+	app.GetFunc("/etag", func(ctx Context) {
+		ctx.SetHeader(HeaderCacheControl, "no-cache")
+		ctx.SetHeader(HeaderETag, `"v1"`)
+		if ctx.GetHeader(HeaderIfNoneMatch) == `"v1"` {
+			ctx.WriteHeader(StatusNotModified)
+			return
+		}
+		ctx.WriteString("etag")
+	})
+	app.GetFunc("/vary", func(ctx Context) {
+		ctx.SetHeader(HeaderCacheControl, "max-age=60")
+		ctx.SetHeader(HeaderVary, HeaderAcceptLanguage)
+		ctx.WriteString(ctx.GetHeader(HeaderAcceptLanguage))
+	})
+	app.GetFunc("/stale", func(ctx Context) {
+		if atomic.LoadInt64(&status) != 0 {
+			ctx.WriteHeader(StatusServiceUnavailable)
+			return
+		}
+		ctx.SetHeader(HeaderCacheControl, "max-age=0, stale-if-error=60")
+		ctx.SetHeader(HeaderLastModified, "Mon, 02 Jan 2006 15:04:05 GMT")
+		ctx.WriteString("stale")
+	})
+	app.GetFunc("/nostore", func(ctx Context) {
+		ctx.SetHeader(HeaderCacheControl, "no-store")
+		ctx.WriteString("nostore")
+	})
+	app.PostFunc("/fresh", HandlerEmpty)
+	app.AnyFunc("/*", HandlerEmpty)
+
+	body := func(want string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			data, _ := io.ReadAll(resp.Body)
+			if string(data) != want {
+				t.Error("cache body", resp.Request.URL.Path, string(data), want)
+			}
+			return nil
+		}
+	}
+	hits := func(path string, want int64) {
+		if n := atomic.SwapInt64(&count, 0); n != want {
+			t.Error("cache hits", path, n, want)
+		}
+	}
+
+	for _, store := range []ClientCacheStore{nil, NewClientCacheStoreFile(t.TempDir())} {
+		app.SetValue(ContextKeyClient, NewClientCustom(NewClientHookCache(store)))
+		for i := 0; i < 3; i++ {
+			app.GetRequest("/fresh", body("fresh"))
+		}
+		hits("/fresh", 1)
+		app.GetRequest("/fresh", http.Header{HeaderCacheControl: {"no-cache"}}, body("fresh"))
+		app.GetRequest("/fresh", http.Header{HeaderCacheControl: {"no-store"}}, body("fresh"))
+		app.PostRequest("/fresh")
+		app.GetRequest("/fresh", body("fresh"))
+		hits("/fresh", 4)
+
+		app.GetRequest("/etag", body("etag"))
+		app.GetRequest("/etag", body("etag"))
+		hits("/etag", 2)
+
+		app.GetRequest("/vary", http.Header{HeaderAcceptLanguage: {"zh"}}, body("zh"))
+		app.GetRequest("/vary", http.Header{HeaderAcceptLanguage: {"zh"}}, body("zh"))
+		app.GetRequest("/vary", http.Header{HeaderAcceptLanguage: {"en"}}, body("en"))
+		hits("/vary", 2)
+
+		atomic.StoreInt64(&status, 0)
+		app.GetRequest("/stale", body("stale"))
+		atomic.StoreInt64(&status, 1)
+		app.GetRequest("/stale", body("stale"))
+		hits("/stale", 2)
+
+		app.GetRequest("/nostore", body("nostore"))
+		app.GetRequest("/nostore", body("nostore"))
+		app.GetRequest("/nocache", body(""))
+		hits("/nostore", 3)
+	}
+
+	// body read error and oversize body are not cached
+	tp := &clientTransportCache{}
+	rt := NewClientHookCache(nil).Wrap(tp)
+	for i := 0; i < 2; i++ {
+		tp.Body = &clientCacheBodyClose{Reader: io.MultiReader(
+			strings.NewReader("error"), iotest.ErrReader(io.ErrUnexpectedEOF),
+		)}
+		req, _ := http.NewRequest(MethodGet, "http://cache/error", nil)
+		resp, err := rt.RoundTrip(req)
+		if resp != nil || err != io.ErrUnexpectedEOF || !tp.Body.Closed {
+			t.Error("cache body error", resp, err, tp.Body.Closed)
+		}
+	}
+	client := NewClientCustom(tp, NewClientHookCache(nil))
+	defer func(limit int64) {
+		DefaultClientCacheBodyLimit = limit
+	}(DefaultClientCacheBodyLimit)
+	DefaultClientCacheBodyLimit = 4
+	for i := 0; i < 2; i++ {
+		tp.Body = &clientCacheBodyClose{Reader: strings.NewReader("oversize")}
+		err := client.GetRequest("http://cache/oversize", body("oversize"))
+		if err != nil {
+			t.Error("cache body oversize", err)
+		}
+	}
+	if tp.Count != 4 {
+		t.Error("cache body round trip", tp.Count)
+	}
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestClientHookRecorder(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.AnyFunc("/record/*", func(ctx Context) {
+		ctx.SetHeader(HeaderSetCookie, "session=secret")
+		ctx.WriteString(ctx.Method() + " " + ctx.Path() + " " + ctx.GetHeader(HeaderAcceptLanguage))
+		body, _ := ctx.Body()
+		ctx.Write(body)
+	})
+	body := func(want string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			data, _ := io.ReadAll(resp.Body)
+			if string(data) != want {
+				t.Error("recorder body", string(data), want)
+			}
+			return nil
+		}
+	}
+	offline := &clientTransportHosts{}
+	dir := t.TempDir()
+	for _, path := range []string{dir + "/cassette.json", dir + "/cassette.har"} {
+		// record
+		client := app.NewClient(NewClientHookRecorder(path, ClientRecordModeRecord,
+			NewClientRecordMatcherHeader(HeaderAcceptLanguage),
+			NewClientRecordMatcherBody(),
+		))
+		client.GetRequest("/record/1", http.Header{HeaderAuthorization: {"Bearer token"}})
+		client.GetRequest("/record/1", http.Header{HeaderAcceptLanguage: {"zh"}})
+		client.PostRequest("/record/2", strings.NewReader("body"))
+		client.PostRequest("/record/2", bytes.NewReader([]byte{0xff, 0xfe}))
+
+		data, _ := os.ReadFile(path)
+		if bytes.Contains(data, []byte("token")) || bytes.Contains(data, []byte("secret")) {
+			t.Error("recorder redact", string(data))
+		}
+
+		// replay
+		client = NewClientCustom(offline, NewClientHookRecorder(path, ClientRecordModeReplay,
+			NewClientRecordMatcherHeader(HeaderAcceptLanguage),
+			NewClientRecordMatcherBody(),
+		))
+		host := "http://" + DefaultClientInternalHost
+		client.GetRequest(host+"/record/1", body("GET /record/1 "))
+		client.GetRequest(host+"/record/1", http.Header{HeaderAcceptLanguage: {"zh"}}, body("GET /record/1 zh"))
+		client.PostRequest(host+"/record/2", strings.NewReader("body"), body("POST /record/2 body"))
+		client.PostRequest(host+"/record/2", bytes.NewReader([]byte{0xff, 0xfe}), body("POST /record/2 \xff\xfe"))
+		err := client.GetRequest(host + "/record/3")
+		if err == nil || !strings.Contains(err.Error(), "not found record") {
+			t.Error("recorder not found", err)
+		}
+
+		// auto
+		client = app.NewClient(NewClientHookRecorder(path, ""))
+		client.GetRequest("/record/1", body("GET /record/1 "))
+		client.GetRequest("/record/4", body("GET /record/4 "))
+	}
+	if len(offline.Reset()) != 0 {
+		t.Error("recorder replay send request")
+	}
+
+	// read body error closes the body, and save error is only logged
+	req, _ := http.NewRequest(MethodGet, "http://localhost/record/body", nil)
+	rbody := &clientBodyError{}
+	tp := &clientTransportBody{Body: rbody}
+	resp, err := NewClientHookRecorder(dir+"/body.json", ClientRecordModeRecord).
+		Wrap(tp).RoundTrip(req)
+	if resp != nil || !errors.Is(err, io.ErrUnexpectedEOF) || !rbody.Closed {
+		t.Error("recorder read body", resp, err, rbody.Closed)
+	}
+	tp.Body = io.NopCloser(strings.NewReader("save"))
+	resp, err = NewClientHookRecorder(dir+"/none/save.json", ClientRecordModeRecord).
+		Wrap(tp).RoundTrip(req)
+	if err != nil || resp == nil {
+		t.Error("recorder save error", err)
+	} else if data, _ := io.ReadAll(resp.Body); string(data) != "save" {
+		t.Error("recorder save body", string(data))
+	}
+
+	NewClientCustom(NewClientHookRecorder(dir+"/none.json", ClientRecordModeReplay)).GetRequest("/")
+	os.WriteFile(dir+"/invalid.json", []byte("{"), 0o644)
+	NewClientCustom(NewClientHookRecorder(dir+"/invalid.json", "")).GetRequest("/")
+
+	app.CancelFunc()
+	app.Run()
+}
+
+type clientTransportBody struct {
+	Body io.ReadCloser
+}
+
+func (tp *clientTransportBody) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: StatusOK,
+		Header:     make(http.Header),
+		Body:       tp.Body,
+		Request:    req,
+	}, nil
+}
+
+type clientBodyError struct {
+	Closed bool
+}
+
+func (body *clientBodyError) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+func (body *clientBodyError) Close() error {
+	body.Closed = true
+	return nil
+}
+
+func TestClientHookHAR(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.AnyFunc("/har/*", func(ctx Context) {
+		ctx.SetCookieValue("name", "eudore", 0)
+		body, _ := ctx.Body()
+		ctx.WriteString(ctx.Method() + " ")
+		ctx.Write(body)
+	})
+
+	har := NewClientHAR()
+	client := app.NewClient(NewClientHookHAR(har, true))
+	client.GetRequest("/har/get?name=eudore", &Cookie{Name: "session", Value: "1"})
+	client.PostRequest("/har/post", strings.NewReader("body"))
+	client.GetRequest("http://127.0.0.1:1/har/error")
+	NewClientCustom(&clientTransportHosts{}, NewClientHookHAR(har, false)).GetRequest("http://localhost/har/nobody")
+
+	data, err := json.Marshal(har)
+	if err != nil {
+		t.Fatal(err)
+	}
+	har = &ClientHAR{}
+	_ = json.Unmarshal(data, har)
+	if har.Log.Version != "1.2" || len(har.Log.Entries) != 4 {
+		t.Fatal("har entries", string(data))
+	}
+	get, post := har.Log.Entries[0], har.Log.Entries[1]
+	if get.Request.QueryString[0].Value != "eudore" ||
+		get.Request.Cookies[0].Name != "session" ||
+		get.Response.Cookies[0].Value != "eudore" ||
+		get.Response.Content.Text != "GET " ||
+		get.Timings.Blocked < 0 || get.Time <= 0 {
+		t.Error("har get", get)
+	}
+	if post.Request.PostData == nil || post.Request.PostData.Text != "body" ||
+		post.Response.Content.Text != "POST body" || post.Response.Content.Size != 9 {
+		t.Error("har post", post)
+	}
+	if har.Log.Entries[2].Error == "" || har.Log.Entries[2].Response.Status != 0 {
+		t.Error("har error", har.Log.Entries[2])
+	}
+	if har.Log.Entries[3].Response.Content.Text != "" {
+		t.Error("har nobody", har.Log.Entries[3])
+	}
+	NewClientHAREntry(&http.Request{URL: &url.URL{}}, nil, nil)
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestClientHookOAuth2(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+
+	var mu sync.Mutex
+	var count int64
+	var expires int64 = 3600
+	var grants []string
+	tokens := map[string]bool{}
+	app.PostFunc("/oauth2/token", func(ctx Context) {
+		mu.Lock()
+		defer mu.Unlock()
+		user, pass, ok := ctx.Request().BasicAuth()
+		if !ok {
+			user, pass = ctx.FormValue("client_id"), ctx.FormValue("client_secret")
+		}
+		switch {
+		case user != "id" || pass != "secret":
+			ctx.WriteHeader(StatusUnauthorized)
+			ctx.Render(map[string]string{
+				"error": "invalid_client", "error_description": "client authentication failed",
+			})
+			return
+		case ctx.FormValue("scope") == "empty":
+			ctx.Render(map[string]string{"token_type": "bearer"})
+			return
+		case ctx.FormValue("refresh_token") == "revoked":
+			grants = append(grants, "refresh_token:revoked")
+			ctx.WriteHeader(StatusBadRequest)
+			ctx.Render(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		count++
+		grant := ctx.FormValue("grant_type") + ":" + ctx.FormValue("refresh_token")
+		grants = append(grants, grant)
+		token := fmt.Sprintf("t%d", count)
+		tokens[token] = true
+		ctx.Render(map[string]any{
+			"access_token":  token,
+			"token_type":    "bearer",
+			"expires_in":    atomic.LoadInt64(&expires),
+			"refresh_token": fmt.Sprintf("r%d", count),
+		})
+	})
+	app.GetFunc("/oauth2/api", func(ctx Context) {
+		mu.Lock()
+		defer mu.Unlock()
+		auth := strings.TrimPrefix(ctx.GetHeader(HeaderAuthorization), "Bearer ")
+		if !tokens[auth] {
+			ctx.WriteHeader(StatusUnauthorized)
+			return
+		}
+		ctx.WriteString(auth)
+	})
+
+	config := &ClientOAuth2Config{
+		TokenURL:     "http://" + DefaultClientInternalHost + "/oauth2/token",
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scopes:       []string{"read", "write"},
+		Params:       url.Values{"audience": {"api"}},
+	}
+	hook := NewClientHookOAuth2(config)
+	t.Log(hook.(fmt.Stringer).String())
+	client := app.NewClient(hook)
+	token := func(want string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			data, _ := io.ReadAll(resp.Body)
+			if string(data) != want {
+				t.Errorf("oauth2 token %q want %q", data, want)
+			}
+			return nil
+		}
+	}
+
+	// concurrent copies share one token
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.NewClient().GetRequest("/oauth2/api", token("t1"))
+		}()
+	}
+	wg.Wait()
+	client.NewClient(func(rt http.RoundTripper) {
+		rt.(*http.Transport).CloseIdleConnections()
+	})
+
+	// retry once on 401 with refresh_token grant
+	mu.Lock()
+	delete(tokens, "t1")
+	mu.Unlock()
+	client.GetRequest("/oauth2/api", strings.NewReader("body"), token("t2"))
+	client.GetRequest("/oauth2/api", token("t2"))
+
+	// refresh proactively
+	mu.Lock()
+	delete(tokens, "t2")
+	mu.Unlock()
+	atomic.StoreInt64(&expires, 10)
+	client.GetRequest("/oauth2/api", token("t3"))
+	client.GetRequest("/oauth2/api", token("t3"))
+	time.Sleep(50 * time.Millisecond)
+	client.GetRequest("/oauth2/api", token("t4"))
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	if count != 5 || strings.Join(grants, " ") != "client_credentials: "+
+		"refresh_token:r1 refresh_token:r2 refresh_token:r3 refresh_token:r4" {
+		t.Error("oauth2 grants", count, grants)
+	}
+	mu.Unlock()
+
+	// expired
+	atomic.StoreInt64(&expires, 0)
+	app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL:     config.TokenURL,
+		ClientID:     "id",
+		ClientSecret: "secret",
+		AuthParams:   true,
+		RefreshEarly: time.Hour,
+	})).GetRequest("/oauth2/api", token("t6"))
+
+	// rejected refresh_token grant falls back to client_credentials grant
+	app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL:     config.TokenURL,
+		ClientID:     "id",
+		ClientSecret: "secret",
+		RefreshToken: "revoked",
+	})).GetRequest("/oauth2/api", token("t7"))
+	mu.Lock()
+	if strings.Join(grants[len(grants)-2:], " ") != "refresh_token:revoked client_credentials:" {
+		t.Error("oauth2 revoked grants", grants)
+	}
+	mu.Unlock()
+
+	// error
+	var oerr *ClientOAuth2Error
+	err := app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL: config.TokenURL,
+		ClientID: "id",
+	})).GetRequest("/oauth2/api")
+	if !errors.As(err, &oerr) || oerr.Error() != "ClientOAuth2: token endpoint "+
+		"status is 401, error: invalid_client: client authentication failed" {
+		t.Error("oauth2 error", err)
+	}
+	err = app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL:     config.TokenURL,
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scopes:       []string{"empty"},
+	})).GetRequest("/oauth2/api")
+	if err == nil {
+		t.Error("oauth2 empty token")
+	}
+	app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL: "http://127.0.0.1:1/oauth2/token",
+	})).GetRequest("/oauth2/api")
+	app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL:  "http://" + DefaultClientInternalHost + "/oauth2/\x00",
+		Transport: http.DefaultTransport,
+	})).GetRequest("/oauth2/api")
+	app.NewClient(NewClientHookOAuth2(&ClientOAuth2Config{
+		TokenURL: config.TokenURL,
+	})).GetRequest("/oauth2/api", func(req *http.Request) {
+		ctx, cancel := context.WithCancel(req.Context())
+		cancel()
+		*req = *req.WithContext(ctx)
+	})
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestClientHookSigner(t *testing.T) {
+	// AWS Signature Version 4 example
+	req, _ := http.NewRequest(MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	req.Header.Set(HeaderContentType, "application/x-www-form-urlencoded; charset=utf-8")
+	_ = NewClientSignerSigV4("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		"us-east-1", "iam",
+	)(req, nil, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	if req.Header.Get(HeaderAuthorization) != "AWS4-HMAC-SHA256 "+
+		"Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, "+
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7" {
+		t.Error("sigv4", req.Header.Get(HeaderAuthorization))
+	}
+
+	// RFC 9421 B.2.5 HMAC-SHA256
+	req, _ = http.NewRequest(MethodPost, "http://example.com/foo?param=Value&Pet=dog", nil)
+	req.Header.Set(HeaderDate, "Tue, 20 Apr 2021 02:07:55 GMT")
+	req.Header.Set(HeaderContentType, MimeApplicationJSON)
+	base, err := GetHTTPSignatureBase(req, `("date" "@authority" "content-type")`+
+		`;created=1618884473;keyid="test-shared-secret"`)
+	secret, _ := base64.StdEncoding.DecodeString("uzvJfB4u3N0Jy4T7NZ75MDVcr8zSTInedJt" +
+		"kgcu46YW4XByzNJjxBdtjUkdJPBtbmHhIDi6pcl8jsasjlTMtDQ==")
+	h := hmac.New(sha256.New, secret)
+	h.Write(base)
+	if err != nil || base64.StdEncoding.EncodeToString(h.Sum(nil)) !=
+		"pxcQw6G3AjtMBQjwo8XzkZf/bws5LelbaMk5rGIGtE8=" {
+		t.Errorf("rfc9421 base %s %v", base, err)
+	}
+
+	// RFC 9421 section 2.2.8 query parameters
+	req, _ = http.NewRequest(MethodGet, "https://example.com/parameters?"+
+		"var=this%20is%20a%20big%0Avalue&bar=with+plus+whitespace"+
+		"&fa%C3%A7ade%22%3A%20=something", nil)
+	base, err = GetHTTPSignatureBase(req, `("@query-param";name="var"`+
+		` "@query-param";name="bar" "@query-param";name="fa%C3%A7ade%22%3A%20")`)
+	want := `"@query-param";name="var": this%20is%20a%20big%0Avalue
+"@query-param";name="bar": with%20plus%20whitespace
+"@query-param";name="fa%C3%A7ade%22%3A%20": something
+"@signature-params": ("@query-param";name="var" "@query-param";name="bar"` +
+		` "@query-param";name="fa%C3%A7ade%22%3A%20")`
+	if err != nil || string(base) != want {
+		t.Errorf("rfc9421 query param base %s %v", base, err)
+	}
+	if _, err = GetHTTPSignatureBase(req, `("@query-param";name="%zz")`); err == nil {
+		t.Error("rfc9421 query param invalid name")
+	}
+
+	for _, input := range []string{
+		`"@method"`, `("@method"`, `(@method)`, `("@method;)`,
+		`("@query-param";name=id)`, `("@query-param";name="id")`,
+		`("@status")`, `("x-none")`, `("date";sf)`,
+		`("@method" "@target-uri" "@scheme" "@request-target" "@path" "@query"` +
+			` "@query-param";name="Pet")`,
+	} {
+		base, err := GetHTTPSignatureBase(req, input)
+		t.Logf("%s %v", base, err)
+	}
+
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	var status int64
+	app.AnyFunc("/sign/*", func(ctx Context) {
+		if atomic.AddInt64(&status, -1) >= 0 {
+			ctx.WriteHeader(StatusServiceUnavailable)
+			return
+		}
+		if ctx.Path() == "/sign/redirect" {
+			ctx.Redirect(StatusTemporaryRedirect, "/sign/hmac")
+			return
+		}
+		input := strings.TrimPrefix(ctx.GetHeader(HeaderSignatureInput), "sig1=")
+		base, err := GetHTTPSignatureBase(ctx.Request(), input)
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		sig := strings.Trim(strings.TrimPrefix(ctx.GetHeader(HeaderSignature), "sig1="), ":")
+		h := hmac.New(sha256.New, []byte("secret"))
+		h.Write(base)
+		body, _ := ctx.Body()
+		sum := sha256.Sum256(body)
+		digest := "sha-256=:" + base64.StdEncoding.EncodeToString(sum[:]) + ":"
+		if sig != base64.StdEncoding.EncodeToString(h.Sum(nil)) ||
+			(len(body) > 0 && ctx.GetHeader(HeaderContentDigest) != digest) {
+			ctx.WriteHeader(StatusUnauthorized)
+		}
+		ctx.WriteString(input)
+	})
+
+	type reader struct{ io.Reader }
+	client := NewClientCustom(
+		NewClientHookSigner(NewClientSignerHTTPMessage("kid", []byte("secret"))),
+		NewClientHookRetry(1, []time.Duration{time.Millisecond, time.Millisecond}, nil),
+		NewClientHookRedirect(nil),
+	)
+	app.SetValue(ContextKeyClient, client)
+	check := func(resp *http.Response) error {
+		if resp.StatusCode != StatusOK {
+			t.Error("sign status", resp.Request.URL, resp.StatusCode)
+		}
+		return nil
+	}
+	app.GetRequest("/sign/hmac?name=eudore", check)
+	app.PostRequest("/sign/hmac", strings.NewReader("body"), check)
+	app.PostRequest("/sign/redirect", strings.NewReader("redirect"), check)
+	atomic.StoreInt64(&status, 1)
+	app.PutRequest("/sign/retry", reader{strings.NewReader("retry")}, check)
+	ch := make(chan int)
+	close(ch)
+	app.PostRequest("/sign/stream", NewClientBodyJSONLines(ch))
+
+	// signers
+	for _, signer := range []ClientSigner{
+		NewClientSignerHMAC("kid", []byte("secret")),
+		NewClientSignerHMAC("kid", []byte("secret"), "Date", "X-None"),
+		NewClientSignerSigV4("ak", "sk", "us-east-1", "s3"),
+		NewClientSignerHTTPMessage("kid", ed25519.NewKeyFromSeed(make([]byte, 32))),
+		NewClientSignerHTTPMessage("kid", "secret"),
+		NewClientSignerHTTPMessage("kid", []byte("secret"), "@method", "x-none"),
+	} {
+		app.NewClient(NewClientHookSigner(signer)).PostRequest("/sign/client",
+			strings.NewReader("body"),
+		)
+	}
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	for _, key := range []any{rsaKey, newEcdsaKey(elliptic.P256()),
+		newEcdsaKey(elliptic.P384()), newEcdsaKey(elliptic.P224()),
+	} {
+		req, _ := http.NewRequest(MethodGet, "http://example.com/", nil)
+		err := NewClientSignerHTTPMessage("kid", key)(req, nil, time.Now())
+		t.Log(req.Header.Get(HeaderSignature), err)
+	}
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func newEcdsaKey(c elliptic.Curve) *ecdsa.PrivateKey {
+	key, _ := ecdsa.GenerateKey(c, rand.Reader)
+	return key
+}
+
 type clientHookBody struct {
 	next http.RoundTripper
 }
@@ -324,6 +1123,113 @@ func TestClientBody(t *testing.T) {
 	app.Run()
 }
 
+func TestClientBodyStream(t *testing.T) {
+	type Body struct {
+		Name string
+	}
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.AnyFunc("/ndjson", func(ctx Context) {
+		ctx.WriteString(ctx.GetHeader(HeaderContentType) + "\n")
+		io.Copy(ctx, ctx)
+	})
+	app.AnyFunc("/multipart", func(ctx Context) {
+		for _, file := range ctx.FormFiles()["file"] {
+			f, _ := file.Open()
+			data, _ := io.ReadAll(f)
+			f.Close()
+			ctx.WriteString(file.Filename + "=" + string(data) + ";")
+		}
+	})
+	body := func(want string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			data, _ := io.ReadAll(resp.Body)
+			if string(data) != want {
+				t.Errorf("stream body %q want %q", data, want)
+			}
+			return nil
+		}
+	}
+
+	// ndjson
+	ch := make(chan Body, 2)
+	ch <- Body{"a"}
+	ch <- Body{"b"}
+	close(ch)
+	ndjson := MimeApplicationNDJSON + "\n{\"Name\":\"a\"}\n{\"Name\":\"b\"}\n"
+	app.PostRequest("/ndjson", NewClientBodyJSONLines(ch), body(ndjson))
+	app.PostRequest("/ndjson", NewClientBodyJSONLines([]Body{{"a"}, {"b"}}), body(ndjson))
+	app.PostRequest("/ndjson", NewClientBodyJSONLines(func(yield func(Body) bool) {
+		_ = yield(Body{"a"}) && yield(Body{"b"}) && yield(Body{"c"})
+	}), body(ndjson+"{\"Name\":\"c\"}\n"))
+	for _, data := range []any{nil, Body{"a"}, map[string]any{}, func() {}, func(func(Body)) {}} {
+		err := app.PostRequest("/ndjson", NewClientBodyJSONLines(data))
+		if !errors.Is(err, ErrClientBodyInvalidLines) {
+			t.Errorf("ndjson %T error: %v", data, err)
+		}
+	}
+	app.PostRequest("/ndjson", NewClientBodyJSONLines([]any{func() {}}))
+
+	bodyLines := NewClientBodyJSONLines(ch)
+	if _, err := bodyLines.GetBody(); err != ErrClientBodyNotGetBody {
+		t.Error("ndjson chan GetBody", err)
+	}
+	bodyLines = NewClientBodyJSONLines([]int{1})
+	bodyLines.AddValue("", nil)
+	bodyLines.AddFile("", "", nil)
+	bodyLines.Close()
+	if _, err := bodyLines.GetBody(); err != nil {
+		t.Error("ndjson slice GetBody", err)
+	}
+
+	// multipart
+	bodyForm := NewClientBodyForm(nil)
+	bodyForm.AddFile("file", "open.txt", func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("open")), nil
+	})
+	if _, err := bodyForm.GetBody(); err != nil {
+		t.Error("multipart GetBody", err)
+	}
+	app.PostRequest("/multipart", bodyForm, body("open.txt=open;"))
+	bodyForm = NewClientBodyForm(nil)
+	bodyForm.AddFile("file", "error.txt", func() (io.ReadCloser, error) {
+		return nil, os.ErrNotExist
+	})
+	app.PostRequest("/multipart", bodyForm)
+
+	// progress
+	var written, total int64
+	progress := NewClientOptionProgress(func(n, t int64) {
+		written, total = n, t
+	})
+	app.PostRequest("/ndjson", strings.NewReader("progress"), progress)
+	if written != 8 || total != 8 {
+		t.Error("progress", written, total)
+	}
+	app.PostRequest("/ndjson", NewClientBodyJSONLines([]int{1, 2}), progress,
+		NewClientHookRedirect(nil),
+		func(req *http.Request) {
+			body, _ := req.GetBody()
+			io.Copy(io.Discard, body)
+		},
+	)
+	if written != 4 || total != -1 {
+		t.Error("progress", written, total)
+	}
+	app.GetRequest("/ndjson", progress)
+	app.GetRequest("/ndjson", progress, func(req *http.Request) {
+		req.Body = io.NopCloser(strings.NewReader(""))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return nil, io.EOF
+		}
+	}, progress, func(req *http.Request) {
+		req.GetBody()
+	})
+
+	app.CancelFunc()
+	app.Run()
+}
+
 func TestClientAuthorization(t *testing.T) {
 	digest := []string{
 		`Digest realm="digest@eudore.cn"`,
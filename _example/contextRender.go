@@ -25,19 +25,21 @@ func main() {
 		eudore.MimeTextHTML:            eudore.NewHandlerDataRenderTemplates(nil, nil),
 		eudore.MimeApplicationJSON:     eudore.HandlerDataRenderJSON,
 		eudore.MimeApplicationProtobuf: eudore.HandlerDataRenderProtobuf,
+		eudore.MimeApplicationMsgpack:  eudore.HandlerDataRenderMsgpack,
+		eudore.MimeApplicationCBOR:     eudore.HandlerDataRenderCBOR,
 	}))
 	app.SetValue(eudore.ContextKeyContextPool, eudore.NewContextBasePool(app))
 	app.AnyFunc("/*", func(ctx eudore.Context) any {
 		type renderData struct {
-			Name    string `xml:"name" json:"name"`
-			Message string `xml:"message" json:"message"`
+			Name    string `xml:"name" json:"name" protobuf:"1,name=name"`
+			Message string `xml:"message" json:"message" protobuf:"2,name=message"`
 		}
 		return renderData{
 			Name:    "eudore",
 			Message: "hello eudore",
 		}
 	})
-	// 访问首页可以看到5种不同Accept值，返回不同格式数据。
+	// 访问首页可以看到7种不同Accept值，返回不同格式数据。
 	app.GetFunc("/", func(ctx eudore.Context) {
 		ctx.SetHeader(eudore.HeaderContentType, eudore.MimeTextHTML)
 		ctx.WriteString(index)
@@ -62,4 +64,6 @@ accept('text/plain')
 accept('application/json')
 accept('application/xml')
 accept('application/protobuf')
+accept('application/msgpack')
+accept('application/cbor')
 </script>`
@@ -4,6 +4,7 @@ package main
 eudore默认没有render html api，通过设置render支持。
 当请求header "Accept: text/html"时，才会调用render html，否在按照Accept header顺序来选择render。
 使用NewHandlerDataRenderTemplates时，必须存在Param template定义模板名称。
+路由参数name定义路由名称，模板函数使用app.URL方法反向生成路由地址。
 可以强制设置Accent header的值强制使用render html
 */
 
@@ -21,7 +22,7 @@ var viewdata = map[string]interface{}{
 }
 
 var tempcontent = `{{- define "index.html" -}}
-name: {{.name}} message: {{.message}}
+name: {{.name}} message: {{.message}} <a href="{{url "template" "*" "index.html"}}">template</a>
 <script>
 fetch("/",{headers: {Accept:"application/json"}})
 .then((response) => response.text())
@@ -37,9 +38,12 @@ fetch("/",{headers: {Accept:"application/json"}})
 var roottemplate embed.FS
 
 func main() {
-	temp := template.Must(template.New("").Parse(tempcontent))
-
 	app := eudore.NewApp()
+	// 模板函数url使用路由参数name反向生成路由地址。
+	temp := template.Must(template.New("").Funcs(template.FuncMap{
+		"url": app.URL,
+	}).Parse(tempcontent))
+
 	// 重新设置Render，参考eudore.DefaultHandlerDataRenders定义，修改MimeTextHTML的Render函数s使用自定义模板。
 	app.SetValue(eudore.ContextKeyRender, eudore.NewHandlerDataRenders(map[string]eudore.HandlerDataFunc{
 		eudore.MimeTextHTML:        eudore.NewHandlerDataRenderTemplates(temp, roottemplate, "**/*.tmpl"),
@@ -51,7 +55,7 @@ func main() {
 	app.AnyFunc("/*path template=index.html", func(ctx eudore.Context) {
 		ctx.Render(viewdata)
 	})
-	app.AnyFunc("/template/*", func(ctx eudore.Context) interface{} {
+	app.AnyFunc("/template/* name=template", func(ctx eudore.Context) interface{} {
 		ctx.SetParam("template", ctx.GetParam("*"))
 		return viewdata
 	})
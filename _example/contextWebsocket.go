@@ -0,0 +1,57 @@
+package main
+
+/*
+eudore.NewWebsocketConn使用ctx.Response().Hijack()实现RFC 6455握手，返回*eudore.WebsocketConn。
+
+处理函数func(eudore.Context, *eudore.WebsocketConn)已注册到HandlerExtender，处理函数返回后自动关闭连接。
+连接绑定ctx.Context()，Context取消或者Server Shutdown时会发送1001关闭连接。
+*/
+
+import (
+	"time"
+
+	"github.com/eudore/eudore"
+)
+
+func main() {
+	app := eudore.NewApp()
+	app.GetFunc("/echo", func(ctx eudore.Context, conn *eudore.WebsocketConn) {
+		for {
+			code, data, err := conn.ReadMessage()
+			if err != nil {
+				ctx.Debug(err)
+				return
+			}
+			ctx.Info(string(data))
+			err = conn.WriteMessage(code, data)
+			if err != nil {
+				return
+			}
+		}
+	})
+	app.GetFunc("/chat", func(ctx eudore.Context) {
+		conn, err := eudore.NewWebsocketConn(ctx, &eudore.WebsocketConfig{
+			Subprotocols: []string{"chat"},
+			ReadLimit:    1 << 20,
+			ReadTimeout:  eudore.TimeDuration(time.Minute),
+			PingInterval: eudore.TimeDuration(time.Second * 30),
+			Compression:  true,
+		})
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			_ = conn.WriteMessage(eudore.WebsocketMessageText, data)
+		}
+	})
+
+	app.Listen(":8088")
+	app.Run()
+}
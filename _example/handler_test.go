@@ -329,6 +329,7 @@ func TestHandlerList(t *testing.T) {
 		"github.com/eudore/eudore.NewHandlerFuncContextMapAnyError(func(eudore.Context, map[string]interface {}) (interface {}, error))",
 		"github.com/eudore/eudore.NewHandlerHTTPFunc1(http.HandlerFunc)",
 		"github.com/eudore/eudore.NewHandlerHTTPFunc2(func(http.ResponseWriter, *http.Request))",
+		"github.com/eudore/eudore.NewHandlerWebsocket(func(eudore.Context, *eudore.WebsocketConn))",
 		"github.com/eudore/eudore.NewHandlerFileEmbed(embed.FS)",
 		"github.com/eudore/eudore.NewHandlerHTTPHandler(http.Handler)",
 		"github.com/eudore/eudore.NewHandlerFileIOFS(fs.FS)",
@@ -1,13 +1,18 @@
 package eudore_test
 
 import (
+	"bytes"
 	"context"
 	"embed"
+	"encoding/hex"
 	"fmt"
 	"html/template"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -167,6 +172,80 @@ func TestHandlerDataRender(*testing.T) {
 	app.Run()
 }
 
+func TestHandlerDataRenderETag(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	app.SetValue(ContextKeyRender, NewHandlerDataRenderETag(nil, false, "no-cache"))
+	app.SetValue(ContextKeyContextPool, NewContextBasePool(app))
+	app.GetFunc("/data", func(ctx Context) any {
+		return map[string]any{"name": "eudore"}
+	})
+	app.GetFunc("/weak", func(ctx Context) error {
+		ctx.SetHeader(HeaderLastModified, "Mon, 02 Jan 2006 15:04:05 GMT")
+		return NewHandlerDataRenderETag(HandlerDataRenderText, true, "")(ctx, "weak")
+	})
+	app.GetFunc("/status", func(ctx Context) any {
+		ctx.WriteStatus(StatusCreated)
+		return "created"
+	})
+	app.PostFunc("/data", func(ctx Context) any {
+		return "post"
+	})
+
+	var etag string
+	app.GetRequest("/data", func(resp *http.Response) error {
+		etag = resp.Header.Get(HeaderETag)
+		if resp.StatusCode != 200 || len(etag) != 34 ||
+			resp.Header.Get(HeaderCacheControl) != "no-cache" {
+			t.Error("etag error", resp.Status, resp.Header)
+		}
+		return nil
+	})
+	app.GetRequest("/data", NewClientHeader(HeaderIfNoneMatch, `"x", W/`+etag),
+		func(resp *http.Response) error {
+			if resp.StatusCode != StatusNotModified {
+				t.Error("if-none-match error", resp.Status)
+			}
+			return nil
+		},
+	)
+	app.GetRequest("/data", NewClientHeader(HeaderIfNoneMatch, `"x"`),
+		NewClientHeader(HeaderIfModifiedSince, "Mon, 02 Jan 2006 15:04:05 GMT"),
+		func(resp *http.Response) error {
+			if resp.StatusCode != StatusOK {
+				t.Error("if-none-match not match error", resp.Status)
+			}
+			return nil
+		},
+	)
+	app.GetRequest("/weak", NewClientHeader(HeaderIfModifiedSince, "Mon, 02 Jan 2006 15:04:05 GMT"),
+		func(resp *http.Response) error {
+			if resp.StatusCode != StatusNotModified ||
+				!strings.HasPrefix(resp.Header.Get(HeaderETag), "W/") {
+				t.Error("if-modified-since error", resp.Status, resp.Header)
+			}
+			return nil
+		},
+	)
+	app.GetRequest("/status", func(resp *http.Response) error {
+		if resp.StatusCode != StatusCreated || resp.Header.Get(HeaderETag) != "" {
+			t.Error("status error", resp.Status, resp.Header)
+		}
+		return nil
+	})
+	app.PostRequest("/data", NewClientHeader(HeaderIfNoneMatch, "*"),
+		func(resp *http.Response) error {
+			if resp.StatusCode != StatusOK || resp.Header.Get(HeaderETag) != "" {
+				t.Error("post error", resp.Status, resp.Header)
+			}
+			return nil
+		},
+	)
+
+	app.CancelFunc()
+	app.Run()
+}
+
 //go:embed handlerdata_test.go
 var handlerdatafile embed.FS
 
@@ -220,6 +299,352 @@ func TestHandlerDataRenderTemplates(*testing.T) {
 	app.Run()
 }
 
+func TestHandlerDataRenderTemplateEngine(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, body string) {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755)
+		os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644)
+		// update modification time for file system with low precision
+		os.Chtimes(filepath.Join(dir, name), time.Now(), time.Now().Add(time.Duration(len(body))*time.Second))
+	}
+	write("layouts/base.html", `<title>{{block "title" .}}eudore{{end}}</title>{{block "content" .}}{{end}}{{template "nav.html" .}}`)
+	write("layouts/simple.html", `{{block "content" .}}{{end}}`)
+	write("partials/nav.html", `<nav>{{tolower .Name}}</nav>`)
+	write("pages/index.html", `{{define "title"}}index{{end}}{{define "content"}}{{toupper .Name}}{{end}}`)
+	write("pages/about.html", `{{define "content"}}about{{end}}`)
+	write("pages/user/info.html", `{{funccreator "trim=-" .Name}} {{version}} {{template "nav.html" .}}`)
+
+	engine := func(reload bool) HandlerDataFunc {
+		return NewHandlerDataRenderTemplateEngine(context.Background(), &TemplateEngine{
+			FS:       os.DirFS(dir),
+			Layouts:  []string{"layouts"},
+			Partials: []string{"partials/"},
+			Pages:    []string{"pages"},
+			Layout:   "base.html",
+			Reload:   reload,
+			Funcs:    template.FuncMap{"version": func() string { return "v1" }},
+		})
+	}
+	data := map[string]string{"Name": "-Eudore-"}
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	for _, mode := range []string{"dev", "prod"} {
+		render := engine(mode == "dev")
+		handler := func(ctx Context) {
+			err := render(ctx, data)
+			if err != nil {
+				ctx.WriteHeader(StatusInternalServerError)
+				ctx.WriteString(err.Error())
+			}
+		}
+		app.GetFunc("/"+mode+"/simple/*template layout=simple.html", handler)
+		app.GetFunc("/"+mode+"/*template", handler)
+		app.GetFunc("/"+mode+"/", func(ctx Context) {
+			render(ctx, "text")
+		})
+	}
+
+	check := func(path string, status int, body string) {
+		err := app.GetRequest(path, NewClientCheckStatus(status), NewClientCheckBody(body))
+		if err != nil {
+			t.Error(path, err)
+		}
+	}
+	for _, mode := range []string{"/dev", "/prod"} {
+		check(mode+"/", 200, "text")
+		check(mode+"/index.html", 200, "<title>index</title>-EUDORE-<nav>-eudore-</nav>")
+		check(mode+"/about.html", 200, "<title>eudore</title>about<nav>-eudore-</nav>")
+		check(mode+"/simple/about.html", 200, "about")
+		check(mode+"/user/info.html", 200, "Eudore v1 <nav>-eudore-</nav>")
+		check(mode+"/none.html", 500, "HandlerData: render not found template none.html")
+	}
+
+	write("pages/about.html", `{{define "content"}}about eudore{{end}}`)
+	write("pages/new.html", `new`)
+	check("/dev/about.html", 200, "<title>eudore</title>about eudore<nav>-eudore-</nav>")
+	check("/dev/new.html", 200, "new")
+	check("/prod/about.html", 200, "<title>eudore</title>about<nav>-eudore-</nav>")
+	check("/prod/new.html", 500, "HandlerData: render not found template new.html")
+
+	write("pages/new.html", `{{end}}`)
+	check("/dev/new.html", 500, "")
+	write("pages/new.html", `{{define "content"}}new{{end}}`)
+	check("/dev/simple/new.html", 200, "new")
+	write("layouts/simple.html", `{{end}}`)
+	check("/dev/simple/new.html", 500, "")
+	check("/prod/simple/new.html", 500, "HandlerData: render not found template new.html")
+
+	// parse error in production
+	dev := engine(true)
+	prod := engine(false)
+	app.GetFunc("/error/*template", func(ctx Context) {
+		dev(ctx, data)
+		prod(ctx, data)
+	})
+	check("/error/index.html", 500, "")
+
+	NewHandlerDataRenderTemplateEngine(context.Background(), &TemplateEngine{
+		Pages: []string{"none"},
+	})
+	app.CancelFunc()
+	app.Run()
+}
+
+type codecChild struct {
+	Name string `json:"name" protobuf:"1,name=name"`
+}
+
+type codecData struct {
+	Bool    bool           `json:"bool" protobuf:"1,name=bool"`
+	Int     int            `json:"int" protobuf:"2,name=int"`
+	Int8    int8           `json:"int8" protobuf:"3,name=int8"`
+	Uint    uint64         `json:"uint" protobuf:"4,name=uint"`
+	Float32 float32        `json:"float32" protobuf:"5,name=float32"`
+	Float64 float64        `json:"float64" protobuf:"6,name=float64"`
+	String  string         `json:"string" protobuf:"7,name=string"`
+	Bytes   []byte         `json:"bytes" protobuf:"8,name=bytes"`
+	Ints    []int          `json:"ints" protobuf:"9,name=ints"`
+	Floats  []float64      `json:"floats" protobuf:"10,name=floats"`
+	Strings []string       `json:"strings" protobuf:"11,name=strings"`
+	Map     map[string]int `json:"map" protobuf:"12,name=map"`
+	Child   codecChild     `json:"child" protobuf:"13,name=child"`
+	Childs  []*codecChild  `json:"childs" protobuf:"14,name=childs"`
+	Ptr     *int           `json:"ptr" protobuf:"15,name=ptr"`
+	Time    time.Time      `json:"time" protobuf:"16,name=time"`
+	Any     any            `json:"any" protobuf:"17,name=any"`
+	Omit    string         `json:"omit,omitempty" protobuf:"18,name=omit"`
+	Skip    string         `json:"-" protobuf:"-"`
+}
+
+func TestHandlerDataCodec(t *testing.T) {
+	ptr := 42
+	data := codecData{
+		Bool: true, Int: -1 << 40, Int8: -100, Uint: 1 << 63,
+		Float32: 1.5, Float64: -2.25, String: "eudore", Bytes: []byte{0, 1, 2},
+		Ints: []int{1, -1, 300}, Floats: []float64{0.5, 0}, Strings: []string{"a", ""},
+		Map: map[string]int{"a": 1, "b": -2}, Child: codecChild{"child"},
+		Childs: []*codecChild{{"c1"}, {}}, Ptr: &ptr,
+		Time: time.Unix(1700000000, 123), Any: "any", Skip: "skip",
+	}
+	codecs := []struct {
+		Mime      string
+		Marshal   func(any) ([]byte, error)
+		Unmarshal func([]byte, any) error
+	}{
+		{MimeApplicationProtobuf, MarshalProtobuf, UnmarshalProtobuf},
+		{MimeApplicationMsgpack, MarshalMsgpack, UnmarshalMsgpack},
+		{MimeApplicationCBOR, MarshalCBOR, UnmarshalCBOR},
+	}
+	equal := func(mime string, out codecData) {
+		if !out.Time.Equal(data.Time) {
+			t.Error(mime, "time", out.Time)
+		}
+		out.Time = data.Time
+		want := data
+		want.Skip = ""
+		if !reflect.DeepEqual(out, want) {
+			t.Errorf("%s\n%#v\n%#v", mime, out, want)
+		}
+	}
+	for _, codec := range codecs {
+		body, err := codec.Marshal(&data)
+		if err != nil {
+			t.Fatal(codec.Mime, err)
+		}
+		var out codecData
+		err = codec.Unmarshal(body, &out)
+		if err != nil {
+			t.Fatal(codec.Mime, err)
+		}
+		equal(codec.Mime, out)
+
+		_, err = codec.Marshal(struct{ C chan int }{make(chan int)})
+		if err == nil {
+			t.Error(codec.Mime, "marshal chan")
+		}
+		if codec.Unmarshal(body, out) == nil || codec.Unmarshal(body[:len(body)-1], &out) == nil {
+			t.Error(codec.Mime, "unmarshal invalid")
+		}
+	}
+
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.SetValue(ContextKeyContextPool, NewContextBasePool(app))
+	app.PostFunc("/codec", func(ctx Context) (any, error) {
+		var data codecData
+		err := ctx.Bind(&data)
+		return data, err
+	})
+	app.GetFunc("/string", func(ctx Context) any {
+		return "eudore"
+	})
+	for _, codec := range codecs {
+		body, _ := codec.Marshal(data)
+		var out codecData
+		err := app.PostRequest("/codec", bytes.NewReader(body),
+			http.Header{HeaderContentType: {codec.Mime}, HeaderAccept: {codec.Mime}},
+			NewClientCheckStatus(200),
+			NewClientParse(&out),
+		)
+		if err != nil {
+			t.Error(codec.Mime, err)
+		}
+		equal(codec.Mime, out)
+
+		var msg struct {
+			Status  int    `json:"status" protobuf:"6,name=status"`
+			Message string `json:"message" protobuf:"11,name=message"`
+		}
+		err = app.GetRequest("/string", NewClientHeader(HeaderAccept, codec.Mime),
+			NewClientParse(&msg),
+		)
+		if err != nil || (codec.Mime == MimeApplicationProtobuf && msg.Message != "eudore") {
+			t.Error(codec.Mime, err, msg)
+		}
+	}
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestHandlerDataCodecFormat(t *testing.T) {
+	decode := func(name string, unmarshal func([]byte, any) error, hexdata string, want any) {
+		body, _ := hex.DecodeString(hexdata)
+		out := reflect.New(reflect.TypeOf(want))
+		err := unmarshal(body, out.Interface())
+		if err != nil || !reflect.DeepEqual(out.Elem().Interface(), want) {
+			t.Errorf("%s decode %s: %v %#v", name, hexdata, err, out.Elem().Interface())
+		}
+	}
+	encode := func(name string, marshal func(any) ([]byte, error), data any, want string) {
+		body, err := marshal(data)
+		if err != nil || hex.EncodeToString(body) != want {
+			t.Errorf("%s encode %#v: %v %x", name, data, err, body)
+		}
+	}
+	type Test1 struct {
+		A int    `protobuf:"1,name=a"`
+		B string `protobuf:"2,name=b"`
+		D []int  `protobuf:"4,name=d"`
+	}
+	encode("protobuf", MarshalProtobuf, Test1{A: 150}, "089601")
+	encode("protobuf", MarshalProtobuf, &Test1{B: "testing"}, "120774657374696e67")
+	encode("protobuf", MarshalProtobuf, Test1{D: []int{3, 270, 86942}}, "2206038e029ea705")
+	encode("protobuf", MarshalProtobuf, (*Test1)(nil), "")
+	decode("protobuf", UnmarshalProtobuf, "089601", Test1{A: 150})
+	// unknown field, unpacked repeated field
+	decode("protobuf", UnmarshalProtobuf, "1d0000000020032080042801", Test1{D: []int{3, 512}})
+	decode("protobuf", UnmarshalProtobuf, "0a00", struct {
+		Map map[int]string `protobuf:"1,name=map"`
+	}{map[int]string{0: ""}})
+	decode("protobuf", UnmarshalProtobuf, "0dffffffff1102000000000000001d0000c03f250000c03f", struct {
+		Int   int32   `protobuf:"1"`
+		Uint  uint64  `protobuf:"2"`
+		Float float32 `protobuf:"3"`
+		Any   any     `protobuf:"4"`
+	}{-1, 2, 1.5, float64(1.5)})
+
+	encode("msgpack", MarshalMsgpack, map[string]any{"a": 1}, "81a16101")
+	encode("msgpack", MarshalMsgpack, []any{nil, false, -1, -33, 200, -200, 70000, -70000, 1 << 33, -1 << 33}, "9ac0c2ffd0dfccc8d1ff38ce00011170d2fffeee90cf0000000200000000d3fffffffe00000000")
+	encode("msgpack", MarshalMsgpack, []any{strings.Repeat("a", 40)[:32], make([]byte, 1)}, "92d920"+strings.Repeat("61", 32)+"c40100")
+	encode("msgpack", MarshalMsgpack, []any{time.Unix(1, 0), time.Unix(1, 1), time.Unix(1<<35, 1), float32(1)}, "94d6ff00000001d7ff0000000400000001c70cff000000010000000800000000ca3f800000")
+	decode("msgpack", UnmarshalMsgpack, "82a16101a162c403010203", map[string]any{"a": int64(1), "b": []byte{1, 2, 3}})
+	decode("msgpack", UnmarshalMsgpack, "8101a161", map[any]any{int64(1): "a"})
+	decode("msgpack", UnmarshalMsgpack, "93d4010ad0ffcf8000000000000000", []any{[]byte{0x0a}, int64(-1), uint64(1 << 63)})
+	decode("msgpack", UnmarshalMsgpack, "dc000101", []int{1})
+	decode("msgpack", UnmarshalMsgpack, "de0001a16101", map[string]uint{"a": 1})
+	decode("msgpack", UnmarshalMsgpack, "da000161", "a")
+
+	encode("cbor", MarshalCBOR, []any{1000000, -1000, "IETF", []int{2, 3}, true, nil}, "861a000f42403903e76449455446820203f5f6")
+	encode("cbor", MarshalCBOR, time.Unix(0, 0).UTC(), "c074313937302d30312d30315430303a30303a30305a")
+	decode("cbor", UnmarshalCBOR, "83f93c00f97c00f9c400", []float64{1, math.Inf(1), -4})
+	decode("cbor", UnmarshalCBOR, "9fff", []int{})
+	decode("cbor", UnmarshalCBOR, "5f42010243030405ff", []byte{1, 2, 3, 4, 5})
+	decode("cbor", UnmarshalCBOR, "7f62657564646f7265ff", "eudore")
+	decode("cbor", UnmarshalCBOR, "bf6346756ef563416d7421ff", map[string]any{"Fun": true, "Amt": int64(-2)})
+	decode("cbor", UnmarshalCBOR, "a2646e616d656165646e616d65f6", map[string]*string{"name": nil})
+	decode("cbor", UnmarshalCBOR, "c11a514b67b0", time.Unix(1363896240, 0))
+	decode("cbor", UnmarshalCBOR, "d8206165", "e")
+	decode("cbor", UnmarshalCBOR, "a1644e616d656178", codecChild{"x"})
+	decode("cbor", UnmarshalCBOR, "a1616182f6a10102", struct{ A any }{
+		[]any{nil, map[any]any{int64(1): int64(2)}},
+	})
+	decode("cbor", UnmarshalCBOR, "a1614181a1616101", struct{ A any }{
+		[]any{map[string]any{"a": int64(1)}},
+	})
+
+	for _, unmarshal := range []func([]byte, any) error{UnmarshalMsgpack, UnmarshalCBOR, UnmarshalProtobuf} {
+		for _, hexdata := range []string{"", "c1", "ff", "1f", "5f01ff", "dc", "c1ff", "d9ff", "bf"} {
+			var out any
+			body, _ := hex.DecodeString(hexdata)
+			if unmarshal(body, &out) == nil && unmarshal(body, &codecData{}) == nil && hexdata != "" {
+				t.Error("invalid data", hexdata)
+			}
+		}
+	}
+	// map key is not comparable
+	for _, body := range [][]byte{
+		{0x81, 0x91, 0x01, 0x01}, {0x81, 0x81, 0x01, 0x01, 0x01},
+		{0xa1, 0x81, 0x01, 0x01}, {0xa1, 0xa1, 0x01, 0x01, 0x01},
+	} {
+		unmarshal := UnmarshalMsgpack
+		if body[0] == 0xa1 {
+			unmarshal = UnmarshalCBOR
+		}
+		var out any
+		err := unmarshal(body, &out)
+		if err == nil || !strings.Contains(err.Error(), "cannot decode") {
+			t.Errorf("decode map key %x: %v", body, err)
+		}
+		err = unmarshal(body, &map[any]int{})
+		if err == nil || !strings.Contains(err.Error(), "cannot decode") {
+			t.Errorf("decode map key %x: %v", body, err)
+		}
+	}
+
+	// nil sets the zero value
+	nilmap := map[any]any{}
+	if UnmarshalMsgpack([]byte{0xc0}, &nilmap) != nil || nilmap != nil ||
+		UnmarshalCBOR([]byte{0xf6}, &map[any]any{}) != nil {
+		t.Error("decode nil", nilmap)
+	}
+
+	var out []int
+	if UnmarshalMsgpack([]byte{0x91, 0xa1, 0x61}, &out) == nil || UnmarshalCBOR([]byte{0x81, 0x20}, &[]uint{}) == nil {
+		t.Error("decode type")
+	}
+	if UnmarshalMsgpack(bytes.Repeat([]byte{0x91}, 1100), &out) == nil ||
+		UnmarshalCBOR(bytes.Repeat([]byte{0x81}, 1100), &out) == nil {
+		t.Error("max depth")
+	}
+}
+
+func FuzzHandlerDataCodec(f *testing.F) {
+	for _, data := range []any{
+		codecData{
+			Int: -1, String: "eudore", Bytes: []byte{1}, Ints: []int{1, 300},
+			Map: map[string]int{"a": 1}, Childs: []*codecChild{{"c1"}},
+			Time: time.Unix(1700000000, 0), Any: []any{1, "a"},
+		},
+		map[any]any{1: []any{nil, true, 1.5}, "a": map[string]any{"b": "c"}},
+	} {
+		for _, marshal := range []func(any) ([]byte, error){MarshalProtobuf, MarshalMsgpack, MarshalCBOR} {
+			body, _ := marshal(data)
+			f.Add(body)
+		}
+	}
+	f.Add([]byte{0x81, 0x91, 0x01, 0x01})
+	f.Add([]byte{0xa1, 0x81, 0x01, 0x01})
+	f.Add([]byte{0xf6})
+	f.Fuzz(func(t *testing.T, body []byte) {
+		for _, unmarshal := range []func([]byte, any) error{UnmarshalProtobuf, UnmarshalMsgpack, UnmarshalCBOR} {
+			var out any
+			_ = unmarshal(body, &out)
+			_ = unmarshal(body, &codecData{})
+			_ = unmarshal(body, &map[any]any{})
+		}
+	})
+}
+
 type dataValidate01 struct {
 	ID     *int   `json:"id" xml:"id" valid:"nozero,omitempty"`
 	Child  []int  `json:"child" xml:"child" valid:"nozero,omitempty"`
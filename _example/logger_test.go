@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strings"
 	"testing"
@@ -235,6 +236,132 @@ func TestLoggerFormatterJSON(*testing.T) {
 	log.WithField("field", new(marsha5)).Debug("marsha5")
 }
 
+func TestLoggerFormatterLogfmt(*testing.T) {
+	entrys := []string{
+		`time=none level=DEBUG json=DEBUG`,
+		`time=none level=DEBUG fmt.Stringer="="`,
+		`time=none level=DEBUG fmt.Stringer=0s`,
+		`time=none level=DEBUG error="logger wirte error"`,
+		`time=none level=DEBUG bool=true`,
+		`time=none level=DEBUG int=1`,
+		`time=none level=DEBUG uint=2`,
+		`time=none level=DEBUG float=3.3`,
+		`time=none level=DEBUG complex=`,
+		`time=none level=DEBUG map="map{`,
+		`time=none level=DEBUG map alias="eudore_test.M{`,
+		`time=none level=DEBUG map empty=map{}`,
+		`time=none level=DEBUG map cycle="map{`,
+		`time=none level=DEBUG struct="{Name:\"name\"}"`,
+		`time=none level=DEBUG struct empty={}`,
+		`time=none level=DEBUG struct cycle="&{`,
+		`time=none level=DEBUG struct anonymous="&{`,
+		`time=none level=DEBUG ptr="&{Name:\"name\"}"`,
+		`time=none level=DEBUG ptr empty=null`,
+		`time=none level=DEBUG slice empty=[]`,
+		`time=none level=DEBUG slice cycle="[\"slice\",0,null]"`,
+		`time=none level=DEBUG array=[1,2,3]`,
+		`time=none level=DEBUG func=`,
+		`time=none level=DEBUG bytes=[98,121,116,101,115]`,
+		`time=none level=DEBUG any empty=null`,
+		`time=none level=DEBUG any empty=[null]`,
+		`time=none level=DEBUG chan empty=null`,
+		`time=none level=INFO message=depth`,
+		`time=none level=INFO message=depth`,
+		`time=none level=INFO message=depth`,
+		`time=none level=INFO message="hello world" quote="a b=\"c\"" empty=""`,
+		`time=none level=WARNING message=`,
+	}
+	log := NewLogger(&LoggerConfig{
+		Stdout:     false,
+		Formatter:  "logfmt",
+		TimeFormat: "none",
+		Handlers:   []LoggerHandler{&loggerWriterDiscard{entrys}},
+	})
+	loggerWriteData(log)
+	log.WithField("quote", "a b=\"c\"").WithField("empty", "").Info("hello world")
+	log.WithField("error", nil).Warning("\n")
+}
+
+type loggerWriterOTLP struct {
+	t *testing.T
+}
+
+func (*loggerWriterOTLP) HandlerPriority() int {
+	return DefaultLoggerPriorityWriterStdout
+}
+
+func (w *loggerWriterOTLP) HandlerEntry(entry *LoggerEntry) {
+	var data struct {
+		ResourceLogs []struct {
+			Resource struct {
+				Attributes []map[string]any `json:"attributes"`
+			} `json:"resource"`
+			ScopeLogs []struct {
+				LogRecords []map[string]any `json:"logRecords"`
+			} `json:"scopeLogs"`
+		} `json:"resourceLogs"`
+	}
+	err := json.Unmarshal(entry.Buffer, &data)
+	if err != nil {
+		w.t.Fatal(err, string(entry.Buffer))
+	}
+	record := data.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	if record["severityText"] != entry.Level.String() {
+		w.t.Error("severityText", record["severityText"])
+	}
+	switch entry.Message {
+	case "trace", "span":
+		if record["traceId"] != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			w.t.Error("traceId", record["traceId"])
+		}
+	case "invalid":
+		if record["traceId"] != nil {
+			w.t.Error("traceId", record["traceId"])
+		}
+	}
+	if entry.Message == "span" && record["spanId"] != "00f067aa0ba902b7" {
+		w.t.Error("spanId", record["spanId"])
+	}
+	if entry.Message == "float" {
+		values := map[string]any{"float": 1.5, "nan": "NaN", "inf": "Infinity", "-inf": "-Infinity"}
+		attrs, _ := record["attributes"].([]any)
+		for _, attr := range attrs {
+			attr, _ := attr.(map[string]any)
+			value, _ := attr["value"].(map[string]any)
+			key, _ := attr["key"].(string)
+			if values[key] != value["doubleValue"] {
+				w.t.Error("doubleValue", key, value)
+			}
+			delete(values, key)
+		}
+		if len(values) != 0 {
+			w.t.Error("doubleValue", values)
+		}
+	}
+}
+
+func TestLoggerFormatterOTLP(t *testing.T) {
+	log := NewLogger(&LoggerConfig{
+		Stdout:    false,
+		Formatter: "otlp",
+		Handlers:  []LoggerHandler{&loggerWriterOTLP{t}},
+	})
+	loggerWriteData(log)
+	log.WithField("utf8", "世界\\ \n \r \t \002 \321 \u2028").Warning("utf8")
+	log.WithField(FieldXTraceID, "4bf92f3577b34da6a3ce929d0e0e4736").Info("trace")
+	log.WithField(FieldXTraceID, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01").Info("span")
+	log.WithField(FieldXTraceID, "invalid").Error("invalid")
+	log.WithField(FieldTraceID, "4bf92f3577b34da6a3ce929d0e0e4736").WithField(FieldSpanID, "00f067aa0ba902b7").Info("fields")
+	log.WithFields([]string{"float", "nan", "inf", "-inf"}, []any{1.5, math.NaN(), math.Inf(1), math.Inf(-1)}).Info("float")
+
+	log = NewLogger(&LoggerConfig{
+		Stdout:    false,
+		Formatter: "disable",
+		Handlers:  []LoggerHandler{NewLoggerFormatterOTLP(map[string]string{"service.name": "eudore", "host.name": "localhost"}), &loggerWriterOTLP{t}},
+	})
+	log.Info("resource")
+}
+
 type (
 	marsha1 struct{}
 	marsha2 struct{}
@@ -10,7 +10,6 @@ import (
 	"github.com/eudore/eudore/middleware"
 	"github.com/google/uuid"
 	"github.com/klauspost/compress/zstd"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 //go:embed *.go
@@ -54,7 +53,10 @@ func main() {
 			},
 		}),
 	)
-	app.GetFunc("/metrics", promhttp.Handler())
+	metrics := eudore.NewMetrics()
+	app.SetValue(eudore.ContextKeyMetrics, metrics)
+	app.AddMiddleware(middleware.NewMetricsFunc(metrics))
+	app.GetFunc("/metrics", middleware.NewMetricsExportFunc(metrics))
 	app.GetFunc("/health", middleware.NewHealthCheckFunc(app))
 	app.AddHandler("404", "", eudore.HandlerRouter404)
 	app.AddHandler("405", "", eudore.HandlerRouter405)
@@ -2,18 +2,30 @@ package eudore_test
 
 import (
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"math/big"
 	"net"
 	"net/http"
+	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -105,6 +117,199 @@ func TestMiddlewareBearerAuth(*testing.T) {
 	app.Run()
 }
 
+func jwtSigned(alg, kid string, key any, claims any) string {
+	head, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	unsigned := base64.RawURLEncoding.EncodeToString(head) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+
+	hashs := map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512}
+	var sig []byte
+	switch key := key.(type) {
+	case []byte:
+		h := hmac.New(hashs[alg[2:]].New, key)
+		h.Write([]byte(unsigned))
+		sig = h.Sum(nil)
+	case *rsa.PrivateKey:
+		h := hashs[alg[2:]].New()
+		h.Write([]byte(unsigned))
+		if alg[0] == 'P' {
+			sig, _ = rsa.SignPSS(rand.Reader, key, hashs[alg[2:]], h.Sum(nil), nil)
+		} else {
+			sig, _ = rsa.SignPKCS1v15(rand.Reader, key, hashs[alg[2:]], h.Sum(nil))
+		}
+	case *ecdsa.PrivateKey:
+		h := hashs[alg[2:]].New()
+		h.Write([]byte(unsigned))
+		r, s, _ := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+		size := (key.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, size*2)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, []byte(unsigned))
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestMiddlewareBearerAuthJWT(t *testing.T) {
+	type user struct {
+		Userid     int    `json:"userid"`
+		Issuer     string `json:"iss,omitempty"`
+		Audience   any    `json:"aud,omitempty"`
+		NotBefore  int64  `json:"nbf,omitempty"`
+		Expiration int64  `json:"exp,omitempty"`
+	}
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	p256, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	p521, _ := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	edKey := ed25519.NewKeyFromSeed(make([]byte, 32))
+	b64 := func(data []byte) string { return base64.RawURLEncoding.EncodeToString(data) }
+	jwks := func(keys ...map[string]string) []byte {
+		data, _ := json.Marshal(map[string]any{"keys": keys})
+		return data
+	}
+	jwkRSA := map[string]string{
+		"kty": "RSA", "kid": "rsa", "n": b64(rsaKey.N.Bytes()),
+		"e": b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+	}
+	jwkEC := map[string]string{
+		"kty": "EC", "kid": "p256", "crv": "P-256",
+		"x": b64(p256.X.Bytes()), "y": b64(p256.Y.Bytes()),
+	}
+	jwkOKP := map[string]string{
+		"kty": "OKP", "kid": "ed25519", "crv": "Ed25519", "x": b64(edKey.Public().(ed25519.PublicKey)),
+	}
+	jwkOct := map[string]string{"kty": "oct", "kid": "hmac", "k": b64([]byte("secret"))}
+	jwkEnc := map[string]string{"kty": "RSA", "kid": "enc", "use": "enc"}
+
+	var served atomic.Value
+	served.Store(jwks(jwkRSA, jwkEC))
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.GetFunc("/jwks", func(ctx Context) {
+		ctx.SetHeader(HeaderContentType, MimeApplicationJSON)
+		ctx.Write(served.Load().([]byte))
+	})
+	handler := func(ctx Context) { ctx.WriteString(ctx.GetParam(ParamUserid)) }
+	app.GetFunc("/jwt/*", NewBearerAuthFunc(map[string]any{
+		"hmac":    []byte("secret"),
+		"hs384":   []byte("secret"),
+		"rsa":     &rsaKey.PublicKey,
+		"p256":    &p256.PublicKey,
+		"p384":    &p384.PublicKey,
+		"p521":    &p521.PublicKey,
+		"ed25519": edKey.Public(),
+	}, NewOptionBearerClaims("eudore", "api", time.Minute)), handler)
+	app.GetFunc("/single/*", NewBearerAuthFunc(&rsaKey.PublicKey), handler)
+	app.GetFunc("/url/*", NewBearerAuthFunc(nil,
+		NewOptionBearerJWKS(app, "http://"+DefaultClientInternalHost+"/jwks", 20*time.Millisecond),
+	), handler)
+
+	file := t.TempDir() + "/jwks.json"
+	os.WriteFile(file, jwks(jwkOKP, jwkOct, jwkEnc), 0o600)
+	app.GetFunc("/file/*", NewBearerAuthFunc(nil, NewOptionBearerJWKS(app, file, 0)), handler)
+	// invalid keys are skipped, and unknown kid does not use the single key
+	mixed := t.TempDir() + "/mixed.json"
+	os.WriteFile(mixed, jwks(map[string]string{"kty": "RSA", "kid": "bad", "n": "-"}, jwkOKP), 0o600)
+	app.GetFunc("/mixed/*", NewBearerAuthFunc(&rsaKey.PublicKey, NewOptionBearerJWKS(app, mixed, 0)), handler)
+	app.GetFunc("/fallback/*", NewBearerAuthFunc(&rsaKey.PublicKey,
+		NewOptionBearerJWKS(app, mixed+".none", 0),
+	), handler)
+
+	check := func(path, token string, status int) {
+		app.GetRequest(path, NewClientOptionBearerAuth(token), func(resp *http.Response) error {
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != status {
+				t.Error("jwt", path, resp.StatusCode, string(body))
+			}
+			return nil
+		})
+	}
+	now := time.Now().Unix()
+	claims := &user{Userid: 1, Issuer: "eudore", Audience: "api"}
+	for _, c := range []struct {
+		alg, kid string
+		key      any
+	}{
+		{"HS256", "hmac", []byte("secret")},
+		{"HS384", "hs384", []byte("secret")},
+		{"HS512", "hmac", []byte("secret")},
+		{"RS256", "rsa", rsaKey},
+		{"RS384", "rsa", rsaKey},
+		{"RS512", "rsa", rsaKey},
+		{"PS256", "rsa", rsaKey},
+		{"PS384", "rsa", rsaKey},
+		{"PS512", "rsa", rsaKey},
+		{"ES256", "p256", p256},
+		{"ES384", "p384", p384},
+		{"ES512", "p521", p521},
+		{"EdDSA", "ed25519", edKey},
+	} {
+		check("/jwt/", jwtSigned(c.alg, c.kid, c.key, claims), StatusOK)
+	}
+	check("/jwt/", jwtSigned("RS256", "p256", rsaKey, claims), StatusUnauthorized)
+	check("/jwt/", jwtSigned("ES256", "p384", p256, claims), StatusUnauthorized)
+	check("/jwt/", jwtSigned("HS256", "rsa", []byte("secret"), claims), StatusUnauthorized)
+	check("/jwt/", jwtSigned("EdDSA", "rsa", edKey, claims), StatusUnauthorized)
+	check("/jwt/", jwtSigned("none", "hmac", nil, claims), StatusUnauthorized)
+	check("/jwt/", jwtSigned("HS256", "none", []byte("secret"), claims), StatusUnauthorized)
+	check("/jwt/", jwtSigned("HS256", "hmac", []byte("secret"), &user{
+		Userid: 1, Issuer: "eudore", Audience: []string{"web", "api"},
+		NotBefore: now + 30, Expiration: now - 30,
+	}), StatusOK)
+	check("/jwt/", jwtSigned("HS256", "hmac", []byte("secret"), &user{
+		Userid: 1, Issuer: "eudore", Audience: "api", NotBefore: now + 120,
+	}), StatusUnauthorized)
+	check("/jwt/", jwtSigned("HS256", "hmac", []byte("secret"), &user{
+		Userid: 1, Issuer: "other", Audience: "api",
+	}), StatusUnauthorized)
+	check("/jwt/", jwtSigned("HS256", "hmac", []byte("secret"), &user{
+		Userid: 1, Issuer: "eudore", Audience: []string{"web"},
+	}), StatusUnauthorized)
+	check("/jwt/", jwtSigned("HS256", "hmac", []byte("secret"), &user{
+		Userid: 1, Issuer: "eudore", Audience: 1,
+	}), StatusUnauthorized)
+	check("/single/", jwtSigned("RS256", "", rsaKey, claims), StatusOK)
+	check("/single/", jwtSigned("RS256", "other", rsaKey, claims), StatusOK)
+
+	// jwks
+	check("/url/", jwtSigned("RS256", "rsa", rsaKey, claims), StatusOK)
+	check("/url/", jwtSigned("ES256", "p256", p256, claims), StatusOK)
+	check("/url/", jwtSigned("EdDSA", "ed25519", edKey, claims), StatusUnauthorized)
+	served.Store(jwks(jwkOKP))
+	time.Sleep(80 * time.Millisecond)
+	check("/url/", jwtSigned("RS256", "rsa", rsaKey, claims), StatusUnauthorized)
+	check("/url/", jwtSigned("EdDSA", "", edKey, claims), StatusOK)
+	check("/file/", jwtSigned("EdDSA", "ed25519", edKey, claims), StatusOK)
+	check("/file/", jwtSigned("HS256", "hmac", []byte("secret"), claims), StatusOK)
+	check("/file/", jwtSigned("HS256", "", []byte("secret"), claims), StatusUnauthorized)
+	check("/mixed/", jwtSigned("EdDSA", "ed25519", edKey, claims), StatusOK)
+	check("/mixed/", jwtSigned("RS256", "other", rsaKey, claims), StatusUnauthorized)
+	check("/mixed/", jwtSigned("RS256", "bad", rsaKey, claims), StatusUnauthorized)
+	check("/fallback/", jwtSigned("RS256", "other", rsaKey, claims), StatusOK)
+
+	for _, data := range [][]byte{
+		[]byte("{"),
+		jwks(map[string]string{"kty": "RSA", "kid": "rsa", "n": "-"}),
+		jwks(map[string]string{"kty": "EC", "kid": "ec", "crv": "P-0", "x": "AA", "y": "AA"}),
+		jwks(map[string]string{"kty": "OKP", "kid": "okp", "crv": "X25519", "x": "AA"}),
+		jwks(map[string]string{"kty": "oct", "kid": "oct", "k": "-"}),
+	} {
+		os.WriteFile(file, data, 0o600)
+		NewBearerAuthFunc(nil, NewOptionBearerJWKS(app, file, 0))
+	}
+	NewBearerAuthFunc(nil, NewOptionBearerJWKS(app, file+".none", 0))
+	NewBearerAuthFunc(nil, NewOptionBearerJWKS(context.Background(),
+		"http://"+DefaultClientInternalHost+"/none", 0))
+	served.Store([]byte("{"))
+	time.Sleep(40 * time.Millisecond)
+
+	app.CancelFunc()
+	app.Run()
+}
+
 func TestMiddlewareDigestAuth(*testing.T) {
 	users := map[string]string{"root": "1"}
 	app := NewApp()
@@ -129,6 +334,139 @@ func TestMiddlewareDigestAuth(*testing.T) {
 	app.Run()
 }
 
+func TestMiddlewareSignatureAuth(t *testing.T) {
+	edKey := ed25519.NewKeyFromSeed(make([]byte, 32))
+	p256, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	keys := map[string]any{
+		"hmac":    []byte("secret"),
+		"string":  "secret",
+		"ed25519": edKey.Public(),
+		"p256":    &p256.PublicKey,
+		"p384":    &p384.PublicKey,
+		"rsa":     &rsaKey.PublicKey,
+		"invalid": 0,
+	}
+
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.AnyFunc("/sign/* path", NewSignatureAuthFunc(keys), func(ctx Context) {
+		ctx.WriteString(ctx.GetParam(ParamUserid))
+	})
+	app.AnyFunc("/path/* path", NewSignatureAuthFunc(keys,
+		NewOptionSignatureComponents("@method", "@path"),
+		NewOptionSignatureMaxAge(time.Second),
+	), func(ctx Context) {
+		ctx.WriteString(ctx.GetParam(ParamUserid))
+	})
+
+	check := func(status int, userid string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != status || (status == StatusOK && string(body) != userid) {
+				t.Error("signature", resp.Request.URL.Path, resp.StatusCode, string(body))
+			}
+			return nil
+		}
+	}
+	signer := func(keyid string, key any, components ...string) ClientHook {
+		return NewClientHookSigner(NewClientSignerHTTPMessage(keyid, key, components...))
+	}
+	header := func(input, sig string) func(*http.Request) {
+		return func(req *http.Request) {
+			req.Header.Set(HeaderSignatureInput, input)
+			req.Header.Set(HeaderSignature, sig)
+		}
+	}
+
+	for keyid, key := range map[string]any{
+		"hmac":    []byte("secret"),
+		"string":  []byte("secret"),
+		"ed25519": edKey,
+		"p256":    p256,
+		"p384":    p384,
+		"rsa":     rsaKey,
+	} {
+		client := app.NewClient(signer(keyid, key))
+		client.GetRequest("/sign/get?id="+keyid, check(StatusOK, keyid))
+		client.PostRequest("/sign/post", strings.NewReader(keyid), check(StatusOK, keyid))
+	}
+	app.NewClient(signer("p384", p256)).GetRequest("/sign/", check(StatusUnauthorized, ""))
+	app.NewClient(signer("rsa", edKey)).GetRequest("/sign/", check(StatusUnauthorized, ""))
+	app.NewClient(signer("none", []byte("secret"))).GetRequest("/sign/", check(StatusUnauthorized, ""))
+	app.NewClient(signer("invalid", []byte("secret"))).GetRequest("/sign/", check(StatusUnauthorized, ""))
+	app.NewClient(signer("hmac", []byte("secret"), "@method", "@path")).
+		GetRequest("/sign/", check(StatusUnauthorized, ""))
+	app.NewClient(signer("hmac", []byte("secret"), "@method", "@path")).
+		PostRequest("/path/", strings.NewReader("body"), check(StatusUnauthorized, ""))
+	app.NewClient(signer("hmac", []byte("secret"), "@method", "@path", "content-digest")).
+		PostRequest("/path/", strings.NewReader("body"), check(StatusOK, "hmac"))
+
+	// digest
+	client := app.NewClient(signer("hmac", []byte("secret")))
+	client.PostRequest("/sign/", strings.NewReader("body"),
+		NewClientHeader(HeaderContentDigest, "sha-512=:"+base64.StdEncoding.EncodeToString(
+			func() []byte { s := sha512.Sum512([]byte("body")); return s[:] }())+":"),
+		check(StatusOK, "hmac"),
+	)
+	client.PostRequest("/sign/", strings.NewReader("body"),
+		NewClientHeader(HeaderContentDigest, "sha-256=:AAAA:"), check(StatusUnauthorized, ""),
+	)
+	client.PostRequest("/sign/", strings.NewReader("body"),
+		NewClientHeader(HeaderContentDigest, "md5=:AAAA:"), check(StatusUnauthorized, ""),
+	)
+
+	// rsa-v1_5-sha256
+	req, _ := http.NewRequest(MethodGet, "http://"+DefaultClientInternalHost+"/sign/", nil)
+	input := fmt.Sprintf(`("@method" "@target-uri");created=%d;keyid="rsa";alg="rsa-v1_5-sha256"`,
+		time.Now().Unix())
+	base, _ := GetHTTPSignatureBase(req, input)
+	sum := sha256.Sum256(base)
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, sum[:])
+	app.GetRequest("/sign/", header("sig1="+input,
+		"sig1=:"+base64.StdEncoding.EncodeToString(sig)+":"), check(StatusOK, "rsa"))
+
+	// RFC 9421 section 2.2.8 query parameters, the base is built by hand
+	query := "var=this%20is%20a%20big%0Avalue&bar=with+plus+whitespace" +
+		"&fa%C3%A7ade%22%3A%20=something"
+	input = fmt.Sprintf(`("@method" "@target-uri" "@query-param";name="var" "@query-param";name="bar"`+
+		` "@query-param";name="fa%%C3%%A7ade%%22%%3A%%20");created=%d;keyid="hmac"`,
+		time.Now().Unix())
+	h := hmac.New(sha256.New, []byte("secret"))
+	h.Write([]byte(`"@method": GET
+"@target-uri": http://` + DefaultClientInternalHost + `/sign/parameters?` + query + `
+"@query-param";name="var": this%20is%20a%20big%0Avalue
+"@query-param";name="bar": with%20plus%20whitespace
+"@query-param";name="fa%C3%A7ade%22%3A%20": something
+"@signature-params": ` + input))
+	app.GetRequest("/sign/parameters?"+query, header("sig1="+input,
+		"sig1=:"+base64.StdEncoding.EncodeToString(h.Sum(nil))+":"), check(StatusOK, "hmac"))
+
+	// invalid
+	created := time.Now().Unix()
+	for _, h := range [][2]string{
+		{"", ""},
+		{`sig1="@method"`, ""},
+		{`sig1=("@method");keyid="hmac"`, ""},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri");created=%d;keyid="hmac"`, created-3600), ""},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri");created=%d;expires=%d;keyid="hmac"`, created, created-1), ""},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri");created=%d;keyid="hmac"`, created), "sig1=:-:"},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri" "x-none");created=%d;keyid="hmac"`, created), "sig1=:AAAA:"},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri");created=%d;keyid="hmac", sig2=("@method");created=%d`, created, created), "sig1=:AAAA:, sig2=:AAAA:"},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri");created=%d;keyid="p256";alg="hmac-sha256"`, created), "sig1=:AAAA:"},
+		{fmt.Sprintf(`sig1=("@method" "@target-uri");created=%d;keyid="rsa";alg="rsa"`, created), "sig1=:AAAA:"},
+	} {
+		app.GetRequest("/sign/", header(h[0], h[1]), check(StatusUnauthorized, ""))
+	}
+	app.GetRequest("/sign/", strings.NewReader("body"), func(req *http.Request) {
+		req.Body = io.NopCloser(bodyNoreader{})
+	}, header(fmt.Sprintf(`sig1=("@method");created=%d;keyid="hmac"`, created), ""))
+
+	app.CancelFunc()
+	app.Run()
+}
+
 type metadatable func() any
 
 func (fn metadatable) Metadata() any {
@@ -500,6 +838,164 @@ func TestMiddlewareCompressMixins(*testing.T) {
 	app.Run()
 }
 
+func TestMiddlewareCompressNegotiate(t *testing.T) {
+	body := strings.Repeat("0123456789abcdef", 256)
+	app := NewApp()
+	app.AddMiddleware(NewCompressionMixinsFunc(nil,
+		NewOptionCompressionLength(64),
+		NewOptionCompressionMime(MimeTextPlain, "application/*"),
+		NewOptionCompressionEncoder(CompressionNameZstandard, NewCompressionWriterZstandard(3)),
+		NewOptionCompressionEncoder(CompressionNameBrotli, NewCompressionWriterBrotli(5)),
+	))
+	app.AnyFunc("/*", func(ctx Context) {
+		ctx.SetHeader(HeaderContentType, MimeTextPlainCharsetUtf8)
+		ctx.WriteString(body)
+	})
+	app.AnyFunc("/small", func(ctx Context) {
+		ctx.SetHeader(HeaderContentType, MimeTextPlain)
+		ctx.WriteString(body[:32])
+	})
+	app.AnyFunc("/html", func(ctx Context) {
+		ctx.SetHeader(HeaderContentType, MimeTextHTML)
+		ctx.WriteString(body)
+	})
+	app.AnyFunc("/json", func(ctx Context) {
+		ctx.SetHeader(HeaderContentType, MimeApplicationJSON)
+		ctx.WriteString(body)
+	})
+
+	encoding := func(path, accept, name string) {
+		app.GetRequest(path, http.Header{HeaderAcceptEncoding: {accept}},
+			func(resp *http.Response) error {
+				if resp.Header.Get(HeaderContentEncoding) != name {
+					t.Errorf("%s %s encoding %q, want %q", path, accept,
+						resp.Header.Get(HeaderContentEncoding), name)
+				}
+				if name == CompressionNameGzip {
+					r, err := gzip.NewReader(resp.Body)
+					if err != nil {
+						return err
+					}
+					data, _ := io.ReadAll(r)
+					if string(data) != body {
+						t.Errorf("%s %s invalid gzip body", path, accept)
+					}
+				}
+				return nil
+			},
+		)
+	}
+	encoding("/", "gzip", CompressionNameGzip)
+	encoding("/", "gzip, deflate, br, zstd", CompressionNameZstandard)
+	encoding("/", "gzip;q=1.0, br;q=0.8, zstd;q=0.5", CompressionNameGzip)
+	encoding("/", "deflate, gzip, br;q=0", CompressionNameGzip)
+	encoding("/", "zstd;q=0, *", CompressionNameBrotli)
+	encoding("/", "gzip;q=0.5, *;q=0.2", CompressionNameGzip)
+	encoding("/", "*;q=0", "")
+	encoding("/", "gzip;q=0", "")
+	encoding("/", "gzip;q=x", "")
+	encoding("/", "identity", "")
+	encoding("/", "x-gzip", "")
+	encoding("/small", "gzip", "")
+	encoding("/html", "gzip", "")
+	encoding("/json", "gzip", CompressionNameGzip)
+
+	app.CancelFunc()
+	app.Run()
+
+	// zstd and brotli are not default
+	app = NewApp()
+	app.AddMiddleware(NewCompressionMixinsFunc(nil))
+	app.AnyFunc("/*", func(ctx Context) {
+		ctx.WriteString(body)
+	})
+	encoding("/", "br, zstd", "")
+	encoding("/", "gzip, deflate, br, zstd", CompressionNameGzip)
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestMiddlewareCompressWriter(t *testing.T) {
+	type compressor interface {
+		Reset(w io.Writer)
+		Write(b []byte) (int, error)
+		Flush() error
+		Close() error
+	}
+	data := []byte(strings.Repeat("eudore compress writer 0123456789\n", 4096))
+	for i := range data {
+		if i%97 == 0 {
+			data[i] = byte(i)
+		}
+	}
+	// incompressible tail for raw blocks
+	seed := uint32(1)
+	for i := 0; i < 1<<16; i++ {
+		seed = seed*1664525 + 1013904223
+		data = append(data, byte(seed>>24))
+	}
+	data = append(data, data[:1<<17]...)
+
+	type writer struct {
+		name     string
+		fn       func() any
+		decoders []func(io.Reader) (io.Reader, error)
+	}
+	var writers []writer
+	for quality := 0; quality <= 11; quality++ {
+		writers = append(writers, writer{
+			fmt.Sprintf("br%d", quality), NewCompressionWriterBrotli(quality),
+			[]func(io.Reader) (io.Reader, error){
+				DefaultDecompressionDecoder[CompressionNameBrotli],
+			},
+		})
+	}
+	for level := 1; level <= 22; level++ {
+		writers = append(writers, writer{
+			fmt.Sprintf("zstd%d", level), NewCompressionWriterZstandard(level),
+			[]func(io.Reader) (io.Reader, error){
+				DefaultDecompressionDecoder[CompressionNameZstandard],
+			},
+		})
+	}
+	for _, writer := range writers {
+		w := writer.fn().(compressor)
+		for _, size := range []int{0, 1, 100, 1 << 17, len(data)} {
+			// multi chunk writes with flush, and reuse writer by reset
+			buf := &bytes.Buffer{}
+			w.Reset(buf)
+			for pos, chunk := 0, 1; pos < size; chunk = chunk*7 + 1 {
+				end := pos + chunk
+				if end > size {
+					end = size
+				}
+				w.Write(data[pos:end])
+				if chunk%3 == 0 {
+					w.Flush()
+				}
+				pos = end
+			}
+			w.Close()
+			if buf.Len() == 0 || size == len(data) && buf.Len() > size/2 {
+				t.Errorf("%s compress %d bytes to %d", writer.name, size, buf.Len())
+			}
+
+			for _, decoder := range writer.decoders {
+				r, err := decoder(bytes.NewReader(buf.Bytes()))
+				if err != nil {
+					t.Errorf("%s decode %d bytes error: %v", writer.name, size, err)
+					continue
+				}
+				out, err := io.ReadAll(r)
+				if err != nil || !bytes.Equal(out, data[:size]) {
+					t.Errorf("%s decode %d bytes to %d error: %v", writer.name, size, len(out), err)
+				}
+			}
+		}
+	}
+}
+
 func TestMiddlewareLook(*testing.T) {
 	var i interface{}
 	config := map[interface{}]interface{}{
@@ -642,3 +1138,196 @@ func TestMiddlewareTimeout(*testing.T) {
 	app.CancelFunc()
 	app.Run()
 }
+
+func TestMiddlewareTrace(t *testing.T) {
+	exporter := NewTraceExporterMemory()
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	app.AddMiddleware(NewTraceFunc(exporter))
+	app.GetFunc("/api/:id", func(ctx Context) error {
+		return app.NewClient(NewClientHookTrace(exporter)).
+			GetRequest("/down", GetTraceSpan(ctx.Context()).WithContext(app), NewClientCheckStatus(StatusOK))
+	})
+	app.GetFunc("/down", func(ctx Context) {
+		span := GetTraceSpan(ctx.Context())
+		if span == nil || ctx.GetHeader(HeaderTraceparent) !=
+			"00-"+span.TraceID+"-"+span.ParentID+span.Traceparent()[52:] {
+			t.Error("trace down", ctx.Request().Header)
+		}
+		ctx.WriteString(span.Traceparent())
+	})
+	app.GetFunc("/500", func(ctx Context) {
+		ctx.WriteHeader(StatusInternalServerError)
+	})
+	app.GetFunc("/panic", NewRecoveryFunc(), NewTraceFunc(exporter), func(Context) {
+		panic("trace panic")
+	})
+
+	traceid := "4bf92f3577b34da6a3ce929d0e0e4736"
+	app.GetRequest("/api/1",
+		NewClientHeader(HeaderTraceparent, "00-"+traceid+"-00f067aa0ba902b7-01"),
+		NewClientHeader(HeaderTracestate, "vendor=1"),
+		NewClientHeader(HeaderBaggage, "user=eudore%201;p=1,invalid"),
+		NewClientCheckStatus(StatusOK),
+	)
+	spans := map[string]*TraceSpan{}
+	for _, span := range exporter.Spans() {
+		if span.TraceID != traceid || span.State != "vendor=1" {
+			t.Error("trace id", span.TraceID, span.State)
+		}
+		spans[span.Kind+" "+span.Name] = span
+	}
+	server, client, down := spans["server GET /api/:id"], spans["client GET"], spans["server GET /down"]
+	if len(spans) != 3 || server == nil || client == nil || down == nil ||
+		server.ParentID != "00f067aa0ba902b7" || client.ParentID != server.SpanID ||
+		down.ParentID != client.SpanID || down.Baggage["user"] != "eudore 1" ||
+		client.Attributes["http.response.status_code"] != StatusOK {
+		t.Error("trace spans", spans)
+	}
+
+	// unsampled, invalid and error
+	exporter.Reset()
+	app.GetRequest("/api/2",
+		NewClientHeader(HeaderTraceparent, "00-"+traceid+"-00f067aa0ba902b7-00"),
+	)
+	for _, val := range []string{
+		"00-" + traceid + "-00f067aa0ba902b7-01-01",
+		"ff-" + traceid + "-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-" + traceid + "-00f067aa0ba902b7-zz",
+		"00-" + traceid + "-00F067AA0BA902B7-01",
+	} {
+		app.GetRequest("/500", NewClientHeader(HeaderTraceparent, val),
+			NewClientCheckStatus(StatusInternalServerError),
+		)
+	}
+	app.GetRequest("/500", NewClientHeader(HeaderTraceparent, "01-"+traceid+"-00f067aa0ba902b7-01-01"))
+	spans2 := exporter.Spans()
+	if len(spans2) != 6 || spans2[0].TraceID == traceid ||
+		spans2[0].Error == "" || spans2[5].TraceID != traceid {
+		t.Error("trace invalid", len(spans2))
+	}
+
+	// client error
+	exporter.Reset()
+	NewClient(NewClientHookTrace(exporter)).GetRequest("http://127.0.0.1:1/")
+	spans2 = exporter.Spans()
+	if len(spans2) != 1 || spans2[0].Error == "" || spans2[0].ParentID != "" {
+		t.Error("trace client error", spans2)
+	}
+
+	// panic ends the span
+	exporter.Reset()
+	app.GetRequest("/panic", NewClientHeader(HeaderTraceparent, "00-"+traceid+"-00f067aa0ba902b7-01"))
+	if spans2 = exporter.Spans(); len(spans2) != 2 || spans2[0].EndTime.IsZero() {
+		t.Error("trace panic", spans2)
+	}
+
+	// client does not modify the request header
+	exporter.Reset()
+	req, _ := http.NewRequest(MethodGet, "http://bad/", nil)
+	resp, err := NewClientHookTrace(exporter).Wrap(&clientTransportHosts{
+		Status: map[string]int{"bad": StatusNotFound},
+	}).RoundTrip(req)
+	if err != nil || resp.StatusCode != StatusNotFound || len(req.Header) != 0 {
+		t.Error("trace client request", err, req.Header)
+	}
+	if spans2 = exporter.Spans(); len(spans2) != 1 || spans2[0].Error != "404 Not Found" {
+		t.Error("trace client status", spans2)
+	}
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestMiddlewareMetrics(t *testing.T) {
+	metrics := NewMetrics()
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLogger(&LoggerConfig{HookMeta: true}))
+	app.SetValue(ContextKeyClient, app.NewClient(NewClientHookBreaker(1, 1, time.Second, nil)))
+	app.SetValue(ContextKeyEventHub, NewEventHub[string](time.Second))
+	app.SetValue(ContextKeyMetrics, metrics)
+	app.AddMiddleware(NewMetricsFunc(metrics))
+	app.AddHandler("404", "", HandlerRouter404)
+	app.GetFunc("/metrics", NewMetricsExportFunc(metrics))
+	app.AnyFunc("/api/:id", func(ctx Context) {
+		ctx.WriteString("hello")
+	})
+
+	app.GetRequest("/api/1")
+	app.PostRequest("/api/2", strings.NewReader("body"))
+	app.GetRequest("/404")
+	app.Info("metrics")
+
+	counter := metrics.NewCounter("test_total", "Test counter\n help.", "name")
+	counter.Inc("a\"\\\n")
+	counter.Add(-1, "b")
+	counter.Add(2)
+	gauge := metrics.NewGauge("test_gauge", "", "name", "kind")
+	gauge.Set(math.Inf(1), "inf")
+	gauge.Set(math.Inf(-1), "-inf")
+	gauge.Set(math.NaN(), "nan", "x", "y")
+	gauge.Dec("dec")
+	hist := metrics.NewHistogram("test_histogram", "Test histogram.", []float64{1, 0.5})
+	hist.Observe(0.5)
+	hist.Observe(0.8)
+	hist.Observe(3)
+	metrics.NewGauge("test_empty", "Test empty.")
+	metrics.NewCounter("test_total", "Test counter.", "name")
+
+	check := func(openmetrics bool, lines ...string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			body, _ := io.ReadAll(resp.Body)
+			for _, line := range lines {
+				if !strings.Contains(string(body), line+"\n") {
+					t.Error("metrics", openmetrics, line)
+				}
+			}
+			if strings.Contains(string(body), "test_empty") ||
+				strings.HasSuffix(string(body), "# EOF\n") != openmetrics {
+				t.Error("metrics", openmetrics, string(body))
+			}
+			return nil
+		}
+	}
+	app.GetRequest("/metrics", check(false,
+		"# HELP test_total Test counter\\n help.",
+		"# TYPE test_total counter",
+		`test_total{name=""} 2`,
+		`test_total{name="a\"\\\n"} 1`,
+		`test_gauge{name="inf",kind=""} +Inf`,
+		`test_gauge{name="-inf",kind=""} -Inf`,
+		`test_gauge{name="nan",kind="x"} NaN`,
+		`test_gauge{name="dec",kind=""} -1`,
+		`test_histogram_bucket{le="0.5"} 1`,
+		`test_histogram_bucket{le="1"} 2`,
+		`test_histogram_bucket{le="+Inf"} 3`,
+		`test_histogram_sum 4.3`,
+		`test_histogram_count 3`,
+		`eudore_server_requests_total{method="GET",route="/api/:id",status="200"} 1`,
+		`eudore_server_requests_total{method="POST",route="/api/:id",status="200"} 1`,
+		`eudore_server_requests_total{method="GET",route="",status="404"} 1`,
+		`eudore_server_request_size_bytes_bucket{method="POST",route="/api/:id",status="200",le="100"} 1`,
+		`eudore_server_response_size_bytes_sum{method="GET",route="/api/:id",status="200"} 5`,
+		`eudore_server_requests_in_flight{method="GET"} 1`,
+		`eudore_logger_entries_total{key="logger",level="INFO"} 1`,
+		`eudore_metadata_health{key="client",name="eudore.clientStd"} 1`,
+		`eudore_client_breaker_requests_total{key="client",breaker="internalhost",result="success"} 3`,
+		`eudore_eventhub_topics{key="event-hub"} 0`,
+		`eudore_server_errors_total{key="server"} 0`,
+	))
+	app.GetRequest("/metrics", NewClientHeader(HeaderAccept, MimeApplicationOpenMetrics), check(true,
+		"# TYPE test counter",
+		`test_total{name=""} 2`,
+		"# TYPE test_histogram histogram",
+	))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("metrics register conflict not panic")
+		}
+		app.CancelFunc()
+		app.Run()
+	}()
+	metrics.NewGauge("test_total", "Test counter.")
+}
@@ -1,10 +1,13 @@
 package eudore_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/netip"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -273,6 +276,146 @@ func TestMiddlewareCacheData(*testing.T) {
 	app.Run()
 }
 
+type cacheStoreMap struct {
+	sync.Map
+}
+
+func (s *cacheStoreMap) Get(key string) ([]byte, error) {
+	val, ok := s.Load(key)
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return val.([]byte), nil
+}
+
+func (s *cacheStoreMap) Set(key string, val []byte, _ time.Duration) error {
+	s.Store(key, val)
+	return nil
+}
+
+func TestMiddlewareCacheStore(t *testing.T) {
+	resp := &CacheResponse{
+		Expired: time.Now().Add(time.Millisecond * 20),
+		Status:  200,
+		Header:  http.Header{HeaderContentType: {MimeTextPlain}},
+		Body:    []byte("hello eudore"),
+	}
+	lru := NewCacheDataLRU(2, 128)
+	lru.SaveData("1", resp)
+	lru.SaveData("2", resp)
+	lru.LoadData("1")
+	lru.SaveData("3", resp)
+	if lru.LoadData("1") == nil || lru.LoadData("2") != nil || lru.LoadData("3") == nil {
+		t.Error("lru evict entries error")
+	}
+	lru.SaveData("big", &CacheResponse{Expired: resp.Expired, Body: make([]byte, 256)})
+	lru.SaveData("4", resp)
+	if lru.LoadData("big") != nil || lru.LoadData("3") == nil || lru.LoadData("4") == nil {
+		t.Error("lru evict bytes error")
+	}
+
+	dir := t.TempDir()
+	for _, storage := range []CacheData{
+		NewCacheDataMap(),
+		lru,
+		NewCacheDataFile(dir),
+		NewCacheDataStore(&cacheStoreMap{}),
+	} {
+		storage.SaveData("/index", resp)
+		data := storage.LoadData("/index")
+		if data == nil || data.Status != 200 || string(data.Body) != "hello eudore" ||
+			data.Header.Get(HeaderContentType) != MimeTextPlain {
+			t.Errorf("%T load error: %v", storage, data)
+		}
+		if storage.LoadData("/none") != nil {
+			t.Errorf("%T load none error", storage)
+		}
+	}
+	if (&CacheResponse{}).UnmarshalBinary([]byte("0 200\r\n")) == nil {
+		t.Error("unmarshal must error")
+	}
+
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	app.AddMiddleware(NewCacheFunc(time.Millisecond*5,
+		NewOptionCacheData(NewCacheDataFile(dir)),
+		NewOptionCacheCleanup(app.Context, time.Millisecond*20),
+	))
+	var count int
+	app.AnyFunc("/*", func(ctx Context) {
+		count++
+		ctx.WriteString("hello " + ctx.GetQuery("name"))
+	})
+	app.GetRequest("/?name=eudore")
+	app.GetRequest("/?name=eudore", func(resp *http.Response) error {
+		body, _ := io.ReadAll(resp.Body)
+		if count != 1 || string(body) != "hello eudore" {
+			t.Error("cache file error", count, string(body))
+		}
+		return nil
+	})
+	time.Sleep(time.Millisecond * 50)
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Error("cleanup file error", len(entries))
+	}
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestMiddlewareCacheETag(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	app.AddMiddleware(NewCacheFunc(time.Second,
+		NewOptionCacheControl("public, max-age=60"),
+	))
+	app.GetFunc("/*", func(ctx Context) {
+		ctx.WriteString("hello eudore")
+	})
+
+	app.GetRequest("/", func(resp *http.Response) error {
+		if resp.Header.Get(HeaderCacheControl) != "public, max-age=60" ||
+			resp.Header.Get(HeaderLastModified) == "" ||
+			resp.Header.Get(HeaderETag) != NewETag([]byte("hello eudore"), false) {
+			t.Error("cache header error", resp.Header)
+		}
+		return nil
+	})
+	app.GetRequest("/miss",
+		NewClientHeader(HeaderIfNoneMatch, NewETag([]byte("hello eudore"), false)),
+		func(resp *http.Response) error {
+			if resp.StatusCode != StatusNotModified || resp.Header.Get(HeaderETag) == "" {
+				t.Error("cache miss 304 error", resp.Status, resp.Header)
+			}
+			return nil
+		},
+	)
+	var etag, modified string
+	app.GetRequest("/", func(resp *http.Response) error {
+		etag = resp.Header.Get(HeaderETag)
+		modified = resp.Header.Get(HeaderLastModified)
+		if resp.StatusCode != 200 || etag != NewETag([]byte("hello eudore"), false) {
+			t.Error("cache etag error", resp.Status, resp.Header)
+		}
+		return nil
+	})
+	check304 := func(resp *http.Response) error {
+		if resp.StatusCode != StatusNotModified {
+			t.Error("cache 304 error", resp.Status)
+		}
+		return nil
+	}
+	app.GetRequest("/", NewClientHeader(HeaderIfNoneMatch, etag), check304)
+	app.GetRequest("/", NewClientHeader(HeaderIfModifiedSince, modified), check304)
+	app.GetRequest("/", NewClientHeader(HeaderIfNoneMatch, `"eudore"`),
+		NewClientCheckStatus(200),
+	)
+
+	app.CancelFunc()
+	app.Run()
+}
+
 func TestMiddlewareRateRequest(*testing.T) {
 	app := NewApp()
 	app.AnyFunc("/*", NewRateRequestFunc(1, 3, NewOptionRateState()))
@@ -304,6 +447,130 @@ func TestMiddlewareRateRequest(*testing.T) {
 	app.Run()
 }
 
+type rateStoreMap struct {
+	sync.Mutex
+	data     map[string][]byte
+	conflict bool
+	ttl      time.Duration
+}
+
+func (s *rateStoreMap) Get(key string) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+	if key == "error" {
+		return nil, fmt.Errorf("rate store error")
+	}
+	return s.data[key], nil
+}
+
+func (s *rateStoreMap) CompareAndSwap(key string, old, val []byte,
+	ttl time.Duration,
+) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.ttl = ttl
+	cur, ok := s.data[key]
+	if s.conflict || ok != (old != nil) || !bytes.Equal(cur, old) {
+		return false, nil
+	}
+	s.data[key] = val
+	return true, nil
+}
+
+func TestMiddlewareRateAlgorithm(t *testing.T) {
+	algorithms := []RateAlgorithm{
+		NewRateAlgorithmGCRA(1, 3),
+		NewRateAlgorithmTokenBucket(1, 3),
+		NewRateAlgorithmSlidingWindow(3, time.Second*3),
+	}
+	now := time.Unix(1700000001, 0)
+	for _, algorithm := range algorithms {
+		var state []byte
+		var result RateResult
+		for i := 0; i < 3; i++ {
+			state, result = algorithm.Take(state, now, 1)
+			if !result.Allow || result.Remaining != int64(2-i) {
+				t.Errorf("%T take %d result %#v", algorithm, i, result)
+			}
+		}
+		state, result = algorithm.Take(state, now, 1)
+		if result.Allow || result.RetryAfter <= 0 {
+			t.Errorf("%T take limit result %#v", algorithm, result)
+		}
+		_, result = algorithm.Take(state, now.Add(result.RetryAfter), 1)
+		if !result.Allow {
+			t.Errorf("%T take retry result %#v", algorithm, result)
+		}
+		state, _ = algorithm.Take(state, now, -1)
+		_, result = algorithm.Take(state, now, 1)
+		if !result.Allow {
+			t.Errorf("%T take put result %#v", algorithm, result)
+		}
+	}
+
+	// n greater than limit is never allowed, include the empty window.
+	window := NewRateAlgorithmSlidingWindow(3, time.Second*3)
+	for _, n := range []int64{0, 1, 3} {
+		state, _ := window.Take(nil, now, n)
+		_, result := window.Take(state, now, 4)
+		if result.Allow || result.RetryAfter <= 0 || result.RetryAfter > time.Second*6 {
+			t.Errorf("sliding window take 4 after %d result %#v", n, result)
+		}
+	}
+	for _, args := range [][2]int64{{0, 1}, {1, 0}, {-1, 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("sliding window %v not panic", args)
+				}
+			}()
+			NewRateAlgorithmSlidingWindow(args[0], time.Duration(args[1]))
+		}()
+	}
+
+	shared := &rateStoreMap{data: make(map[string][]byte)}
+	app := NewApp()
+	app.AddMiddleware("global", NewLoggerLevelFunc(func(Context) int { return 4 }))
+	app.AnyFunc("/*", NewRateRequestFunc(1, 3,
+		NewOptionRateState(),
+		NewOptionRateStore(NewRateStoreShared(shared, 3)),
+		NewOptionRateAlgorithm(NewRateAlgorithmSlidingWindow(3, time.Minute)),
+		NewOptionKeyFunc(func(ctx Context) string {
+			return ctx.GetQuery("key")
+		}),
+	))
+	for i := 0; i < 4; i++ {
+		app.GetRequest("/?key=shared", func(resp *http.Response) error {
+			remaining := resp.Header.Get(HeaderRateLimitRemaining)
+			switch {
+			case i < 3 && (resp.StatusCode != 200 || remaining != strconv.Itoa(2-i)):
+				t.Errorf("rate %d status %d remaining %s", i, resp.StatusCode, remaining)
+			case i == 3 && (resp.StatusCode != 429 || resp.Header.Get(HeaderRetryAfter) == ""):
+				t.Errorf("rate limit status %d", resp.StatusCode)
+			}
+			if resp.Header.Get(HeaderRateLimitPolicy) != "3;w=60" {
+				t.Errorf("rate policy %s", resp.Header.Get(HeaderRateLimitPolicy))
+			}
+			if resp.Header.Get(HeaderXRateLimit) != "3" ||
+				resp.Header.Get(HeaderXRateRemaining) != remaining ||
+				resp.Header.Get(HeaderXRateReset) == "" {
+				t.Errorf("rate x header %v", resp.Header)
+			}
+			return nil
+		})
+	}
+	if len(shared.data) != 1 || shared.ttl != 2*time.Minute {
+		t.Errorf("rate shared store size %d ttl %s", len(shared.data), shared.ttl)
+	}
+	// store error allows the request
+	app.GetRequest("/?key=error", NewClientCheckStatus(200))
+	shared.conflict = true
+	app.GetRequest("/?key=conflict", NewClientCheckStatus(200))
+
+	app.CancelFunc()
+	app.Run()
+}
+
 func TestMiddlewareRateSpeed(*testing.T) {
 	app := NewApp()
 	app.AddMiddleware("global", NewLoggerLevelFunc(func(Context) int { return 4 }))
@@ -354,6 +621,17 @@ func TestMiddlewareRateSpeed(*testing.T) {
 			ctx.WriteString("body")
 		},
 	)
+	// store error does not limit the speed
+	app.AnyFunc("/error",
+		NewRateSpeedFunc(1, 1,
+			NewOptionRateStore(NewRateStoreShared(&rateStoreMap{}, 3)),
+			NewOptionKeyFunc(func(Context) string { return "error" }),
+		),
+		func(ctx Context) {
+			ctx.Body()
+			ctx.WriteString("store error body")
+		},
+	)
 
 	app.GetRequest("/skip")
 	app.PostRequest("/read", strings.NewReader("read body"))
@@ -363,6 +641,9 @@ func TestMiddlewareRateSpeed(*testing.T) {
 	app.PostRequest("/wait", strings.NewReader("wait body"))
 	app.PostRequest("/cannel", strings.NewReader("wait body"))
 	app.PostRequest("/write")
+	app.PostRequest("/error", strings.NewReader("store error body"),
+		NewClientCheckStatus(200), NewClientCheckBody("store error body"),
+	)
 	time.Sleep(time.Second / 10)
 
 	app.CancelFunc()
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/eudore/eudore"
 	. "github.com/eudore/eudore/middleware"
@@ -323,3 +324,134 @@ func TestMiddlewarePolicyAPI(t *testing.T) {
 	app.CancelFunc()
 	app.Run()
 }
+
+func TestMiddlewareSession(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, NewLoggerNull())
+	for i, store := range []SessionStore{
+		NewSessionStoreCookie([]byte("hash"), nil),
+		NewSessionStoreCookie([]byte("hash"), []byte("block")),
+		NewSessionStoreMap(),
+		NewSessionStoreFile(t.TempDir()),
+	} {
+		name := "sid" + strconv.Itoa(i)
+		prefix := "/" + strconv.Itoa(i)
+		api := app.Group(prefix)
+		api.AddMiddleware(NewSessionFunc(store,
+			NewOptionSessionCookie(http.Cookie{Name: name, HttpOnly: true, MaxAge: 10}),
+			NewOptionCSRFCookie(http.Cookie{Path: "/", SameSite: http.SameSiteStrictMode}),
+			NewOptionSessionExpiry(0, 0),
+			NewOptionSessionCleanup(app, time.Second),
+		))
+		api.GetFunc("/login", func(ctx Context) {
+			sess := GetSession(ctx)
+			sess.Renew()
+			sess.Set("user", 1000)
+			sess.AddFlash("welcome")
+		})
+		api.GetFunc("/user", func(ctx Context) {
+			flashes := GetSession(ctx).GetFlashes()
+			ctx.WriteString(strconv.Itoa(GetSessionValue[int](ctx, "user")) + " " +
+				strconv.Itoa(len(flashes)))
+		})
+		api.GetFunc("/delete", func(ctx Context) {
+			GetSession(ctx).Delete("user")
+			ctx.WriteHeader(StatusOK)
+		})
+		api.GetFunc("/logout", func(ctx Context) {
+			GetSession(ctx).Destroy()
+		})
+		api.GetFunc("/big", func(ctx Context) {
+			GetSession(ctx).Set("big", strings.Repeat("x", 4096))
+			ctx.WriteString("big")
+		})
+		api.GetFunc("/invalid", func(ctx Context) {
+			GetSession(ctx).Set("invalid", struct{ Name string }{})
+		})
+
+		var cookies []string
+		cookie := func(resp *http.Response) error {
+			cookies = append(cookies, resp.Header.Get(HeaderSetCookie))
+			return nil
+		}
+		client := app.NewClient(NewClientHookCookie(nil))
+		check := func(path, body string) {
+			err := client.GetRequest(prefix+path, NewClientCheckBody(body), cookie)
+			if err != nil {
+				t.Error(name, path, err)
+			}
+		}
+		check("/user", "0 0")
+		check("/login", "")
+		check("/user", "1000 1")
+		check("/user", "1000 0")
+		check("/login", "")
+		check("/delete", "")
+		check("/user", "0 1")
+		check("/logout", "")
+		check("/user", "0 0")
+		check("/big", "big")
+		check("/invalid", "")
+		// the cookie store rewrites the cookie when the session changes
+		if cookies[0] != "" || cookies[1] == "" || cookies[4] == cookies[1] ||
+			!strings.Contains(cookies[1], "SameSite=Strict") ||
+			!strings.Contains(cookies[7], "Max-Age=0") ||
+			(i < 2 && (cookies[2] == "" || cookies[9] != "")) ||
+			(i >= 2 && (cookies[2] != "" || cookies[10] != "")) {
+			t.Error(name, cookies)
+		}
+
+		// old or invalid value
+		for _, val := range []string{
+			strings.TrimPrefix(strings.Split(cookies[1], ";")[0], name+"="),
+			"invalid", "invalid.AAAA", "AAAA.AAAA", "../invalid",
+		} {
+			app.GetRequest(prefix+"/user", NewClientHeader(HeaderCookie, name+"="+val),
+				NewClientCheckBody("0 0"),
+			)
+		}
+	}
+
+	// empty hash key
+	func() {
+		defer func() {
+			if recover() != ErrSessionCookieHashKeyEmpty {
+				t.Error("session cookie empty hash key not panic")
+			}
+		}()
+		NewSessionStoreCookie(nil, []byte("block"))
+	}()
+
+	// idle expiry
+	api := app.Group("/idle")
+	api.AddMiddleware(NewSessionFunc(NewSessionStoreMap(),
+		NewOptionSessionExpiry(time.Millisecond*20, time.Hour),
+	))
+	api.GetFunc("/login", func(ctx Context) {
+		GetSession(ctx).Set("user", "eudore")
+	})
+	api.GetFunc("/user", func(ctx Context) {
+		ctx.WriteString("user " + GetSessionValue[string](ctx, "user"))
+	})
+	client := app.NewClient(NewClientHookCookie(nil))
+	client.GetRequest("/idle/login")
+	err := client.GetRequest("/idle/user", NewClientCheckBody("eudore"))
+	if err != nil {
+		t.Error(err)
+	}
+	time.Sleep(time.Millisecond * 30)
+	err = client.GetRequest("/idle/user", NewClientCheckBody("eudore"))
+	if err == nil {
+		t.Error("session idle not expired")
+	}
+
+	app.GetFunc("/none", func(ctx Context) {
+		if GetSession(ctx) != nil || GetSessionValue[string](ctx, "user") != "" {
+			t.Error("session not nil")
+		}
+	})
+	app.GetRequest("/none")
+
+	app.CancelFunc()
+	app.Run()
+}
@@ -6,8 +6,13 @@ package eudore_test
 // middleware4_test.go midd radix
 
 import (
+	"bytes"
+	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -66,6 +71,299 @@ func TestMiddlewareBodyLimit(*testing.T) {
 	app.CancelFunc()
 }
 
+func TestMiddlewareBodyDecompress(t *testing.T) {
+	body := strings.Repeat("eudore decompress body ", 16)
+	encode := func(names ...string) *strings.Reader {
+		buf := &bytes.Buffer{}
+		data := []byte(body)
+		for _, name := range names {
+			buf.Reset()
+			var w io.WriteCloser
+			switch name {
+			case CompressionNameGzip:
+				w = gzip.NewWriter(buf)
+			case CompressionNameDeflate:
+				w = zlib.NewWriter(buf)
+			case CompressionNameBrotli:
+				bw := NewCompressionWriterBrotli(11)().(decompressWriter)
+				bw.Reset(buf)
+				w = bw
+			case CompressionNameZstandard:
+				zw := NewCompressionWriterZstandard(3)().(decompressWriter)
+				zw.Reset(buf)
+				w = zw
+			default:
+				w, _ = flate.NewWriter(buf, flate.DefaultCompression)
+			}
+			w.Write(data)
+			w.Close()
+			data = append([]byte(nil), buf.Bytes()...)
+		}
+		return strings.NewReader(string(data))
+	}
+	check := func(status int, data string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			b, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != status ||
+				status == StatusOK && string(b) != data {
+				t.Errorf("decompress status %d body %q", resp.StatusCode, b)
+			}
+			return nil
+		}
+	}
+
+	app := NewApp()
+	app.AddMiddleware("global",
+		NewLoggerLevelFunc(func(Context) int { return 4 }),
+	)
+	app.AnyFunc("/*", NewBodyDecompressFunc(1024, nil), func(ctx Context) {
+		body, err := ctx.Body()
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		ctx.Write(body)
+	})
+	app.AnyFunc("/small", NewBodyDecompressFunc(64, nil), func(ctx Context) {
+		_, err := ctx.Body()
+		ctx.Fatal(err)
+	})
+	app.AnyFunc("/unlimited", NewBodyDecompressFunc(0, nil), func(ctx Context) {
+		body, err := ctx.Body()
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		ctx.Write(body)
+	})
+
+	gz := http.Header{HeaderContentEncoding: {CompressionNameGzip}}
+	app.PostRequest("/", strings.NewReader("raw body"), check(StatusOK, "raw body"))
+	app.PostRequest("/", gz, encode(CompressionNameGzip), check(StatusOK, body))
+	app.PostRequest("/", http.Header{HeaderContentEncoding: {"deflate"}},
+		encode(CompressionNameDeflate), check(StatusOK, body),
+	)
+	app.PostRequest("/", http.Header{HeaderContentEncoding: {"deflate"}},
+		encode("raw"), check(StatusOK, body),
+	)
+	app.PostRequest("/", http.Header{HeaderContentEncoding: {"deflate, GZIP"}},
+		encode(CompressionNameDeflate, CompressionNameGzip), check(StatusOK, body),
+	)
+	app.PostRequest("/", gz, strings.NewReader("not gzip"), check(StatusBadRequest, ""))
+	truncated, _ := io.ReadAll(encode(CompressionNameGzip))
+	app.PostRequest("/", gz, bytes.NewReader(truncated[:20]), check(StatusBadRequest, ""))
+	app.PostRequest("/small", gz, encode(CompressionNameGzip),
+		check(StatusRequestEntityTooLarge, ""),
+	)
+	br := http.Header{HeaderContentEncoding: {CompressionNameBrotli}}
+	zstd := http.Header{HeaderContentEncoding: {CompressionNameZstandard}}
+	app.PostRequest("/", br, encode(CompressionNameBrotli), check(StatusOK, body))
+	app.PostRequest("/", zstd, encode(CompressionNameZstandard), check(StatusOK, body))
+	app.PostRequest("/", http.Header{HeaderContentEncoding: {"zstd, br"}},
+		encode(CompressionNameZstandard, CompressionNameBrotli), check(StatusOK, body),
+	)
+	app.PostRequest("/", br, strings.NewReader("not br"), check(StatusBadRequest, ""))
+	app.PostRequest("/", zstd, strings.NewReader("not zstd"), check(StatusBadRequest, ""))
+	truncated, _ = io.ReadAll(encode(CompressionNameZstandard))
+	app.PostRequest("/", zstd, bytes.NewReader(truncated[:len(truncated)-4]),
+		check(StatusBadRequest, ""),
+	)
+	// zstd -19 --check
+	app.PostRequest("/unlimited", zstd, decompressBase64(decompressZstdVector),
+		check(StatusOK, strings.Repeat(decompressText, 4)),
+	)
+	app.PostRequest("/unlimited", gz, encode(CompressionNameGzip), check(StatusOK, body))
+	app.PostRequest("/", http.Header{HeaderContentEncoding: {"compress"}}, strings.NewReader("lzw"),
+		check(StatusUnsupportedMediaType, ""),
+		func(resp *http.Response) error {
+			if resp.Header.Get(HeaderAcceptEncoding) != "zstd, br, gzip, deflate" {
+				t.Errorf("decompress accept %q", resp.Header.Get(HeaderAcceptEncoding))
+			}
+			return nil
+		},
+	)
+
+	app.CancelFunc()
+	app.Run()
+}
+
+type decompressWriter interface {
+	io.WriteCloser
+	Reset(io.Writer)
+}
+
+const (
+	decompressText = `Eudore is a high-performance web framework for Go. The request body may be
+compressed by the client with zstd, brotli, gzip or deflate, and the
+middleware decodes the body lazily with a size limit. Zstandard frames use
+Huffman coded literals and FSE coded sequences, brotli streams use prefix
+codes, context modeling and a static dictionary of common English words.
+The Quick Brown Fox Jumps Over The Lazy Dog, the quick brown fox jumps over
+the lazy dog, THE QUICK BROWN FOX JUMPS OVER THE LAZY DOG.
+`
+	decompressZstdVector = `
+KLUv/WTUBv0KAMbXRh8Qk3X4h74asvDXPmSp6czdMTN3zDQAgGPVFREAQNXdOgA7ADsA8p2v+406
+ZGu5YrMfxNQ3N7/Bpn4tZxyu52vyQB9eu/qmtsbT1eoUa+dH3axdVcYAlxWvu9t4rg0zKdktrA5S
+d23mgRtnlOdPtHebjB8nLcN+DM/VaSwhjBOC1D3i62TF8LWPxY/1KMrblXoEr/38ulH7pRwDFpVO
+e85+r/pbdbTjfRH+xeuuvbNolAx7/nr40n6PIE+suGKfjuu1t/yolSQGK13lG+XhqZtVlSEgtgFQ
+4DAVjskIGBGpMl3yaFAUv0yGOyxTZPAFIUGBXEJMoIRaU1GcyOrCt49jVO5Mt9jMFPe04VTrOvjm
+xQwCBSysrf2yUQYaIHBIJKV33OHnI0G8cRRMHMjhuJggvINtbA+Rdu3h+mSac7OLJBrf/QkRk4Sr
+52hvJHGpsnCTnlod2LjDwq4dDZQmNBc=`
+)
+
+// decompressBrotliVectors is the reference brotli encoder output of the first
+// size bytes of decompressText repeated 16 times and every 251th byte replaced.
+var decompressBrotliVectors = []struct {
+	quality, lgwin, size int
+	data                 string
+}{
+	{0, 10, 300, `
+g5UAAICqqqrqP852OQncLfxyi+UecYqbqIqoq0CoqpiJiIKG2eF8jf+76t9zb3XPqC5vY7grXiws
+LGxsrOplRnXw4cOPwWAwjjNZVYPBYDAYDOZQZ0QqSqNwWGgn2XuCP48vk9QYxAGhyrO+HmxFrePI
+DIsTFMPOS+0Xihp86AO+K4PxOdkDktIFHS9IvGXth7E7E6QLojLkJjwClkSF24N2SKbRZIfnLQeo
+AXFpGLwDDoKovHUharzQGIizEjtEZUhKFzS8pV2wJCoguNwMTbrEA348cBAaQTHs7DCdt89ZSscB
+WYkJmgQbNgccBO9fb5D/iQmcz8kjs+dvmIKHMfZo4DM4jIv8bVmJfYcB
+`},
+	{0, 16, 8016, `
+g6cPAICqqqrqv892OTHcLfxwDw83dw8Pj7Bwd/NY/KamKmaq7qoq5qpqbm52qEtd6lKXvvSlL33p
+S1/60pe69KUvfelLX/rSl770pS996Uv/31X/nnure0Z1eRvDXfFiYWFhY2NVLzOqgw8ffgwGg3Gc
+yaoaDAaDwWAwhzojUlEahcNCO8neE/x5/FWvOBBMhIA2rX7oKDQcnPCSMFCNJghHA4cLGg5Y8QyV
+JgS69hQTalYjnBhRUybZdYFiJIV6RNIEaQ35hMEkjSkmlaMOnKzJ0U6mAwcoaqxIlEN4haQpc0Yp
+S4MIBEWSFUUkTahZjbBiMnbEYJKGQDQTwRpn0gynmIRXIig0QTiK6CNl675pnPCQrEjBmkRB2Ajh
+FZaHAvL/ihQiXXvykmL+hiliCiRcNDBDF6gx9ywv8IUckn2ie4JjRdb4Njr4wxaISSQjoYxMhr0I
+I7iBZOfYo/CtNVFj4KDiLKs0YdcbecE88OCx5Ds2vesiyhsFVJqwFdOIBbc5kiZceyMvqAMPHg3f
+ce5dF8E3ClnSBCumEYrbHNW6wO74/PSC+b78fMOy/MLm+Pp+QPlR7FGtC2wfT99YlKtZVvxPMeJ/
+frng/7qfYy76/7ifYy7Uv93PMRfqX+7nmAv6p/s55oL/4X6OuVB/dz/HXIS/uZ9jLvBX93PMBf3F
+/RxzEf/sfo65wC/u55gL+pP7OebC/NH9HHNx/YP7OeYi/t79HHPR/879HHOB37qfYy7oN+7nmAvx
+a/dzzIX/2f0cc4Gf3M8xF/JH93PMhf7B/RxzQaP7OebCJPdzzEVk93PMRXt2P8dc5OR+FrkB
+`},
+	{1, 16, 8016, `
+g6cPAICqqqrqP892OTHcLfxwz8hwj8jIyPSMLZe4qamKmYmHqoq7iFqYmx/ykpe85CUveclLXvKS
+l7zkJS95yUte8pKXvOQlL3nJS17ykvD/7+39TsCWDC8GGA7nOAyHw4D8soQ4Z59DYRj8w1tDECWw
+waHnrt9bk7aiyWVPGKlBqy7RKHqNVhSHMsNFT1DaDGQFjYQJyU1oqPKS1kpmFNBMKD3BR6ZcMHLp
+sbMSajQqJXKNbsdriCJQG12hGi4HlJ6qxCFEGp0SAnkJZEjLNauPbsdxQmStOhjvCJETlxmurLgc
+nIYnHy+Gwag6Gto2uYykzAIiF1IXDdPSLc7n8P+vMhptBsqe7H1nDytKLsXUgLVSy9sqJStWw0su
+tC1IEihy7uLqWwcrrrBHYF9YstMJ0sJLSpIxz11k6zGKBptV68vO6cD+GvsqY8ZCtjge0tqwvCFF
+ScYTt5twIF29koBNQ7VGPa1ssVKD3JBWpaet5J4QGrJ7cTTH6eXdO/ewf7Z8/ACL5RMcX95/eI7l
+o/kZSjI9uX31FAfLw1k1//fxxiB/GcPwhzGE34wh/GIM9JMxyA/GEL4zBv3GGPCVMdAXxmCfGQM+
+MQb6yBj4A2PYvGcM9o4xDG8ZA94wBnrNGNwrxpBfMga8YAz+OWPonzEGmhgDF8Zgwhi6FWOoiSID
+`},
+	{3, 10, 8016, `
+oXj6AAD+f/Nz1THqHh9WeEqzXUphabvlUzeZ95KZ7sy87HyaTQQGg8FgMBgMBoPBYDAYDAaDwWAw
+GAwGxMGJwvEgmHhgMsVEsQY0rHuOg0ISGTZBwdje3Bo4dhK9CpoxcosuKs+jxC06iTiWBTaGEXlX
+OGW0QhO8mtBypcUPkVNiQjshG4Z2lkPGaLPBnDLVaKNkZ2v0sx0gEcSdU5lrqEDIhitviRyPKjKI
+tRAnZMNohSY4NVs3YbTZQCHZmeGst3mBq5RVIBUJySsJJXG1Kl3nVYAWYoKzmaNyCSoQlhcN9H9i
+QuJd4aA59SVSjqz8TGGI3Nl91TKooSVk3md4IXY29HOPvxRSVtlqkNXZSlBxgnTQ4r0ENKF3NhmM
+Eiktqo1hnBWrtziMMgYsZY+T4oeE9Q1HbAzjVM0TjqSvkQ1jV6zeoo0yBnSyx3XxQ4LccKyyYTg1
+TyDpa2xWDc4u7965h8Pz9eMHWK6f4OTy/sMLrB8159isGpzevnqKo/Xxomr+kUQ+3kz+Mit/mNFv
+ZvSLGf9kJj+Y0Xdm8RszfGXGX5ilz8zwiRl/ZGY/MNu9Z5beMStvmeENM37NTL1iFl4ywwtm+jkz
+84wZT8xsZpaEWX8NqWY=
+`},
+	{4, 16, 8016, `
+4ukDgPh9bu+npqnu6ckr+ohot7ADLDYPS76oCwR4yxdhIPi+hGU/DidYoq9ImMlwgKEfIEYk264k
+hKFUda9fkEmOCSqBgKNsp3DEGr1kIJaiZi/HHiyOUcE5tCYx9L8tbhwGy74IRF8YpJkc5GNKEpJo
+DHUZw/8L6FWpg35JKnUYMae+0mH3U3lwDEmWL1J6OyXX/g932CtigiSTJSWzOWt1fNFP+X2nVAVS
+Ik58Fag0h+4NuSUbSZAlpFVmlhWIECZLkmiMtPGT1W7KW8pg/pckcSTzpL42kjQmEUzTQz4mS/XR
+apH09kqP+qQIWJKvQrtwRSDbvWLibEjIlL8JAg7Rwek7vRzLJKe1OoSmTJkeimPuDVHOfVCdBfkE
+DT0U391+rejXF6Vs63tPogssw7a4D9wd7qFYSx2CT8iHrZVlaGqrKqlBcXNDRz3KGzpR3VbX2IKG
+9rLmIb+z2qLuLpQ2VOS0sl8Pnhl/xSz7gJl8jZl8hhk9xIzvYCavYxZfwgxnMaMTmCWHMcM+zGgn
+ZmoLZtF6zJJVmGVLMcMCzGg2ZmIaZuFEzDAGM3M4Zs4gzKgvZirFLGHMbBcknQA=
+`},
+	{6, 16, 8016, `
+4ukDgPj9z+VXx6h7fLrCFwBdYBZo2rwywUcCeB7OxhvSnO+DbzxuG8jHMMCEy8J0oPgAMYI19y5D
+Riis3elbj3SdDYJNQMH70nPGgExSseg+kw1HV74GsAmxNzE06qyByno2XMpBJUljV47PpONgqOau
++Mj4d+SINF2GanCa0ybDkmNLARvQqY0E/Z0SStu3z1QMgk5pBiKa8a2TU8mxObNDRsoVAgNUpTIh
+eUFmyl8E0gVJgelms0txI9KDjoORFk7xtZ1/jB+0/4/sloxFfiHqYYQJ0SCBlIuQj1f6KSsNLtbJ
+aXNbwpUKyifQGwJ3yp9cLnajfgHKkpuk7bsRlW7THPA2ONMNf85CcLorRSAig4zdvYYqOdnwZYu8
+dqMd9OwumW6YOQv2FxT2pSm+c6j6zdhAP0TFBfmEwDs6IiEc/hGJCI4Li4xBRLxf9Pg7C/VKToJv
+RIBJ8vtVeGb8FbP0D5jprzHTn2FGDzHjO5jp1zEzLmGGs5jRCczEIWbYwYzWMbMtYZY6i5mYwCx9
+GDP0YUadmCktmLnrMUMVZlopZtYCzCgbM1saZoIxs9hBkgk=
+`},
+	{9, 22, 8016, `
+G08fAMTvfy6/Okbd49MVvgDoArNA0+aVCT4SwAN/My6knPfgjWNbIB/DABPu7PA0UHyAeAg6VxKP
+V52D7TWBR490nQ2CTUDB+6LnEx0yScWk20w2HF15D2ATYk9iqNVZAZX1bLiUjUqSxq4cr6RjY6j6
+qrjI+HfkiDRdhmpwmtMmw5JjSwEb0KmNBPo7xZeWb52pGASd0vRENO1bO6eSY3NmhwyUIwR6qEph
+QvKE9JS/CKQLkgLTzWaX4kakk46NkWZO8bWVf4wftP+3zJYMRX4+6qGF8dEgrnSdhLy90k9ZaXCx
+Tk6b2xIuV1A+Ab0h4Ez5g8vFbtTPQ1lSk7R8L6LSbZoD3gZnuuHPWQhOd6UIRGSQsbrHUCUnG75s
+keeutYOa3SHTDTNnwf6CxK70iOscsv4yNtAPUXFBPiHwjo5ICId/RCKC48IiYxAR7xc9/s5CvZKT
+4BsRYJL8fhWeGX/FLP0DZvprzPRnmNFDzPgOZvp1zIxLmOEsZnQCM3GIGXYwo3XMbEuYpc5iJiYw
+Sx/GDH2YUSdmSgtm7nrMUIWZVoqZtQAzysbMloaZYMwsdpBkAg==
+`},
+	{11, 10, 8016, `
+oXj6ACL/N6fTMeo/n1X4AaALXIGS9poBMkKAt7hFAeq2nh/7Lf/txymHYcB6gBjEXLvPT0Ui2xxL
+MXrUE1hOi0CGgYLWpn75AN6IhpAjBzNOBM5SBLLlnOJ2YFST2KO9QywMb50Tmc9+WMeSQGs5nVJG
+gsRPxgOnBRYNIgOtTUkI4y29KS0CFnmkVU8rJeUnU/oKjKmBqD7JKPO8HK3wCwYBI8JcEItVlBrk
+FCxEcotxX6Wpk0mB+PefVU8710XcCxjB8ZCVJm68Jf37J6SwhPLd3N4M0cZ1nTdqU8aIMJ7y7ygf
+VG3K+YSMjvDabFlha035+YIxJyHPganmq6G4ui23OIfQCaVgG2dzk8SxquR21qZ27slozZ1Sxfo7
+szbw9ABjOzMRYfJ125lxrzATHmDGXsOMPYMZOoQZtwMzdh1m2iWYwSzM0ATM8DDMoA9mqBNmshaY
+aephhqtgJpTCDApghrJhRqXBTJ0IM4iBGRMOM2kQzJAvzGQ6mGEOZhI5kEgE
+`},
+	{11, 22, 8016, `
+G08fQOT/5nQ6Rv3nswo/AHSBK1DSXjNARgjwFrcoQN3W82O/5b/9OOUwDFgPEIOYa/f5qUhkm2Mp
+Ro96AstpEcgwUNDa1C8fwBvREHLkYMaJwFmKQLacU9wOjGoSe7R3iIXhrXMi89kP61gSaC2nU8pI
+kPjJeOC0wKJBZKC1KQlhvKU3pUXAIo+06mmlpPxkSl+BMTUQ1ScZZZ6XoxV+wSBgRJgLYrGKUoOc
+goVIbjHuqzR1MikQ//6z6mnnuoh7ASM4HrLSxI23pH//hBSWUL6b25sh2riu80ZtyhgRxlP+HeWD
+qk05n5DREV6bLStsrSk/XzDmJOQ5MNV8NRRXt+UW5xA6oRRs42xukjhWldzO2tTOPRmtuVOqWH9n
+1gaeHmBsZyYiTL5uOzPuFWbCA8zYa5ixZzBDhzDjdmDGrsNMuwQzmIUZmoAZHoYZ9MEMdcJM1gIz
+TT3McBXMhFKYQQHMUDbMqDSYqRNhBjEwY8JhJg2CGfKFmUwHM8zBTCIHEokA
+`},
+}
+
+func decompressBase64(s string) *bytes.Reader {
+	data, _ := base64.StdEncoding.DecodeString(s)
+	return bytes.NewReader(data)
+}
+
+func TestMiddlewareBodyDecompressDecoder(t *testing.T) {
+	data := []byte(strings.Repeat(decompressText, 16))
+	for i := range data {
+		if i%251 == 0 {
+			data[i] = byte(i)
+		}
+	}
+	decode := func(name string, src []byte) ([]byte, error) {
+		r, err := DefaultDecompressionDecoder[name](bytes.NewReader(src))
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+
+	// brotli with static dictionary, context modeling and block switch
+	for _, v := range decompressBrotliVectors {
+		vector, _ := io.ReadAll(decompressBase64(v.data))
+		out, err := decode(CompressionNameBrotli, vector)
+		if err != nil || !bytes.Equal(out, data[:v.size]) {
+			t.Errorf("brotli quality %d lgwin %d decode %d bytes error: %v", v.quality, v.lgwin, len(out), err)
+		}
+		_, err = decode(CompressionNameBrotli, vector[:len(vector)/2])
+		if err == nil {
+			t.Errorf("brotli quality %d lgwin %d decode truncated data", v.quality, v.lgwin)
+		}
+	}
+
+	vector, _ := io.ReadAll(decompressBase64(decompressZstdVector))
+	out, err := decode(CompressionNameZstandard, vector)
+	if err != nil || string(out) != strings.Repeat(decompressText, 4) {
+		t.Errorf("zstd decode vector error: %v", err)
+	}
+	// concatenated and skippable frames
+	skippable := []byte{0x50, 0x2a, 0x4d, 0x18, 3, 0, 0, 0, 1, 2, 3}
+	frames := append(append(append([]byte{}, vector...), skippable...), vector...)
+	out, err = decode(CompressionNameZstandard, frames)
+	if err != nil || string(out) != strings.Repeat(decompressText, 8) {
+		t.Errorf("zstd decode frames error: %v", err)
+	}
+	for _, i := range []int{0, 5, 20, 100, len(vector) - 1} {
+		corrupted := append([]byte{}, vector...)
+		corrupted[i] ^= 0x10
+		_, err = decode(CompressionNameZstandard, corrupted)
+		if err == nil {
+			t.Errorf("zstd decode corrupted byte %d", i)
+		}
+	}
+}
+
 func TestMiddlewareHeader(*testing.T) {
 	app := NewApp()
 	app.AddMiddleware("global", NewHeaderSecureFunc(http.Header{"Server": {"eudore"}}))
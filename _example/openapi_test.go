@@ -0,0 +1,137 @@
+package eudore_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/eudore/eudore"
+)
+
+type openapiPage struct {
+	Page int `alias:"page" json:"page" valid:"min=1"`
+	Size int `alias:"size" json:"size" valid:"(enum=10,20,50)"`
+}
+
+type openapiQuery struct {
+	openapiPage
+	Name  string   `url:"name" valid:"nozero,len>2"`
+	Email string   `url:"email" valid:"mail,omitempty"`
+	Tags  []string `url:"tags"`
+	Skip  string   `url:"-"`
+	Range struct {
+		Start time.Time `url:"start"`
+	} `url:"range"`
+}
+
+type openapiUser struct {
+	ID       int           `json:"id"`
+	Name     string        `json:"name" form:"username" valid:"nozero,regexp=^[a-z]+$"`
+	Friends  []openapiUser `json:"friends,omitempty" valid:"len<5"`
+	Created  time.Time     `json:"created"`
+	Data     []byte        `json:"data"`
+	Duration TimeDuration  `json:"duration"`
+	Extra    map[string]any
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	app.GetFunc("/openapi.*", NewHandlerOpenAPI(app, &OpenAPIConfig{
+		Title:   "eudore",
+		Version: "1.0.0",
+		Servers: []string{"http://localhost:8080"},
+	}))
+	app.GetFunc("/users", func(ctx Context, req openapiQuery) ([]openapiUser, error) {
+		return nil, nil
+	})
+	app.PostFunc("/users", func(ctx Context, req *openapiUser) (any, error) {
+		return req, nil
+	})
+	app.GetFunc("/users/:id|num/*path name=user", func(ctx Context, req map[string]any) (*openapiUser, error) {
+		return nil, nil
+	})
+	app.AnyFunc("/files/:name|^[a-z]+$", func(Context) {})
+	app.AddHandler("404", "", HandlerRouter404)
+
+	app.NewRequest("GET", "/openapi.json",
+		http.Header{HeaderAccept: {MimeApplicationJSON}},
+		func(resp *http.Response) error {
+			doc := &OpenAPIDocument{}
+			err := json.NewDecoder(resp.Body).Decode(doc)
+			if err != nil {
+				return err
+			}
+			if doc.OpenAPI != "3.1.0" || doc.Paths["/openapi.*"] != nil {
+				t.Error("openapi route must skip")
+			}
+
+			get := doc.Paths["/users"]["get"]
+			names := make([]string, len(get.Parameters))
+			for i, p := range get.Parameters {
+				names[i] = p.Name
+			}
+			if strings.Join(names, ",") != "page,size,name,email,tags,range.start" {
+				t.Error("query params:", names)
+			}
+			if !get.Parameters[2].Required || get.Parameters[3].Required ||
+				*get.Parameters[0].Schema.Minimum != 1 ||
+				len(get.Parameters[1].Schema.Enum) != 3 ||
+				*get.Parameters[2].Schema.MinLength != 3 ||
+				get.Parameters[3].Schema.Format != "email" ||
+				get.Parameters[4].Schema.Type != "array" {
+				t.Error("query valid:", get.Parameters)
+			}
+
+			post := doc.Paths["/users"]["post"]
+			if post.RequestBody.Content[MimeApplicationJSON].Schema.Ref == "" ||
+				post.RequestBody.Content[MimeApplicationForm].Schema.Properties["username"] == nil {
+				t.Error("request body:", post.RequestBody)
+			}
+
+			user := doc.Components.Schemas["openapiUser"]
+			if user.Properties["name"].Pattern != "^[a-z]+$" ||
+				user.Properties["friends"].Items.Ref == "" ||
+				*user.Properties["friends"].MaxItems != 4 ||
+				user.Properties["created"].Format != "date-time" ||
+				user.Properties["data"].Format != "byte" ||
+				user.Properties["duration"].Type != "string" ||
+				user.Properties["Extra"].Type != "object" ||
+				strings.Join(user.Required, ",") != "name" {
+				t.Error("schema:", user)
+			}
+
+			param := doc.Paths["/users/{id}/{path}"]["get"]
+			if len(param.Parameters) != 2 || param.OperationID != "user" || param.Parameters[0].Schema.Type != "integer" ||
+				param.Responses["200"].Content[MimeApplicationJSON].Schema.Ref == "" {
+				t.Error("path params:", param.Parameters)
+			}
+			if len(doc.Paths["/files/{name}"]) != len(DefaultRouterAnyMethod) ||
+				doc.Paths["/files/{name}"]["get"].Parameters[0].Schema.Pattern != "^[a-z]+$" {
+				t.Error("any method:", doc.Paths["/files/{name}"])
+			}
+			return nil
+		},
+	)
+	app.NewRequest("GET", "/openapi.yaml", func(resp *http.Response) error {
+		body, _ := io.ReadAll(resp.Body)
+		for _, str := range []string{
+			"openapi: \"3.1.0\"\n",
+			"\n  \"/users/{id}/{path}\":\n",
+			"\n        - name: \"id\"\n          in: \"path\"\n",
+			"\n        \"200\":\n          description: \"OK\"\n",
+			"\n      required:\n        - \"name\"\n",
+		} {
+			if !strings.Contains(string(body), str) {
+				t.Errorf("yaml not have %q", str)
+			}
+		}
+		return nil
+	})
+
+	app.CancelFunc()
+	app.Run()
+}
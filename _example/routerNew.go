@@ -22,6 +22,13 @@ RouterStd是eudore的默认路由器，使用基数树算法独立实现，性
 *num|{min:100}
 *num|{^0.*$}
 ```
+
+变量名称或校验规则使用块模式结尾后可以紧跟常量实现混合匹配，例如'/files/:{name}.:ext'、'/ids/:id|{num}.json'，优先匹配最长的变量值；
+未使用块模式时变量到下一个斜杠处结束，例如'/:user-id'的变量名称为'user-id'。
+
+注册路由时会检查相同形状的路由冲突，相同路由被覆盖、变量名称不同被遮蔽会输出Warning日志，
+变量校验规则可以区分路由，例如'/:id|num'和'/:name'不是冲突，两个路由互相存在对方没有的校验规则时存在歧义，
+冲突信息记录在routerCoreMux的Metadata中。
 */
 
 import (
@@ -71,6 +78,11 @@ func main() {
 	})
 	app.GetFunc("/:path|enum=1,2,3", eudore.HandlerRouter404)
 
+	// ---------- 混合路由 ----------
+	app.GetFunc("/files/:{name}.:ext", func(ctx eudore.Context) {
+		ctx.WriteString("file name is: " + ctx.GetParam("name") + " ext is: " + ctx.GetParam("ext") + "\n")
+	})
+
 	// ---------- 路由Debug ----------
 	api := app.Group("/api/{v 1} version=v1")
 	app.AddHandler("TEST", "/:path|{^0.*$}/*path|{^0.*$}", eudore.HandlerEmpty)
@@ -93,6 +105,7 @@ func main() {
 	app.NewRequest("GET", "/get/222", status(200), body("num great 100"))
 	app.NewRequest("GET", "/get/0xx", status(200), body("first char is '0'"))
 	app.NewRequest("XXX", "/get/0xx", status(405))
+	app.NewRequest("GET", "/files/app.min.js", status(200), body("file name is: app.min ext is: js"))
 
 	app.Listen(":8088")
 	app.Run()
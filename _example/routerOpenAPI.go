@@ -0,0 +1,54 @@
+package main
+
+/*
+eudore.NewOpenAPIDocument读取Router记录的MetadataRouter生成OpenAPI 3.1文档。
+
+请求类型为HandlerExtender转换的func(Context, Request)第二个参数，响应类型为第一个非error返回值。
+GET HEAD DELETE方法使用url/alias tag生成query参数，其他方法使用json/form tag生成请求体，valid tag生成约束。
+eudore.NewHandlerOpenAPI处理路径后缀.yaml/.yml或Accept为application/yaml时返回yaml，否则返回json。
+*/
+
+import (
+	"github.com/eudore/eudore"
+)
+
+type userRequest struct {
+	Name  string `json:"name" form:"name" valid:"nozero,len<32"`
+	Email string `json:"email" form:"email" valid:"mail"`
+	Age   int    `json:"age" form:"age" valid:"min=0,max=150"`
+}
+
+type userQuery struct {
+	Page int    `alias:"page" valid:"min=1"`
+	Size int    `alias:"size" valid:"(enum=10,20,50)"`
+	Name string `alias:"name"`
+}
+
+type userResponse struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func main() {
+	app := eudore.NewApp()
+	app.GetFunc("/openapi.*", eudore.NewHandlerOpenAPI(app, &eudore.OpenAPIConfig{
+		Title:   "eudore example",
+		Version: "1.0.0",
+		Servers: []string{"http://localhost:8088"},
+	}))
+
+	api := app.Group("/api/v1")
+	api.GetFunc("/users", func(ctx eudore.Context, req *userQuery) ([]userResponse, error) {
+		return []userResponse{}, nil
+	})
+	api.PostFunc("/users", func(ctx eudore.Context, req *userRequest) (*userResponse, error) {
+		return &userResponse{ID: 1, Name: req.Name, Email: req.Email}, nil
+	})
+	api.GetFunc("/users/:id|num", func(ctx eudore.Context, req map[string]any) (*userResponse, error) {
+		return &userResponse{ID: eudore.GetAny[int](ctx.GetParam("id"))}, nil
+	})
+
+	app.Listen(":8088")
+	app.Run()
+}
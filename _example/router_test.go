@@ -1,8 +1,10 @@
 package eudore_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"html/template"
 	"net/http"
 	"testing"
 
@@ -148,3 +150,80 @@ func TestRouterCoreHost(t *testing.T) {
 	r.(interface{ Mount(context.Context) }).Mount(context.Background())
 	r.(interface{ Unmount(context.Context) }).Unmount(context.Background())
 }
+
+func TestRouterURL(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	api := app.Group("/api/v1 name=api")
+	api.GetFunc("/users/:id|num name=user", HandlerEmpty)
+	api.GetFunc("/users/:id|num/files/*path name=file", HandlerEmpty)
+	api.GetFunc("/tags/:tag|^[a-z-]+$ name=tag", HandlerEmpty)
+	api.GetFunc("/index", HandlerEmpty)
+	app.GetFunc("/static/* name=static", HandlerEmpty)
+	if app.AddHandler("GET", "/err/:id|nofunc name=err", HandlerEmpty) == nil {
+		t.Error("invalid check must error")
+	}
+	if app.AddHandler("POST", "/api/v1/users/:id|num name=user", HandlerEmpty) != nil {
+		t.Error("same name and path must not error")
+	}
+	if app.AddHandler("GET", "/users/:id name=user", HandlerEmpty) == nil {
+		t.Error("duplicate name must error")
+	}
+	if app.GetRequest("/users/1", NewClientCheckStatus(404)) != nil {
+		t.Error("duplicate name must not register the route")
+	}
+
+	urls := []struct {
+		name   string
+		params []any
+		url    string
+	}{
+		{"user", []any{"id", 1}, "/api/v1/users/1"},
+		{"user", []any{"id", 2, "page", 1, "size"}, "/api/v1/users/2?page=1&size="},
+		{"file", []any{"id", 3, "path", "a b/c#.txt"}, "/api/v1/users/3/files/a%20b/c%23.txt"},
+		{"tag", []any{"tag", "go-lang"}, "/api/v1/tags/go-lang"},
+		{"static", []any{"*", "js/app.js"}, "/static/js/app.js"},
+		{"static", nil, "/static/"},
+	}
+	for _, u := range urls {
+		s, err := app.URL(u.name, u.params...)
+		if err != nil || s != u.url {
+			t.Error(u.name, s, err)
+		}
+	}
+
+	errs := []struct {
+		name   string
+		params []any
+	}{
+		{"api", nil},
+		{"err", nil},
+		{"user", nil},
+		{"user", []any{"id", "a"}},
+		{"tag", []any{"tag", "a/b"}},
+		{"tag", []any{"tag", "Go"}},
+	}
+	for _, u := range errs {
+		s, err := app.URL(u.name, u.params...)
+		if err == nil {
+			t.Error(u.name, s)
+		}
+		t.Log(err)
+	}
+
+	temp := template.Must(template.New("").Funcs(template.FuncMap{
+		"url": app.URL,
+	}).Parse(`<a href="{{url "user" "id" .}}">user</a>`))
+	buf := &bytes.Buffer{}
+	err := temp.Execute(buf, 4)
+	if err != nil || buf.String() != `<a href="/api/v1/users/4">user</a>` {
+		t.Error(buf.String(), err)
+	}
+	err = temp.Execute(buf, "x")
+	if err == nil {
+		t.Error("template url must error")
+	}
+
+	app.CancelFunc()
+	app.Run()
+}
@@ -3,7 +3,9 @@ package eudore_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	. "github.com/eudore/eudore"
@@ -162,6 +164,7 @@ func TestRouterStdSplit(t *testing.T) {
 		{"/api/:name|^\\d+$/info", []string{"/api/", ":name|^\\d+$", "/info"}},
 		{"/api/*|{^0/api\\S+$}", []string{"/api/", "*|^0/api\\S+$"}},
 		{"/api/*|^\\$\\d+$", []string{"/api/", "*|^\\$\\d+$"}},
+		{"/api/:user-id/:file.ext", []string{"/api/", ":user-id", "/", ":file.ext"}},
 	}
 	for i := range datas {
 		if fmt.Sprint(getSplitPathT(datas[i].path)) != fmt.Sprint(datas[i].strs) {
@@ -235,3 +238,85 @@ func getSplitPathT(path string) []string {
 	}
 	return strs
 }
+
+func TestRouterStdMixed(t *testing.T) {
+	r, c := newCSR(NewRouterCoreMux())
+	echoParams := func(ctx Context) {
+		ctx.WriteString(ctx.Params().String())
+	}
+	r.GetFunc("/files/:{name}.:ext", echoParams)
+	r.GetFunc("/files/:name", echoParams)
+	r.GetFunc("/files/:{name}.json", echoParams)
+	r.GetFunc("/ids/:id|{num}.json", echoParams)
+	r.GetFunc("/ids/:id", echoParams)
+	r.GetFunc("/range/:{from}-:to/info", echoParams)
+	r.GetFunc("/users/:user-id", echoParams)
+	r.GetFunc("/download/:file.ext", echoParams)
+	r.GetFunc("/tags/:{tag}|{num}-:name", echoParams)
+
+	routes := []struct {
+		path string
+		body string
+	}{
+		{"/files/app.js", "route=/files/:name.:ext ext=js name=app"},
+		{"/files/app.min.js", "route=/files/:name.:ext ext=js name=app.min"},
+		{"/files/app.json", "route=/files/:name.json name=app"},
+		{"/files/app", "route=/files/:name name=app"},
+		{"/files/.js", "route=/files/:name name=.js"},
+		{"/ids/12.json", "route=/ids/:id|num.json id=12"},
+		{"/ids/ab.json", "route=/ids/:id id=ab.json"},
+		{"/range/1-10/info", "route=/range/:from-:to/info to=10 from=1"},
+		{"/users/1", "route=/users/:user-id user-id=1"},
+		{"/download/app.zip", "route=/download/:file.ext file.ext=app.zip"},
+		{"/tags/12-go", "route=/tags/:tag|num-:name name=go tag=12"},
+	}
+	for _, route := range routes {
+		c.NewRequest("GET", route.path,
+			NewClientCheckStatus(200),
+			func(resp *http.Response) error {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != route.body {
+					t.Error(route.path, string(body))
+				}
+				return nil
+			},
+		)
+	}
+}
+
+func TestRouterStdConflict(t *testing.T) {
+	r, _ := newCSR(NewRouterCoreMux())
+	r.GetFunc("/users/:id|num", HandlerEmpty)
+	r.GetFunc("/users/:name", HandlerEmpty)
+	r.GetFunc("/users/:uid", HandlerEmpty)
+	r.AnyFunc("/users/:id|num", HandlerEmpty)
+	r.GetFunc("/users/:id|num", HandlerEmpty)
+	r.GetFunc("/users/:id|^\\d+$", HandlerEmpty)
+	r.GetFunc("/users/:id/info", HandlerEmpty)
+	r.PostFunc("/users/:uid/info", HandlerEmpty)
+	r.GetFunc("/files/*", HandlerEmpty)
+	r.GetFunc("/files/*path", HandlerEmpty)
+	r.GetFunc("/files/:name", HandlerEmpty)
+	r.GetFunc("/groups/:gid|num/:name", HandlerEmpty)
+	r.GetFunc("/groups/:gid/:uid|num", HandlerEmpty)
+
+	meta := r.(interface{ Metadata() any }).Metadata().(MetadataRouter)
+	conflicts := meta.Core.(MetadataRouterCoreMux).Conflicts
+	want := []string{
+		"routerCoreMux: route 'GET /users/:name' overlaps 'GET /users/:id|num' by param check",
+		"routerCoreMux: route 'GET /users/:uid' overlaps 'GET /users/:id|num' by param check",
+		"routerCoreMux: route 'GET /users/:uid' is shadowed by 'GET /users/:name'",
+		"routerCoreMux: route 'ANY /users/:id|num' overlaps 'GET /users/:name' by param check",
+		"routerCoreMux: route 'ANY /users/:id|num' overlaps 'GET /users/:uid' by param check",
+		"routerCoreMux: route 'GET /users/:id|num' overwrites 'GET /users/:id|num'",
+		"routerCoreMux: route 'GET /users/:id|^\\d+$' is ambiguous with 'GET /users/:id|num'",
+		"routerCoreMux: route 'GET /users/:id|^\\d+$' overlaps 'GET /users/:name' by param check",
+		"routerCoreMux: route 'GET /users/:id|^\\d+$' overlaps 'GET /users/:uid' by param check",
+		"routerCoreMux: route 'GET /users/:id|^\\d+$' is ambiguous with 'ANY /users/:id|num'",
+		"routerCoreMux: route 'GET /files/*path' is shadowed by 'GET /files/*'",
+		"routerCoreMux: route 'GET /groups/:gid/:uid|num' is ambiguous with 'GET /groups/:gid|num/:name'",
+	}
+	if strings.Join(conflicts, "\n") != strings.Join(want, "\n") {
+		t.Error(strings.Join(conflicts, "\n"))
+	}
+}
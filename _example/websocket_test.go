@@ -0,0 +1,377 @@
+package eudore_test
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/eudore/eudore"
+)
+
+type websocketClient struct {
+	net.Conn
+	*bufio.Reader
+	size int
+}
+
+func newWebsocketClient(t *testing.T, addr, path string, headers ...string) (*websocketClient, *http.Response) {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(MethodGet, "http://"+addr+path, nil)
+	req.Header.Set(HeaderConnection, HeaderValueUpgrade)
+	req.Header.Set(HeaderUpgrade, "websocket")
+	req.Header.Set(HeaderSecWebSocketVersion, "13")
+	req.Header.Set(HeaderSecWebSocketKey, "dGhlIHNhbXBsZSBub25jZQ==")
+	for i := 0; i < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	_ = req.Write(conn)
+
+	client := &websocketClient{Conn: conn, Reader: bufio.NewReader(conn)}
+	resp, err := http.ReadResponse(client.Reader, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, resp
+}
+
+func (c *websocketClient) WriteFrame(fin bool, rsv1 bool, opcode int, data []byte) {
+	head := []byte{byte(opcode), 0x80}
+	if fin {
+		head[0] |= 0x80
+	}
+	if rsv1 {
+		head[0] |= 0x40
+	}
+	switch {
+	case len(data) < 126:
+		head[1] |= byte(len(data))
+	case len(data) <= 0xffff:
+		head[1] |= 126
+		head = binary.BigEndian.AppendUint16(head, uint16(len(data)))
+	default:
+		head[1] |= 127
+		head = binary.BigEndian.AppendUint64(head, uint64(len(data)))
+	}
+	mask := []byte{1, 2, 3, 4}
+	payload := make([]byte, len(data))
+	for i := range data {
+		payload[i] = data[i] ^ mask[i%4]
+	}
+	c.Write(append(append(head, mask...), payload...))
+}
+
+func (c *websocketClient) ReadFrame() (int, []byte) {
+	var opcode int
+	var compressed bool
+	var data []byte
+	head := make([]byte, 8)
+	for {
+		_, err := io.ReadFull(c.Reader, head[:2])
+		if err != nil {
+			return -1, nil
+		}
+		length := int(head[1] & 0x7f)
+		switch length {
+		case 126:
+			io.ReadFull(c.Reader, head[:2])
+			length = int(binary.BigEndian.Uint16(head))
+		case 127:
+			io.ReadFull(c.Reader, head)
+			length = int(binary.BigEndian.Uint64(head))
+		}
+		payload := make([]byte, length)
+		io.ReadFull(c.Reader, payload)
+		if opcode == 0 {
+			opcode, compressed = int(head[0]&0x0f), head[0]&0x40 != 0
+		}
+		data = append(data, payload...)
+		c.size = len(data)
+		if head[0]&0x80 != 0 {
+			break
+		}
+	}
+	if compressed {
+		r := flate.NewReader(io.MultiReader(bytes.NewReader(data),
+			strings.NewReader("\x00\x00\xff\xff\x01\x00\x00\xff\xff"),
+		))
+		data, _ = io.ReadAll(r)
+	}
+	return opcode, data
+}
+
+func TestWebsocketConn(t *testing.T) {
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	app.GetFunc("/echo", func(ctx Context, conn *WebsocketConn) {
+		for {
+			code, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(code, data)
+		}
+	})
+	app.GetFunc("/config", func(ctx Context) {
+		ctx.SetHeader(HeaderXRequestID, "websocket")
+		conn, err := NewWebsocketConn(ctx, &WebsocketConfig{
+			Subprotocols:         []string{"chat", "json"},
+			CheckOrigin:          func(*http.Request) bool { return true },
+			ReadLimit:            64,
+			PingInterval:         TimeDuration(time.Millisecond * 20),
+			FrameSize:            16,
+			Compression:          true,
+			CompressionThreshold: 8,
+		})
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		defer conn.Close()
+		for {
+			code, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(code, data)
+		}
+	})
+	app.GetFunc("/deflate", func(ctx Context) {
+		conn, err := NewWebsocketConn(ctx, &WebsocketConfig{Compression: true})
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		defer conn.Close()
+		code, data, err := conn.ReadMessage()
+		if err == nil {
+			conn.WriteMessage(code, data)
+		}
+	})
+	app.GetFunc("/cancel", func(ctx Context) {
+		c, cancel := context.WithTimeout(ctx.Context(), time.Millisecond*20)
+		defer cancel()
+		ctx.SetContext(c)
+		conn, err := NewWebsocketConn(ctx, nil)
+		if err != nil {
+			ctx.Fatal(err)
+			return
+		}
+		<-conn.Context().Done()
+	})
+	ts := httptest.NewServer(app)
+	defer ts.Close()
+	addr := ts.Listener.Addr().String()
+
+	// handshake
+	client, resp := newWebsocketClient(t, addr, "/echo")
+	if resp.StatusCode != StatusSwitchingProtocols ||
+		resp.Header.Get(HeaderSecWebSocketAccept) != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatal("handshake error", resp.Status, resp.Header)
+	}
+
+	client.WriteFrame(true, false, WebsocketMessageText, []byte("hello"))
+	code, data := client.ReadFrame()
+	if code != WebsocketMessageText || string(data) != "hello" {
+		t.Error("echo error", code, string(data))
+	}
+	// fragmented message and interleaved ping
+	client.WriteFrame(false, false, WebsocketMessageBinary, []byte("eud"))
+	client.WriteFrame(true, false, WebsocketMessagePing, []byte("ping"))
+	client.WriteFrame(true, false, 0, []byte("ore"))
+	code, data = client.ReadFrame()
+	if code != WebsocketMessagePong || string(data) != "ping" {
+		t.Error("pong error", code, string(data))
+	}
+	code, data = client.ReadFrame()
+	if code != WebsocketMessageBinary || string(data) != "eudore" {
+		t.Error("fragment error", code, string(data))
+	}
+	// invalid utf8
+	client.WriteFrame(true, false, WebsocketMessageText, []byte{0xff, 0xfe})
+	code, data = client.ReadFrame()
+	if code != WebsocketMessageClose || binary.BigEndian.Uint16(data) != WebsocketCloseInvalidFramePayloadData {
+		t.Error("close error", code, data)
+	}
+	client.Close()
+
+	// close handshake
+	client, _ = newWebsocketClient(t, addr, "/echo")
+	client.WriteFrame(true, false, WebsocketMessageClose, []byte{0x0f, 0xa0, 'b', 'y', 'e'})
+	code, data = client.ReadFrame()
+	if code != WebsocketMessageClose || binary.BigEndian.Uint16(data) != 4000 {
+		t.Error("close error", code, data)
+	}
+	client.Close()
+
+	// the control frame deadline does not affect later writes without WriteTimeout
+	DefaultWebsocketCloseTimeout = time.Millisecond * 20
+	client, _ = newWebsocketClient(t, addr, "/echo")
+	client.SetReadDeadline(time.Now().Add(time.Second))
+	client.WriteFrame(true, false, WebsocketMessagePing, []byte("ping"))
+	code, _ = client.ReadFrame()
+	time.Sleep(time.Millisecond * 40)
+	client.WriteFrame(true, false, WebsocketMessageText, []byte("after pong"))
+	code2, data := client.ReadFrame()
+	if code != WebsocketMessagePong || code2 != WebsocketMessageText || string(data) != "after pong" {
+		t.Error("write after pong error", code, code2, string(data))
+	}
+	client.Close()
+	DefaultWebsocketCloseTimeout = time.Second * 5
+
+	// protocol error
+	for _, frame := range [][]byte{
+		{0x80, 0x80, 0, 0, 0, 0},             // continuation
+		{0x83, 0x80, 0, 0, 0, 0},             // opcode
+		{0xc1, 0x80, 0, 0, 0, 0},             // rsv1
+		{0x81, 0x00},                         // unmasked
+		{0x09, 0x80, 0, 0, 0, 0},             // fragmented control
+		{0x88, 0x81, 0, 0, 0, 0, 0},          // close payload
+		{0x88, 0x82, 0, 0, 0, 0, 0x03, 0xed}, // close code
+	} {
+		client, _ = newWebsocketClient(t, addr, "/echo")
+		client.Write(frame)
+		code, data = client.ReadFrame()
+		if code != WebsocketMessageClose || binary.BigEndian.Uint16(data) != WebsocketCloseProtocolError {
+			t.Error("protocol error", frame, code, data)
+		}
+		client.Close()
+	}
+
+	// config
+	client, resp = newWebsocketClient(t, addr, "/config",
+		HeaderOrigin, "http://eudore.cn",
+		HeaderSecWebSocketProtocol, "xml, json",
+		HeaderSecWebSocketExtensions, "permessage-deflate; server_max_window_bits=10, permessage-deflate; client_max_window_bits",
+	)
+	if resp.Header.Get(HeaderSecWebSocketProtocol) != "json" ||
+		resp.Header.Get(HeaderXRequestID) != "websocket" ||
+		!strings.HasPrefix(resp.Header.Get(HeaderSecWebSocketExtensions), "permessage-deflate") {
+		t.Fatal("handshake error", resp.Header)
+	}
+	code, _ = client.ReadFrame()
+	if code != WebsocketMessagePing {
+		t.Error("ping error", code)
+	}
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.BestSpeed)
+	w.Write([]byte("eudore websocket deflate"))
+	w.Flush()
+	client.WriteFrame(true, true, WebsocketMessageText, buf.Bytes()[:buf.Len()-4])
+	for {
+		code, data = client.ReadFrame()
+		if code != WebsocketMessagePing {
+			break
+		}
+	}
+	if code != WebsocketMessageText || string(data) != "eudore websocket deflate" {
+		t.Error("deflate error", code, string(data))
+	}
+	client.WriteFrame(true, false, WebsocketMessageBinary, make([]byte, 100))
+	for {
+		code, data = client.ReadFrame()
+		if code != WebsocketMessagePing {
+			break
+		}
+	}
+	if code != WebsocketMessageClose || binary.BigEndian.Uint16(data) != WebsocketCloseMessageTooBig {
+		t.Error("limit error", code, data)
+	}
+	client.Close()
+
+	// default compression level
+	client, _ = newWebsocketClient(t, addr, "/deflate",
+		HeaderSecWebSocketExtensions, "permessage-deflate",
+	)
+	client.WriteFrame(true, false, WebsocketMessageText, []byte(strings.Repeat("eudore", 64)))
+	code, data = client.ReadFrame()
+	if string(data) != strings.Repeat("eudore", 64) || client.size >= 64 {
+		t.Error("deflate level error", code, client.size)
+	}
+	client.Close()
+
+	// context cancel
+	client, _ = newWebsocketClient(t, addr, "/cancel")
+	code, data = client.ReadFrame()
+	if code != WebsocketMessageClose || binary.BigEndian.Uint16(data) != WebsocketCloseGoingAway {
+		t.Error("cancel error", code, data)
+	}
+	client.Close()
+
+	// handshake error
+	for _, headers := range [][]string{
+		{HeaderUpgrade, "h2c"},
+		{HeaderSecWebSocketKey, "eudore"},
+		{HeaderSecWebSocketVersion, "8"},
+		{HeaderOrigin, "http://eudore.cn"},
+	} {
+		client, resp = newWebsocketClient(t, addr, "/echo", headers...)
+		t.Log(resp.Status, resp.Header.Get(HeaderSecWebSocketVersion))
+		if resp.StatusCode == StatusSwitchingProtocols {
+			t.Error("handshake must failed", headers)
+		}
+		client.Close()
+	}
+
+	// server shutdown
+	client, _ = newWebsocketClient(t, addr, "/echo")
+	time.Sleep(time.Millisecond * 10)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ts.Config.Shutdown(ctx)
+	code, data = client.ReadFrame()
+	if code != WebsocketMessageClose || binary.BigEndian.Uint16(data) != WebsocketCloseGoingAway {
+		t.Error("shutdown error", code, data)
+	}
+	client.Close()
+
+	app.CancelFunc()
+	app.Run()
+}
+
+func TestWebsocketConnError(t *testing.T) {
+	var closeErr error = &WebsocketCloseError{Code: WebsocketCloseNormalClosure}
+	var target *WebsocketCloseError
+	if !errors.As(closeErr, &target) || target.Code != WebsocketCloseNormalClosure {
+		t.Error(closeErr)
+	}
+	t.Log(closeErr, &WebsocketCloseError{Code: WebsocketCloseGoingAway, Text: "shutdown"})
+
+	app := NewApp()
+	app.SetValue(ContextKeyLogger, DefaultLoggerNull)
+	app.GetFunc("/echo", func(ctx Context, conn *WebsocketConn) {
+		err := conn.WriteMessage(WebsocketMessagePing, nil)
+		if err == nil {
+			t.Error("write ping must error")
+		}
+		conn.Close()
+		_, _, err = conn.ReadMessage()
+		t.Log(err, conn.Subprotocol(), conn.RemoteAddr())
+		if conn.WriteMessage(WebsocketMessageText, nil) == nil || conn.Ping(nil) == nil {
+			t.Error("closed conn write must error")
+		}
+	})
+	app.NewRequest("GET", "/echo",
+		http.Header{
+			HeaderConnection:          {HeaderValueUpgrade},
+			HeaderUpgrade:             {"websocket"},
+			HeaderSecWebSocketVersion: {"13"},
+			HeaderSecWebSocketKey:     {"dGhlIHNhbXBsZSBub25jZQ=="},
+		},
+	)
+
+	app.CancelFunc()
+	app.Run()
+}
@@ -51,10 +51,22 @@ type ClientHook interface {
 }
 
 type MetadataClient struct {
-	Health    bool     `json:"health" protobuf:"1,name=health" yaml:"health"`
-	Name      string   `json:"name" protobuf:"2,name=name" yaml:"name"`
-	Transport string   `json:"transport" protobuf:"3,name=transport" yaml:"transport"`
-	Hooks     []string `json:"hooks" protobuf:"4,name=hooks" yaml:"hooks"`
+	Health    bool                    `json:"health" protobuf:"1,name=health" yaml:"health"`
+	Name      string                  `json:"name" protobuf:"2,name=name" yaml:"name"`
+	Transport string                  `json:"transport" protobuf:"3,name=transport" yaml:"transport"`
+	Hooks     []string                `json:"hooks" protobuf:"4,name=hooks" yaml:"hooks"`
+	Breakers  []MetadataClientBreaker `json:"breakers,omitempty" protobuf:"5,name=breakers" yaml:"breakers,omitempty"`
+}
+
+// MetadataClientBreaker defines the state of a [NewClientHookBreaker] key.
+type MetadataClientBreaker struct {
+	Key                  string    `json:"key" protobuf:"1,name=key" yaml:"key"`
+	State                string    `json:"state" protobuf:"2,name=state" yaml:"state"`
+	LastTime             time.Time `json:"lastTime" protobuf:"3,name=lastTime" yaml:"lastTime"`
+	ConsecutiveSuccesses int       `json:"consecutiveSuccesses" protobuf:"4,name=consecutiveSuccesses" yaml:"consecutiveSuccesses"`
+	ConsecutiveFailures  int       `json:"consecutiveFailures" protobuf:"5,name=consecutiveFailures" yaml:"consecutiveFailures"`
+	TotalSuccesses       uint64    `json:"totalSuccesses" protobuf:"6,name=totalSuccesses" yaml:"totalSuccesses"`
+	TotalFailures        uint64    `json:"totalFailures" protobuf:"7,name=totalFailures" yaml:"totalFailures"`
 }
 
 type clientStd struct {
@@ -148,7 +160,12 @@ func (client *clientStd) Mount(ctx context.Context) {
 
 func (client *clientStd) Metadata() any {
 	names := make([]string, len(client.Hooks))
+	var breakers []MetadataClientBreaker
 	for i := range client.Hooks {
+		breaker, ok := client.Hooks[i].(*clientHookBreaker)
+		if ok {
+			breakers = append(breakers, breaker.Metadata()...)
+		}
 		s, ok := client.Hooks[i].(fmt.Stringer)
 		if ok {
 			names[i] = s.String()
@@ -161,6 +178,7 @@ func (client *clientStd) Metadata() any {
 		Health:    true,
 		Transport: reflect.TypeOf(client.Transport).String(),
 		Hooks:     names,
+		Breakers:  breakers,
 	}
 }
 
@@ -432,13 +450,15 @@ type fileContent struct {
 	Body   []byte
 	File   string
 	Reader io.Reader
+	Open   func() (io.ReadCloser, error)
 }
 
 // The NewClientBodyForm function creates [MimeApplicationForm] or
 // [MimeMultipartForm] request body.
 //
 // If you use the AddFile method to add file,
-// the [HeaderContentType] is [MimeMultipartForm].
+// the [HeaderContentType] is [MimeMultipartForm],
+// and the files are streamed without buffering.
 func NewClientBodyForm(data url.Values) ClientBody {
 	return &bodyForm{values: data, boundary: GetStringRandom(30)}
 }
@@ -456,41 +476,60 @@ func (body *bodyForm) Read(p []byte) (int, error) {
 	return body.reader.Read(p)
 }
 
-func (body *bodyForm) encode(wc io.WriteCloser) {
+func (body *bodyForm) encode(wc *io.PipeWriter) {
 	w := multipart.NewWriter(wc)
 	_ = w.SetBoundary(body.boundary)
 	go func() {
+		var err error
 		for key, vals := range body.values {
 			for _, val := range vals {
-				_ = w.WriteField(key, val)
+				if err == nil {
+					err = w.WriteField(key, val)
+				}
 			}
 		}
 		for key, vals := range body.files {
 			for _, val := range vals {
-				part, _ := w.CreateFormFile(key, val.Name)
-				switch {
-				case val.Body != nil:
-					_, _ = part.Write(val.Body)
-				case val.Reader != nil:
-					_, _ = io.Copy(part, val.Reader)
-					c, ok := val.Reader.(io.Closer)
-					if ok {
-						c.Close()
-					}
-				case val.File != "":
-					file, err := os.Open(val.File)
-					if err == nil {
-						_, _ = io.Copy(part, file)
-						file.Close()
-					}
+				if err == nil {
+					err = writeFormFile(w, key, val)
 				}
 			}
 		}
-		w.Close()
-		wc.Close()
+		if err == nil {
+			err = w.Close()
+		}
+		wc.CloseWithError(err)
 	}()
 }
 
+func writeFormFile(w *multipart.Writer, key string, val fileContent) error {
+	part, err := w.CreateFormFile(key, val.Name)
+	if err != nil {
+		return err
+	}
+	var reader io.Reader
+	switch {
+	case val.Body != nil:
+		_, err = part.Write(val.Body)
+		return err
+	case val.Reader != nil:
+		reader = val.Reader
+	case val.Open != nil:
+		reader, err = val.Open()
+	case val.File != "":
+		reader, err = os.Open(val.File)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, reader)
+	c, ok := reader.(io.Closer)
+	if ok {
+		c.Close()
+	}
+	return err
+}
+
 func (body *bodyForm) Close() error {
 	if body.reader != nil {
 		return body.reader.Close()
@@ -538,6 +577,8 @@ func (body *bodyForm) AddFile(key string, name string, data any) {
 			content.Name = filepath.Base(b)
 		}
 		content.File = b
+	case func() (io.ReadCloser, error):
+		content.Open = b
 	case io.Reader:
 		body.noClone = true
 		content.Reader = b
@@ -547,6 +588,103 @@ func (body *bodyForm) AddFile(key string, name string, data any) {
 	body.files[key] = append(body.files[key], content)
 }
 
+type bodyJSONLines struct {
+	reader io.ReadCloser
+	data   any
+}
+
+// The NewClientBodyJSONLines function creates [MimeApplicationNDJSON] request
+// body, which streams each element as a line of JSON.
+//
+// The data type is chan, slice or iterator func(yield func(T) bool),
+// other type reads [ErrClientBodyInvalidLines].
+// The chan can only be read once and does not support GetBody.
+func NewClientBodyJSONLines(data any) ClientBody {
+	return &bodyJSONLines{data: data}
+}
+
+func (body *bodyJSONLines) Read(p []byte) (int, error) {
+	if body.reader == nil {
+		rc, wc := io.Pipe()
+		body.reader = rc
+		go func() {
+			var err error
+			enc := json.NewEncoder(wc)
+			ok := rangeClientBodyValues(body.data, func(val any) bool {
+				err = enc.Encode(val)
+				return err == nil
+			})
+			if !ok {
+				err = ErrClientBodyInvalidLines
+			}
+			wc.CloseWithError(err)
+		}()
+	}
+	return body.reader.Read(p)
+}
+
+func (body *bodyJSONLines) Close() error {
+	if body.reader != nil {
+		return body.reader.Close()
+	}
+	return nil
+}
+
+func (body *bodyJSONLines) GetContentType() string {
+	return MimeApplicationNDJSON
+}
+
+func (body *bodyJSONLines) GetBody() (io.ReadCloser, error) {
+	if reflect.ValueOf(body.data).Kind() == reflect.Chan {
+		return nil, ErrClientBodyNotGetBody
+	}
+	return &bodyJSONLines{data: body.data}, nil
+}
+
+func (body *bodyJSONLines) AddValue(string, any)        {}
+func (body *bodyJSONLines) AddFile(string, string, any) {}
+
+// The rangeClientBodyValues function calls fn for each element of the chan,
+// slice or iterator until fn returns false,
+// and returns false if the data type is not supported.
+func rangeClientBodyValues(data any, fn func(any) bool) bool {
+	v := reflect.ValueOf(data)
+	switch v.Kind() {
+	case reflect.Chan:
+		for {
+			val, ok := v.Recv()
+			if !ok || !fn(val.Interface()) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !fn(v.Index(i).Interface()) {
+				break
+			}
+		}
+		return true
+	case reflect.Func:
+		t := v.Type()
+		if t.NumIn() != 1 || t.NumOut() != 0 || t.In(0).Kind() != reflect.Func {
+			return false
+		}
+		yield := t.In(0)
+		if yield.NumIn() != 1 || yield.NumOut() != 1 ||
+			yield.Out(0).Kind() != reflect.Bool {
+			return false
+		}
+		v.Call([]reflect.Value{reflect.MakeFunc(yield,
+			func(args []reflect.Value) []reflect.Value {
+				return []reflect.Value{reflect.ValueOf(fn(args[0].Interface()))}
+			},
+		)})
+		return true
+	default:
+		return false
+	}
+}
+
 // The NewClientCheckStatus method creates [ClientOption] to check the response
 // status code.
 func NewClientCheckStatus(status ...int) func(*http.Response) error {
@@ -568,7 +706,8 @@ func NewClientCheckStatus(status ...int) func(*http.Response) error {
 // data type is *string or [io.Writer] to write data directly.
 //
 // If the [HeaderContentType] value is [MimeApplicationJSON]
-// [MimeApplicationXML],
+// [MimeApplicationXML] [MimeApplicationProtobuf] [MimeApplicationMsgpack]
+// [MimeApplicationCBOR],
 // use the corresponding Decoder to parse.
 func NewClientParse(data any) func(*http.Response) error {
 	return func(w *http.Response) error {
@@ -656,6 +795,19 @@ func clientParseIn(w *http.Response, star, end int, data any) error {
 		return json.NewDecoder(w.Body).Decode(data)
 	case MimeApplicationXML:
 		return xml.NewDecoder(w.Body).Decode(data)
+	case MimeApplicationProtobuf, MimeApplicationMsgpack, MimeApplicationCBOR:
+		body, err := io.ReadAll(w.Body)
+		if err != nil {
+			return err
+		}
+		switch mime {
+		case MimeApplicationProtobuf:
+			return UnmarshalProtobuf(body, data)
+		case MimeApplicationMsgpack:
+			return UnmarshalMsgpack(body, data)
+		default:
+			return UnmarshalCBOR(body, data)
+		}
 	}
 	return fmt.Errorf(ErrClientParseBodyError, mime)
 }
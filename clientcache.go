@@ -0,0 +1,507 @@
+package eudore
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ClientCacheStore defines the storage of [NewClientHookCache].
+//
+// Get returns an error if the key does not exist.
+//
+// Implementations: [NewClientCacheStoreMap] [NewClientCacheStoreFile].
+type ClientCacheStore interface {
+	Get(key string) ([]byte, error)
+	Set(key string, val []byte) error
+	Delete(key string) error
+}
+
+type clientHookCache struct {
+	next  http.RoundTripper
+	store ClientCacheStore
+}
+
+// clientCacheEntry defines the stored response and the request values
+// selected by Vary.
+type clientCacheEntry struct {
+	RequestTime  time.Time
+	ResponseTime time.Time
+	Vary         http.Header
+	Response     *http.Response
+	Body         []byte
+}
+
+// clientCacheControl defines the parsed Cache-Control directives.
+type clientCacheControl struct {
+	NoStore        bool
+	NoCache        bool
+	MustRevalidate bool
+	MaxAge         int
+	StaleIfError   int
+}
+
+var clientCacheStatus = map[int]struct{}{
+	StatusOK: {}, StatusNonAuthoritativeInfo: {}, StatusNoContent: {},
+	StatusMultipleChoices: {}, StatusMovedPermanently: {},
+	StatusPermanentRedirect: {}, StatusNotFound: {},
+	StatusMethodNotAllowed: {}, StatusGone: {},
+	StatusRequestURITooLong: {}, StatusNotImplemented: {},
+}
+
+// NewClientHookCache function creates [ClientHook] to implement an
+// RFC 9111 private cache for GET requests.
+//
+// The response is stored by method, URL and the request headers selected by
+// Vary, and the freshness uses Cache-Control max-age, Expires or
+// Last-Modified heuristic.
+// The stale response is revalidated using If-None-Match and
+// If-Modified-Since, and serve stale response when the error or
+// StatusCode >= 500 and stale-if-error allows.
+//
+// The unsafe method deletes the cached response of the URL.
+//
+// If store is nil, use [NewClientCacheStoreMap].
+//
+// RFC 5861: HTTP Cache-Control Extensions for Stale Content
+//
+// RFC 9111: HTTP Caching.
+func NewClientHookCache(store ClientCacheStore) ClientHook {
+	if store == nil {
+		store = NewClientCacheStoreMap()
+	}
+	return &clientHookCache{store: store}
+}
+
+func (*clientHookCache) Name() string { return "cache" }
+func (hook *clientHookCache) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookCache{
+		next:  rt,
+		store: hook.store,
+	}
+}
+
+//nolint:cyclop,gocyclo
+func (hook *clientHookCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := MethodGet + " " + req.URL.String()
+	switch req.Method {
+	case MethodGet, MethodHead, MethodOptions, MethodTrace:
+	default:
+		resp, err := hook.next.RoundTrip(req)
+		if err == nil && resp.StatusCode < StatusBadRequest {
+			_ = hook.store.Delete(key)
+		}
+		return resp, err
+	}
+	reqcc := newClientCacheControl(req.Header)
+	if req.Method != MethodGet || reqcc.NoStore || req.Header.Get(HeaderRange) != "" {
+		return hook.next.RoundTrip(req)
+	}
+
+	now := time.Now()
+	entry := hook.load(key, req)
+	if entry != nil && !reqcc.NoCache {
+		age := entry.Age(now)
+		fresh := entry.Lifetime()
+		if reqcc.MaxAge >= 0 && time.Duration(reqcc.MaxAge)*time.Second < fresh {
+			fresh = time.Duration(reqcc.MaxAge) * time.Second
+		}
+		if age < fresh && !newClientCacheControl(entry.Response.Header).NoCache {
+			return entry.NewResponse(req, age), nil
+		}
+	}
+
+	r := req
+	if entry != nil {
+		r = entry.NewConditional(req)
+	}
+	resp, err := hook.next.RoundTrip(r)
+	if entry != nil && entry.IsStaleIfError(resp, err, reqcc, now) {
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return entry.NewResponse(req, entry.Age(now)), nil
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	if entry != nil && resp.StatusCode == StatusNotModified {
+		_ = resp.Body.Close()
+		for k, v := range resp.Header {
+			switch k {
+			case HeaderContentLength, HeaderContentEncoding, HeaderTransferEncoding:
+			default:
+				entry.Response.Header[k] = v
+			}
+		}
+		entry.RequestTime = now
+		entry.ResponseTime = time.Now()
+		hook.save(key, entry)
+		return entry.NewResponse(req, entry.Age(entry.ResponseTime)), nil
+	}
+
+	respcc := newClientCacheControl(resp.Header)
+	_, ok := clientCacheStatus[resp.StatusCode]
+	if !ok || respcc.NoStore || resp.Header.Get(HeaderVary) == "*" ||
+		(respcc.MaxAge < 0 && resp.Header.Get(HeaderExpires) == "" &&
+			resp.Header.Get(HeaderETag) == "" &&
+			resp.Header.Get(HeaderLastModified) == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, DefaultClientCacheBodyLimit+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	// the oversize body is not cached and continues to read.
+	if len(body) > int(DefaultClientCacheBodyLimit) {
+		resp.Body = &clientCacheBody{
+			Reader: io.MultiReader(bytes.NewReader(body), resp.Body),
+			Closer: resp.Body,
+		}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry = &clientCacheEntry{
+		RequestTime:  now,
+		ResponseTime: time.Now(),
+		Vary:         make(http.Header),
+		Response:     resp,
+		Body:         body,
+	}
+	for _, name := range getClientCacheVary(resp.Header) {
+		entry.Vary[name] = req.Header.Values(name)
+	}
+	hook.save(key, entry)
+	return resp, nil
+}
+
+// The load method returns the entry that matches the request Vary.
+func (hook *clientHookCache) load(key string, req *http.Request) *clientCacheEntry {
+	data, err := hook.store.Get(key)
+	if err != nil {
+		return nil
+	}
+	entry := &clientCacheEntry{}
+	err = entry.UnmarshalBinary(data)
+	if err != nil {
+		_ = hook.store.Delete(key)
+		return nil
+	}
+	for name, vals := range entry.Vary {
+		if strings.Join(vals, ", ") != strings.Join(req.Header.Values(name), ", ") {
+			return nil
+		}
+	}
+	return entry
+}
+
+func (hook *clientHookCache) save(key string, entry *clientCacheEntry) {
+	data, err := entry.MarshalBinary()
+	if err == nil {
+		_ = hook.store.Set(key, data)
+	}
+}
+
+type clientCacheBody struct {
+	io.Reader
+	io.Closer
+}
+
+func getClientCacheVary(h http.Header) []string {
+	var names []string
+	for _, vary := range h.Values(HeaderVary) {
+		for _, name := range strings.Split(vary, ",") {
+			name = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+func newClientCacheControl(h http.Header) clientCacheControl {
+	cc := clientCacheControl{MaxAge: -1, StaleIfError: -1}
+	for _, line := range h.Values(HeaderCacheControl) {
+		for _, s := range strings.Split(line, ",") {
+			k, v, _ := strings.Cut(strings.TrimSpace(s), "=")
+			v = strings.Trim(v, "\"")
+			switch strings.ToLower(k) {
+			case "no-store":
+				cc.NoStore = true
+			case "no-cache":
+				cc.NoCache = true
+			case "must-revalidate", "proxy-revalidate":
+				cc.MustRevalidate = true
+			case "max-age":
+				cc.MaxAge = getClientCacheSeconds(v)
+			case "stale-if-error":
+				cc.StaleIfError = getClientCacheSeconds(v)
+			}
+		}
+	}
+	if h.Get(HeaderPragma) == "no-cache" && h.Get(HeaderCacheControl) == "" {
+		cc.NoCache = true
+	}
+	return cc
+}
+
+func getClientCacheSeconds(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// The Age method returns the current age of the response.
+//
+// RFC 9111 4.2.3.
+func (entry *clientCacheEntry) Age(now time.Time) time.Duration {
+	h := entry.Response.Header
+	date, err := http.ParseTime(h.Get(HeaderDate))
+	if err != nil {
+		date = entry.ResponseTime
+	}
+	age := time.Duration(getClientCacheSeconds(h.Get(HeaderAge))) * time.Second
+	apparent := entry.ResponseTime.Sub(date)
+	corrected := age + entry.ResponseTime.Sub(entry.RequestTime)
+	if apparent > corrected {
+		corrected = apparent
+	}
+	return corrected + now.Sub(entry.ResponseTime)
+}
+
+// The Lifetime method returns the freshness lifetime of the response,
+// using 10% of the time since Last-Modified as heuristic.
+//
+// RFC 9111 4.2.1.
+func (entry *clientCacheEntry) Lifetime() time.Duration {
+	h := entry.Response.Header
+	cc := newClientCacheControl(h)
+	if cc.MaxAge >= 0 {
+		return time.Duration(cc.MaxAge) * time.Second
+	}
+	date, err := http.ParseTime(h.Get(HeaderDate))
+	if err != nil {
+		date = entry.ResponseTime
+	}
+	if h.Get(HeaderExpires) != "" {
+		expires, err := http.ParseTime(h.Get(HeaderExpires))
+		if err != nil {
+			return 0
+		}
+		return expires.Sub(date)
+	}
+	modified, err := http.ParseTime(h.Get(HeaderLastModified))
+	if err == nil && modified.Before(date) {
+		return date.Sub(modified) / 10
+	}
+	return 0
+}
+
+// The IsStaleIfError method returns whether the stale response can be used
+// when the error or StatusCode >= 500.
+func (entry *clientCacheEntry) IsStaleIfError(resp *http.Response, err error,
+	reqcc clientCacheControl, now time.Time,
+) bool {
+	if err == nil && resp.StatusCode < StatusInternalServerError {
+		return false
+	}
+	respcc := newClientCacheControl(entry.Response.Header)
+	if respcc.MustRevalidate || respcc.NoCache {
+		return false
+	}
+	stale := respcc.StaleIfError
+	if reqcc.StaleIfError > stale {
+		stale = reqcc.StaleIfError
+	}
+	return stale >= 0 &&
+		entry.Age(now) < entry.Lifetime()+time.Duration(stale)*time.Second
+}
+
+// The NewConditional method creates a conditional request using the
+// validators of the response.
+func (entry *clientCacheEntry) NewConditional(req *http.Request) *http.Request {
+	etag := entry.Response.Header.Get(HeaderETag)
+	modified := entry.Response.Header.Get(HeaderLastModified)
+	if etag == "" && modified == "" {
+		return req
+	}
+
+	r := new(http.Request)
+	*r = *req
+	r.Header = req.Header.Clone()
+	if etag != "" && r.Header.Get(HeaderIfNoneMatch) == "" {
+		r.Header.Set(HeaderIfNoneMatch, etag)
+	}
+	if modified != "" && r.Header.Get(HeaderIfModifiedSince) == "" {
+		r.Header.Set(HeaderIfModifiedSince, modified)
+	}
+	return r
+}
+
+// The NewResponse method creates a response using the cached data.
+func (entry *clientCacheEntry) NewResponse(req *http.Request, age time.Duration,
+) *http.Response {
+	resp := new(http.Response)
+	*resp = *entry.Response
+	resp.Header = entry.Response.Header.Clone()
+	resp.Header.Set(HeaderAge, strconv.Itoa(int(age/time.Second)))
+	resp.Body = io.NopCloser(bytes.NewReader(entry.Body))
+	resp.ContentLength = int64(len(entry.Body))
+	resp.Request = req
+	return resp
+}
+
+// MarshalBinary method implements the [encoding.BinaryMarshaler] interface,
+// the format is the request and response time line, Vary MIME header and
+// HTTP response.
+func (entry *clientCacheEntry) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s\r\n",
+		entry.RequestTime.Format(time.RFC3339Nano),
+		entry.ResponseTime.Format(time.RFC3339Nano),
+	)
+	err := entry.Vary.Write(&buf)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("\r\n")
+
+	resp := new(http.Response)
+	*resp = *entry.Response
+	resp.Body = io.NopCloser(bytes.NewReader(entry.Body))
+	resp.ContentLength = int64(len(entry.Body))
+	resp.TransferEncoding = nil
+	resp.Request = nil
+	err = resp.Write(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary method implements the [encoding.BinaryUnmarshaler] interface.
+func (entry *clientCacheEntry) UnmarshalBinary(data []byte) error {
+	r := textproto.NewReader(bufio.NewReader(bytes.NewReader(data)))
+	line, err := r.ReadLine()
+	if err != nil {
+		return err
+	}
+	reqtime, resptime, _ := strings.Cut(line, " ")
+	entry.RequestTime, err = time.Parse(time.RFC3339Nano, reqtime)
+	if err != nil {
+		return err
+	}
+	entry.ResponseTime, err = time.Parse(time.RFC3339Nano, resptime)
+	if err != nil {
+		return err
+	}
+	vary, err := r.ReadMIMEHeader()
+	if err != nil {
+		return err
+	}
+	entry.Vary = http.Header(vary)
+
+	entry.Response, err = http.ReadResponse(r.R, nil)
+	if err != nil {
+		return err
+	}
+	entry.Body, err = io.ReadAll(entry.Response.Body)
+	return err
+}
+
+type clientCacheMap struct {
+	sync.Map
+}
+
+// NewClientCacheStoreMap function creates [ClientCacheStore] using
+// [sync.Map], which is the default storage.
+func NewClientCacheStoreMap() ClientCacheStore {
+	return &clientCacheMap{}
+}
+
+func (c *clientCacheMap) Get(key string) ([]byte, error) {
+	val, ok := c.Load(key)
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return val.([]byte), nil
+}
+
+func (c *clientCacheMap) Set(key string, val []byte) error {
+	c.Store(key, val)
+	return nil
+}
+
+func (c *clientCacheMap) Delete(key string) error {
+	c.Map.Delete(key)
+	return nil
+}
+
+type clientCacheFile struct {
+	dir string
+}
+
+// NewClientCacheStoreFile function creates [ClientCacheStore] using files in
+// dir, the file name is the sha256 of the key.
+func NewClientCacheStoreFile(dir string) ClientCacheStore {
+	return &clientCacheFile{dir}
+}
+
+func (c *clientCacheFile) name(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+func (c *clientCacheFile) Get(key string) ([]byte, error) {
+	return os.ReadFile(c.name(key))
+}
+
+// The Set method writes a temporary file and then renames it.
+func (c *clientCacheFile) Set(key string, val []byte) error {
+	err := os.MkdirAll(c.dir, 0o755)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(val)
+	if err1 := file.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), c.name(key))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return err
+}
+
+func (c *clientCacheFile) Delete(key string) error {
+	err := os.Remove(c.name(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,484 @@
+package eudore
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ClientHAR defines the HTTP Archive 1.2 format.
+//
+// refer: http://www.softwareishard.com/blog/har-12-spec/
+type ClientHAR struct {
+	sync.Mutex `json:"-"`
+	Log        ClientHARLog `json:"log"`
+}
+
+// ClientHARLog defines the HAR log.
+type ClientHARLog struct {
+	Version string           `json:"version"`
+	Creator ClientHARCreator `json:"creator"`
+	Entries []ClientHAREntry `json:"entries"`
+}
+
+// ClientHARCreator defines the HAR log creator.
+type ClientHARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// ClientHAREntry defines the HAR entry of a request.
+type ClientHAREntry struct {
+	StartedDateTime time.Time         `json:"startedDateTime"`
+	Time            float64           `json:"time"`
+	Request         ClientHARRequest  `json:"request"`
+	Response        ClientHARResponse `json:"response"`
+	Cache           struct{}          `json:"cache"`
+	Timings         ClientHARTimings  `json:"timings"`
+	ServerIPAddress string            `json:"serverIPAddress,omitempty"`
+	Connection      string            `json:"connection,omitempty"`
+	Error           string            `json:"_error,omitempty"`
+}
+
+// ClientHARRequest defines the HAR request.
+type ClientHARRequest struct {
+	Method      string             `json:"method"`
+	URL         string             `json:"url"`
+	HTTPVersion string             `json:"httpVersion"`
+	Cookies     []ClientHARPair    `json:"cookies"`
+	Headers     []ClientHARPair    `json:"headers"`
+	QueryString []ClientHARPair    `json:"queryString"`
+	PostData    *ClientHARPostData `json:"postData,omitempty"`
+	HeadersSize int64              `json:"headersSize"`
+	BodySize    int64              `json:"bodySize"`
+}
+
+// ClientHARResponse defines the HAR response.
+type ClientHARResponse struct {
+	Status      int              `json:"status"`
+	StatusText  string           `json:"statusText"`
+	HTTPVersion string           `json:"httpVersion"`
+	Cookies     []ClientHARPair  `json:"cookies"`
+	Headers     []ClientHARPair  `json:"headers"`
+	Content     ClientHARContent `json:"content"`
+	RedirectURL string           `json:"redirectURL"`
+	HeadersSize int64            `json:"headersSize"`
+	BodySize    int64            `json:"bodySize"`
+}
+
+// ClientHARPair defines the HAR header, query and cookie.
+type ClientHARPair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ClientHARPostData defines the HAR request body.
+type ClientHARPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// ClientHARContent defines the HAR response body.
+type ClientHARContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// ClientHARTimings defines the HAR timings in milliseconds,
+// -1 if the timing does not apply.
+type ClientHARTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// NewClientHARWithRecords function creates [ClientHAR] using [ClientRecord].
+func NewClientHARWithRecords(records []*ClientRecord) *ClientHAR {
+	har := NewClientHAR()
+	for _, record := range records {
+		entry := ClientHAREntry{
+			StartedDateTime: record.Time,
+			Time:            float64(record.Duration) / float64(time.Millisecond),
+			Request: ClientHARRequest{
+				Method:      record.Request.Method,
+				URL:         record.Request.URL,
+				HTTPVersion: "HTTP/1.1",
+				Cookies:     []ClientHARPair{},
+				Headers:     newClientHARPairs(record.Request.Header),
+				QueryString: []ClientHARPair{},
+				HeadersSize: -1,
+				BodySize:    getClientRecordBodySize(record.Request.Body, record.Request.Encoding),
+			},
+			Response: ClientHARResponse{
+				Status:      record.Response.Status,
+				StatusText:  http.StatusText(record.Response.Status),
+				HTTPVersion: "HTTP/1.1",
+				Cookies:     []ClientHARPair{},
+				Headers:     newClientHARPairs(record.Response.Header),
+				Content: ClientHARContent{
+					Size:     getClientRecordBodySize(record.Response.Body, record.Response.Encoding),
+					MimeType: record.Response.Header.Get(HeaderContentType),
+					Text:     record.Response.Body,
+					Encoding: record.Response.Encoding,
+				},
+				RedirectURL: record.Response.Header.Get(HeaderLocation),
+				HeadersSize: -1,
+				BodySize:    getClientRecordBodySize(record.Response.Body, record.Response.Encoding),
+			},
+			Timings: ClientHARTimings{
+				Blocked: -1, DNS: -1, Connect: -1, SSL: -1,
+				Wait: float64(record.Duration) / float64(time.Millisecond),
+			},
+		}
+		u, err := url.Parse(record.Request.URL)
+		if err == nil {
+			entry.Request.QueryString = newClientHARPairs(u.Query())
+		}
+		if record.Request.Body != "" {
+			entry.Request.PostData = &ClientHARPostData{
+				MimeType: record.Request.Header.Get(HeaderContentType),
+				Text:     record.Request.Body,
+				Encoding: record.Request.Encoding,
+			}
+		}
+		har.Log.Entries = append(har.Log.Entries, entry)
+	}
+	return har
+}
+
+// NewClientHAR function creates an empty [ClientHAR].
+func NewClientHAR() *ClientHAR {
+	version := "devel"
+	info, ok := debug.ReadBuildInfo()
+	if ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/eudore/eudore" {
+				version = dep.Version
+			}
+		}
+	}
+	return &ClientHAR{Log: ClientHARLog{
+		Version: "1.2",
+		Creator: ClientHARCreator{Name: "eudore", Version: version},
+		Entries: []ClientHAREntry{},
+	}}
+}
+
+func newClientHARPairs(vals map[string][]string) []ClientHARPair {
+	pairs := []ClientHARPair{}
+	for k, vs := range vals {
+		for _, v := range vs {
+			pairs = append(pairs, ClientHARPair{k, v})
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Name < pairs[j].Name
+	})
+	return pairs
+}
+
+// The Records method converts HAR entries to [ClientRecord].
+func (har *ClientHAR) Records() []*ClientRecord {
+	records := make([]*ClientRecord, len(har.Log.Entries))
+	for i, entry := range har.Log.Entries {
+		record := &ClientRecord{
+			Time:     entry.StartedDateTime,
+			Duration: time.Duration(entry.Time * float64(time.Millisecond)),
+			Request: ClientRecordRequest{
+				Method: entry.Request.Method,
+				URL:    entry.Request.URL,
+				Header: make(http.Header),
+			},
+			Response: ClientRecordResponse{
+				Status:   entry.Response.Status,
+				Header:   make(http.Header),
+				Body:     entry.Response.Content.Text,
+				Encoding: entry.Response.Content.Encoding,
+			},
+		}
+		for _, h := range entry.Request.Headers {
+			record.Request.Header.Add(h.Name, h.Value)
+		}
+		for _, h := range entry.Response.Headers {
+			record.Response.Header.Add(h.Name, h.Value)
+		}
+		if entry.Request.PostData != nil {
+			record.Request.Body = entry.Request.PostData.Text
+			record.Request.Encoding = entry.Request.PostData.Encoding
+		}
+		records[i] = record
+	}
+	return records
+}
+
+type clientHookHAR struct {
+	next http.RoundTripper
+	har  *ClientHAR
+	body bool
+}
+
+// NewClientHookHAR function creates [ClientHook] to aggregate the requests
+// into har, the timings use [ClientTrace].
+//
+// If body is true, the request body that supports GetBody and the response
+// body are saved, otherwise the entry is appended when the response body is
+// closed.
+//
+// Lock har before reading it concurrently.
+func NewClientHookHAR(har *ClientHAR, body bool) ClientHook {
+	return &clientHookHAR{har: har, body: body}
+}
+
+func (*clientHookHAR) Name() string { return "har" }
+func (hook *clientHookHAR) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookHAR{
+		next: rt,
+		har:  hook.har,
+		body: hook.body,
+	}
+}
+
+func (hook *clientHookHAR) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := &ClientTrace{}
+	req = req.WithContext(NewClientTraceWithContext(req.Context(), trace))
+	var reqbody []byte
+	if hook.body && req.GetBody != nil && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err == nil {
+			reqbody, _ = io.ReadAll(body)
+			_ = body.Close()
+		}
+	}
+
+	resp, err := hook.next.RoundTrip(req)
+	if err != nil {
+		entry := NewClientHAREntry(req, nil, trace)
+		entry.Error = err.Error()
+		hook.har.Append(entry)
+		return resp, err
+	}
+
+	if hook.body {
+		data, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		hook.release(req, resp, trace, reqbody, data, int64(len(data)))
+		return resp, err
+	}
+	resp.Body = &clientHARBody{
+		ReadCloser: resp.Body,
+		release: func(size int64) {
+			hook.release(req, resp, trace, nil, nil, size)
+		},
+	}
+	return resp, nil
+}
+
+func (hook *clientHookHAR) release(req *http.Request, resp *http.Response,
+	trace *ClientTrace, reqbody, respbody []byte, size int64,
+) {
+	trace.Lock()
+	trace.HTTPDone = time.Now()
+	trace.HTTPDuration = trace.HTTPDone.Sub(trace.HTTPStart)
+	trace.Unlock()
+	entry := NewClientHAREntry(req, resp, trace)
+	entry.Response.BodySize = size
+	entry.Response.Content.Size = size
+	if hook.body {
+		text, encoding := encodeClientRecordBody(respbody)
+		entry.Response.Content.Text = text
+		entry.Response.Content.Encoding = encoding
+		if len(reqbody) > 0 {
+			text, encoding = encodeClientRecordBody(reqbody)
+			entry.Request.PostData = &ClientHARPostData{
+				MimeType: req.Header.Get(HeaderContentType),
+				Text:     text,
+				Encoding: encoding,
+			}
+		}
+	}
+	hook.har.Append(entry)
+}
+
+// clientHARBody counts the response body and calls release when it is closed.
+type clientHARBody struct {
+	io.ReadCloser
+	size    int64
+	once    sync.Once
+	release func(int64)
+}
+
+func (b *clientHARBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+func (b *clientHARBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.release(b.size) })
+	return err
+}
+
+// The Append method appends the entry to the log.
+func (har *ClientHAR) Append(entry ClientHAREntry) {
+	har.Lock()
+	har.Log.Entries = append(har.Log.Entries, entry)
+	har.Unlock()
+}
+
+// NewClientHAREntry function creates [ClientHAREntry] using the request,
+// response and [ClientTrace], excluding the body.
+//
+// The request headers use [ClientTrace.WroteHeaders] if it is not empty.
+func NewClientHAREntry(req *http.Request, resp *http.Response, trace *ClientTrace,
+) ClientHAREntry {
+	if trace == nil {
+		trace = &ClientTrace{}
+	}
+	trace.Lock()
+	defer trace.Unlock()
+	header := trace.WroteHeaders
+	if header == nil {
+		header = req.Header
+	}
+	entry := ClientHAREntry{
+		StartedDateTime: trace.HTTPStart,
+		Request: ClientHARRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     newClientHARCookies(req.Cookies()),
+			Headers:     newClientHARPairs(header),
+			QueryString: newClientHARPairs(req.URL.Query()),
+			HeadersSize: -1,
+			BodySize:    req.ContentLength,
+		},
+		Response: ClientHARResponse{
+			Cookies:     []ClientHARPair{},
+			Headers:     []ClientHARPair{},
+			HeadersSize: -1,
+			BodySize:    -1,
+			Content:     ClientHARContent{Size: -1},
+		},
+		Timings: newClientHARTimings(trace),
+	}
+	if trace.GotConnRemoteAddr != nil {
+		entry.ServerIPAddress, _, _ = net.SplitHostPort(trace.GotConnRemoteAddr.String())
+	}
+	if trace.GotConnLocalAddr != nil {
+		_, entry.Connection, _ = net.SplitHostPort(trace.GotConnLocalAddr.String())
+	}
+	if resp != nil {
+		entry.Request.HTTPVersion = resp.Proto
+		entry.Response = ClientHARResponse{
+			Status:      resp.StatusCode,
+			StatusText:  http.StatusText(resp.StatusCode),
+			HTTPVersion: resp.Proto,
+			Cookies:     newClientHARCookies(resp.Cookies()),
+			Headers:     newClientHARPairs(resp.Header),
+			Content: ClientHARContent{
+				Size:     resp.ContentLength,
+				MimeType: resp.Header.Get(HeaderContentType),
+			},
+			RedirectURL: resp.Header.Get(HeaderLocation),
+			HeadersSize: -1,
+			BodySize:    resp.ContentLength,
+		}
+	}
+	for _, t := range [...]float64{
+		entry.Timings.Blocked, entry.Timings.DNS, entry.Timings.Connect,
+		entry.Timings.Send, entry.Timings.Wait, entry.Timings.Receive,
+	} {
+		if t > 0 {
+			entry.Time += t
+		}
+	}
+	return entry
+}
+
+// The newClientHARTimings function calculates the HAR timings,
+// the connect time includes the ssl time.
+func newClientHARTimings(trace *ClientTrace) ClientHARTimings {
+	timings := ClientHARTimings{
+		Blocked: -1, DNS: -1, Connect: -1, SSL: -1,
+		Send: 0, Wait: 0, Receive: 0,
+	}
+	if !trace.DNSStart.IsZero() && !trace.DNSDone.IsZero() {
+		timings.DNS = getClientHARDuration(trace.DNSStart, trace.DNSDone)
+	}
+	if len(trace.Connect) > 0 {
+		conn := trace.Connect[len(trace.Connect)-1]
+		done := conn.Done
+		if !trace.TLSHandshakeDone.IsZero() {
+			done = trace.TLSHandshakeDone
+		}
+		timings.Connect = getClientHARDuration(conn.Start, done)
+	}
+	if !trace.TLSHandshakeStart.IsZero() && !trace.TLSHandshakeDone.IsZero() {
+		timings.SSL = getClientHARDuration(trace.TLSHandshakeStart, trace.TLSHandshakeDone)
+	}
+	if trace.GotConn.IsZero() {
+		return timings
+	}
+
+	start := trace.GetConn
+	if start.IsZero() {
+		start = trace.HTTPStart
+	}
+	blocked := getClientHARDuration(start, trace.GotConn)
+	if timings.DNS > 0 {
+		blocked -= timings.DNS
+	}
+	if timings.Connect > 0 {
+		blocked -= timings.Connect
+	}
+	if blocked < 0 {
+		blocked = 0
+	}
+	timings.Blocked = blocked
+
+	wrote := trace.WroteRequest
+	if wrote.IsZero() {
+		wrote = trace.GotConn
+	}
+	timings.Send = getClientHARDuration(trace.GotConn, wrote)
+	if !trace.GotFirstResponseByte.IsZero() {
+		timings.Wait = getClientHARDuration(wrote, trace.GotFirstResponseByte)
+		if !trace.HTTPDone.IsZero() {
+			timings.Receive = getClientHARDuration(trace.GotFirstResponseByte, trace.HTTPDone)
+		}
+	}
+	return timings
+}
+
+// The getClientHARDuration function returns the milliseconds from start to
+// end, and returns 0 if it is negative.
+func getClientHARDuration(start, end time.Time) float64 {
+	d := end.Sub(start)
+	if d < 0 || start.IsZero() || end.IsZero() {
+		return 0
+	}
+	return float64(d) / float64(time.Millisecond)
+}
+
+func newClientHARCookies(cookies []*http.Cookie) []ClientHARPair {
+	pairs := make([]ClientHARPair, len(cookies))
+	for i := range cookies {
+		pairs[i] = ClientHARPair{cookies[i].Name, cookies[i].Value}
+	}
+	return pairs
+}
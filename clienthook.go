@@ -10,12 +10,14 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"hash/fnv"
 	"io"
 	"math/rand"
 	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -327,6 +329,11 @@ func (hook *clientHookRetry) Wrap(rt http.RoundTripper) http.RoundTripper {
 }
 
 func (hook *clientHookRetry) RoundTrip(req *http.Request) (*http.Response, error) {
+	if hook.num > 0 {
+		req = req.WithContext(context.WithValue(req.Context(),
+			contextKeyClientTried, &clientBalancerTried{},
+		))
+	}
 	for i := 0; i <= hook.num; i++ {
 		resp, err := hook.next.RoundTrip(req)
 		if hook.condition(resp, err) {
@@ -375,6 +382,564 @@ func randInterval(n time.Duration) time.Duration {
 	return time.Duration(rand.Int63n(int64(n)))
 }
 
+type clientHookBreaker struct {
+	next    http.RoundTripper
+	breaker *clientBreaker
+}
+
+type clientBreaker struct {
+	Entrys       sync.Map
+	GetKeyFunc   func(*http.Request) string
+	MaxSuccesses int
+	MaxFailures  int
+	OpenWait     time.Duration
+}
+
+// clientBreakerEntry defines the breaker data for a single key.
+type clientBreakerEntry struct {
+	sync.Mutex
+	State                int
+	Probing              bool
+	OpenTime             time.Time
+	LastTime             time.Time
+	ConsecutiveSuccesses int
+	ConsecutiveFailures  int
+	TotalSuccesses       uint64
+	TotalFailures        uint64
+}
+
+// Define the client breaker state.
+const (
+	clientBreakerClosed = iota
+	clientBreakerHalfOpen
+	clientBreakerOpen
+)
+
+var clientBreakerStates = [...]string{"closed", "half-open", "open"}
+
+// ClientBreakerError defines the error returned when [NewClientHookBreaker]
+// rejects the request, errors.Is matches [ErrClientBreakerOpen].
+type ClientBreakerError struct {
+	Key   string
+	State string
+	// Retry is the duration until the breaker allows the next probe.
+	Retry time.Duration
+}
+
+func (err *ClientBreakerError) Error() string {
+	return fmt.Sprintf(ErrClientBreakerRejected, err.Key, err.State, err.Retry)
+}
+
+func (err *ClientBreakerError) Unwrap() error {
+	return ErrClientBreakerOpen
+}
+
+// NewClientHookBreaker function creates [ClientHook] to implement request
+// circuit breaking, each key has an independent breaker.
+//
+// When the request error is not empty or StatusCode >= 500, it is a failure.
+// After failures consecutive failures the breaker is open and the request
+// returns [ClientBreakerError] without sending;
+// after wait the breaker is half-open and allows one probe request at a time,
+// successes consecutive successes close the breaker and a failure opens it.
+//
+// If fn is nil, use [http.Request.Host] as key, an empty key is not limited.
+// The breaker state is exposed in [MetadataClient].
+func NewClientHookBreaker(successes, failures int, wait time.Duration,
+	fn func(*http.Request) string,
+) ClientHook {
+	if fn == nil {
+		fn = func(req *http.Request) string {
+			return req.Host
+		}
+	}
+	return &clientHookBreaker{breaker: &clientBreaker{
+		GetKeyFunc:   fn,
+		MaxSuccesses: GetAnyDefault(successes, 1),
+		MaxFailures:  GetAnyDefault(failures, 1),
+		OpenWait:     wait,
+	}}
+}
+
+func (*clientHookBreaker) Name() string { return "breaker" }
+func (hook *clientHookBreaker) String() string {
+	return fmt.Sprintf("breaker successes=%d failures=%d wait=%s",
+		hook.breaker.MaxSuccesses, hook.breaker.MaxFailures, hook.breaker.OpenWait,
+	)
+}
+
+func (hook *clientHookBreaker) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookBreaker{
+		next:    rt,
+		breaker: hook.breaker,
+	}
+}
+
+func (hook *clientHookBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := hook.breaker.GetKeyFunc(req)
+	if key == "" {
+		return hook.next.RoundTrip(req)
+	}
+
+	val, ok := hook.breaker.Entrys.Load(key)
+	if !ok {
+		val, _ = hook.breaker.Entrys.LoadOrStore(key, &clientBreakerEntry{})
+	}
+	entry := val.(*clientBreakerEntry)
+	now := time.Now()
+	state, retry := entry.OnAccess(now, hook.breaker.OpenWait)
+	if state != clientBreakerClosed && retry >= 0 {
+		return nil, &ClientBreakerError{
+			Key:   key,
+			State: clientBreakerStates[state],
+			Retry: retry,
+		}
+	}
+
+	resp, err := hook.next.RoundTrip(req)
+	if err != nil || resp.StatusCode >= StatusInternalServerError {
+		entry.OnFailed(state, hook.breaker.MaxFailures)
+	} else {
+		entry.OnSucceed(state, hook.breaker.MaxSuccesses)
+	}
+	return resp, err
+}
+
+// The Metadata method returns the state of all breaker entrys sorted by key.
+func (hook *clientHookBreaker) Metadata() []MetadataClientBreaker {
+	var data []MetadataClientBreaker
+	hook.breaker.Entrys.Range(func(key, val any) bool {
+		entry := val.(*clientBreakerEntry)
+		entry.Lock()
+		data = append(data, MetadataClientBreaker{
+			Key:                  key.(string),
+			State:                clientBreakerStates[entry.State],
+			LastTime:             entry.LastTime,
+			ConsecutiveSuccesses: entry.ConsecutiveSuccesses,
+			ConsecutiveFailures:  entry.ConsecutiveFailures,
+			TotalSuccesses:       entry.TotalSuccesses,
+			TotalFailures:        entry.TotalFailures,
+		})
+		entry.Unlock()
+		return true
+	})
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Key < data[j].Key
+	})
+	return data
+}
+
+// The OnAccess method returns the state used by the request,
+// if retry is not less than 0, the request is rejected.
+func (entry *clientBreakerEntry) OnAccess(now time.Time, wait time.Duration,
+) (int, time.Duration) {
+	entry.Lock()
+	defer entry.Unlock()
+	entry.LastTime = now
+	if entry.State == clientBreakerOpen {
+		retry := entry.OpenTime.Add(wait).Sub(now)
+		if retry > 0 {
+			return clientBreakerOpen, retry
+		}
+		entry.State = clientBreakerHalfOpen
+	}
+	if entry.State == clientBreakerHalfOpen {
+		if entry.Probing {
+			return clientBreakerHalfOpen, 0
+		}
+		entry.Probing = true
+		return clientBreakerHalfOpen, -1
+	}
+	return clientBreakerClosed, -1
+}
+
+func (entry *clientBreakerEntry) OnSucceed(state, limit int) {
+	entry.Lock()
+	entry.TotalSuccesses++
+	entry.ConsecutiveSuccesses++
+	entry.ConsecutiveFailures = 0
+	if state == clientBreakerHalfOpen {
+		entry.Probing = false
+		if entry.State == clientBreakerHalfOpen &&
+			entry.ConsecutiveSuccesses >= limit {
+			entry.State = clientBreakerClosed
+			entry.ConsecutiveSuccesses = 0
+		}
+	}
+	entry.Unlock()
+}
+
+// The OnFailed method opens the breaker at the time of failure,
+// so that slow requests do not shorten the open wait.
+func (entry *clientBreakerEntry) OnFailed(state, limit int) {
+	entry.Lock()
+	entry.TotalFailures++
+	entry.ConsecutiveFailures++
+	entry.ConsecutiveSuccesses = 0
+	if state == clientBreakerHalfOpen {
+		entry.Probing = false
+	}
+	if entry.State != clientBreakerOpen &&
+		(state == clientBreakerHalfOpen || entry.ConsecutiveFailures >= limit) {
+		entry.State = clientBreakerOpen
+		entry.OpenTime = time.Now()
+		entry.ConsecutiveFailures = 0
+	}
+	entry.Unlock()
+}
+
+// ClientResolver defines the function that resolves the request host to
+// the endpoints used by [NewClientHookBalancer].
+//
+// If the endpoints is empty, the request is sent to the original host.
+type ClientResolver func(ctx context.Context, host string) ([]string, error)
+
+// NewClientResolverStatic function creates [ClientResolver] using
+// a static host to endpoints mapping.
+func NewClientResolverStatic(hosts map[string][]string) ClientResolver {
+	hosts = mapClone(hosts)
+	return func(_ context.Context, host string) ([]string, error) {
+		return hosts[host], nil
+	}
+}
+
+// NewClientResolverSRV function creates [ClientResolver] using DNS SRV
+// records, only resolves host with the prefix '_', such as
+// '_http._tcp.example.com'.
+//
+// Use the target of the lowest priority and cache it for ttl.
+func NewClientResolverSRV(ttl time.Duration) ClientResolver {
+	type entry struct {
+		expire    time.Time
+		endpoints []string
+	}
+	var mu sync.Mutex
+	caches := make(map[string]entry)
+	return func(ctx context.Context, host string) ([]string, error) {
+		if !strings.HasPrefix(host, "_") {
+			return nil, nil
+		}
+		now := time.Now()
+		mu.Lock()
+		e, ok := caches[host]
+		mu.Unlock()
+		if ok && now.Before(e.expire) {
+			return e.endpoints, nil
+		}
+
+		_, srvs, err := net.DefaultResolver.LookupSRV(ctx, "", "", host)
+		if err != nil {
+			return nil, err
+		}
+		endpoints := make([]string, 0, len(srvs))
+		for _, srv := range srvs {
+			if srv.Priority != srvs[0].Priority {
+				break
+			}
+			endpoints = append(endpoints, net.JoinHostPort(
+				strings.TrimSuffix(srv.Target, "."),
+				strconv.Itoa(int(srv.Port)),
+			))
+		}
+		sort.Strings(endpoints)
+
+		mu.Lock()
+		caches[host] = entry{now.Add(ttl), endpoints}
+		mu.Unlock()
+		return endpoints, nil
+	}
+}
+
+type clientHookBalancer struct {
+	next     http.RoundTripper
+	balancer *clientBalancer
+}
+
+type clientBalancer struct {
+	Pools       sync.Map
+	Resolver    ClientResolver
+	Policy      string
+	GetHashFunc func(*http.Request) string
+}
+
+type clientBalancerPool struct {
+	sync.Mutex
+	Addrs     []string
+	Endpoints []*clientBalancerEndpoint
+	Ring      []clientBalancerNode
+	Index     int
+}
+
+type clientBalancerEndpoint struct {
+	Addr      string
+	Inflight  int
+	Failures  int
+	EjectTime time.Time
+}
+
+type clientBalancerNode struct {
+	Hash     uint32
+	Endpoint *clientBalancerEndpoint
+}
+
+// clientBalancerTried records the endpoints used by [NewClientHookRetry],
+// so that retries are sent to a different endpoint.
+type clientBalancerTried struct {
+	sync.Mutex
+	Addrs []string
+}
+
+var contextKeyClientTried = NewContextKey("client-tried")
+
+// NewClientHookBalancer function creates [ClientHook] to implement
+// client-side load balancing, resolver resolves the request host to a pool of
+// endpoints and rewrites the request URL host.
+//
+// Available policy: round-robin least hash.
+//
+// The hash policy uses consistent hashing, and fn returns the hash key,
+// default use [http.Request.URL.Path].
+//
+// When an endpoint has [DefaultClientBalancerFailures] consecutive network
+// errors or StatusCode >= 500, it is ejected for
+// [DefaultClientBalancerEjectTime].
+//
+// When used with [NewClientHookRetry], retries prefer untried endpoints.
+func NewClientHookBalancer(resolver ClientResolver, policy string,
+	fn func(*http.Request) string,
+) ClientHook {
+	if fn == nil {
+		fn = func(req *http.Request) string {
+			return req.URL.Path
+		}
+	}
+	switch policy {
+	case "least", "hash":
+	default:
+		policy = "round-robin"
+	}
+	return &clientHookBalancer{balancer: &clientBalancer{
+		Resolver:    resolver,
+		Policy:      policy,
+		GetHashFunc: fn,
+	}}
+}
+
+func (*clientHookBalancer) Name() string { return "balancer" }
+func (hook *clientHookBalancer) String() string {
+	return "balancer policy=" + hook.balancer.Policy
+}
+
+func (hook *clientHookBalancer) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookBalancer{
+		next:     rt,
+		balancer: hook.balancer,
+	}
+}
+
+func (hook *clientHookBalancer) RoundTrip(req *http.Request) (*http.Response, error) {
+	addrs, err := hook.balancer.Resolver(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return hook.next.RoundTrip(req)
+	}
+
+	val, ok := hook.balancer.Pools.Load(req.URL.Host)
+	if !ok {
+		val, _ = hook.balancer.Pools.LoadOrStore(req.URL.Host, &clientBalancerPool{})
+	}
+	pool := val.(*clientBalancerPool)
+	tried, _ := req.Context().Value(contextKeyClientTried).(*clientBalancerTried)
+	var excludes []string
+	if tried != nil {
+		tried.Lock()
+		excludes = append(excludes, tried.Addrs...)
+		tried.Unlock()
+	}
+	endpoint := pool.Next(addrs, excludes, hook.balancer, req)
+	if tried != nil {
+		tried.Lock()
+		tried.Addrs = append(tried.Addrs, endpoint.Addr)
+		tried.Unlock()
+	}
+
+	r := new(http.Request)
+	*r = *req
+	u := *req.URL
+	u.Host = endpoint.Addr
+	r.URL = &u
+	if req.Host == req.URL.Host {
+		r.Host = ""
+	}
+
+	resp, err := hook.next.RoundTrip(r)
+	pool.Release(endpoint, err == nil && resp.StatusCode < StatusInternalServerError)
+	if resp != nil && resp.Body != nil {
+		resp.Body = &clientBalancerBody{
+			ReadCloser: resp.Body,
+			done:       func() { pool.Done(endpoint) },
+		}
+	} else {
+		pool.Done(endpoint)
+	}
+	return resp, err
+}
+
+// clientBalancerBody decrements the endpoint inflight when the body is closed.
+type clientBalancerBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *clientBalancerBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
+
+// The Next method updates the endpoints and selects an endpoint,
+// ejected and excluded endpoints are skipped if possible.
+func (pool *clientBalancerPool) Next(addrs, excludes []string,
+	b *clientBalancer, req *http.Request,
+) *clientBalancerEndpoint {
+	now := time.Now()
+	pool.Lock()
+	defer pool.Unlock()
+	if !sliceEqual(pool.Addrs, addrs) {
+		pool.Update(addrs, b.Policy == "hash")
+	}
+
+	var endpoint *clientBalancerEndpoint
+	for _, check := range [...]func(*clientBalancerEndpoint) bool{
+		func(e *clientBalancerEndpoint) bool {
+			return now.After(e.EjectTime) && sliceIndex(excludes, e.Addr) == -1
+		},
+		func(e *clientBalancerEndpoint) bool {
+			return now.After(e.EjectTime)
+		},
+		func(*clientBalancerEndpoint) bool {
+			return true
+		},
+	} {
+		switch b.Policy {
+		case "least":
+			endpoint = pool.nextLeast(check)
+		case "hash":
+			endpoint = pool.nextHash(b.GetHashFunc(req), check)
+		default:
+			endpoint = pool.nextRoundRobin(check)
+		}
+		if endpoint != nil {
+			break
+		}
+	}
+	endpoint.Inflight++
+	return endpoint
+}
+
+// The Update method rebuilds the endpoints and keeps the state of the
+// existing endpoints.
+func (pool *clientBalancerPool) Update(addrs []string, ring bool) {
+	endpoints := make([]*clientBalancerEndpoint, len(addrs))
+	for i, addr := range addrs {
+		endpoints[i] = &clientBalancerEndpoint{Addr: addr}
+		for _, e := range pool.Endpoints {
+			if e.Addr == addr {
+				endpoints[i] = e
+				break
+			}
+		}
+	}
+	pool.Addrs = append([]string{}, addrs...)
+	pool.Endpoints = endpoints
+	pool.Ring = pool.Ring[:0]
+	if ring {
+		for _, e := range endpoints {
+			for i := 0; i < DefaultClientBalancerReplicas; i++ {
+				pool.Ring = append(pool.Ring, clientBalancerNode{
+					Hash:     clientBalancerHash(e.Addr + "#" + strconv.Itoa(i)),
+					Endpoint: e,
+				})
+			}
+		}
+		sort.Slice(pool.Ring, func(i, j int) bool {
+			return pool.Ring[i].Hash < pool.Ring[j].Hash
+		})
+	}
+}
+
+func (pool *clientBalancerPool) nextRoundRobin(check func(*clientBalancerEndpoint) bool,
+) *clientBalancerEndpoint {
+	for i := range pool.Endpoints {
+		e := pool.Endpoints[(pool.Index+i)%len(pool.Endpoints)]
+		if check(e) {
+			pool.Index = (pool.Index + i + 1) % len(pool.Endpoints)
+			return e
+		}
+	}
+	return nil
+}
+
+func (pool *clientBalancerPool) nextLeast(check func(*clientBalancerEndpoint) bool,
+) *clientBalancerEndpoint {
+	var endpoint *clientBalancerEndpoint
+	for i := range pool.Endpoints {
+		e := pool.Endpoints[(pool.Index+i)%len(pool.Endpoints)]
+		if check(e) && (endpoint == nil || e.Inflight < endpoint.Inflight) {
+			endpoint = e
+		}
+	}
+	pool.Index = (pool.Index + 1) % len(pool.Endpoints)
+	return endpoint
+}
+
+func (pool *clientBalancerPool) nextHash(key string, check func(*clientBalancerEndpoint) bool,
+) *clientBalancerEndpoint {
+	hash := clientBalancerHash(key)
+	pos := sort.Search(len(pool.Ring), func(i int) bool {
+		return pool.Ring[i].Hash >= hash
+	})
+	for i := range pool.Ring {
+		e := pool.Ring[(pos+i)%len(pool.Ring)].Endpoint
+		if check(e) {
+			return e
+		}
+	}
+	return nil
+}
+
+// The Release method records the result of the request,
+// and ejects the endpoint after consecutive failures.
+func (pool *clientBalancerPool) Release(e *clientBalancerEndpoint, succeed bool) {
+	pool.Lock()
+	if succeed {
+		e.Failures = 0
+	} else {
+		e.Failures++
+		if e.Failures >= DefaultClientBalancerFailures {
+			e.Failures = 0
+			e.EjectTime = time.Now().Add(DefaultClientBalancerEjectTime)
+		}
+	}
+	pool.Unlock()
+}
+
+// The Done method decrements the endpoint inflight.
+func (pool *clientBalancerPool) Done(e *clientBalancerEndpoint) {
+	pool.Lock()
+	e.Inflight--
+	pool.Unlock()
+}
+
+func clientBalancerHash(key string) uint32 {
+	h := fnv.New32a()
+	_, _ = io.WriteString(h, key)
+	return h.Sum32()
+}
+
 type clientHookLogger struct {
 	next   http.RoundTripper
 	level  LoggerLevel
@@ -0,0 +1,309 @@
+package eudore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ClientOAuth2Config defines the OAuth2 client configuration used by
+// [NewClientHookOAuth2].
+type ClientOAuth2Config struct {
+	TokenURL     string   `alias:"tokenurl" json:"tokenurl" yaml:"tokenurl"`
+	ClientID     string   `alias:"clientid" json:"clientid" yaml:"clientid"`
+	ClientSecret string   `alias:"clientsecret" json:"clientsecret" yaml:"clientsecret"`
+	Scopes       []string `alias:"scopes" json:"scopes" yaml:"scopes"`
+	// If RefreshToken is not empty, use the refresh_token grant,
+	// otherwise use the client_credentials grant.
+	// The refresh token rejected by the token endpoint is cleared,
+	// and use the client_credentials grant if ClientSecret is not empty.
+	RefreshToken string `alias:"refreshtoken" json:"refreshtoken" yaml:"refreshtoken"`
+	// Params defines the additional parameters of the token request,
+	// such as audience.
+	Params url.Values `alias:"params" json:"params" yaml:"params"`
+	// If AuthParams is true, send client_id and client_secret in the
+	// request body, otherwise use Basic authentication.
+	AuthParams bool `alias:"authparams" json:"authparams" yaml:"authparams"`
+	// RefreshEarly defines how long before expiry the token is refreshed in
+	// the background, the default value is [DefaultClientOAuth2RefreshEarly].
+	RefreshEarly time.Duration `alias:"refreshearly" json:"refreshearly" yaml:"refreshearly"`
+	// Transport defines the [http.RoundTripper] used by the token request,
+	// the default value is the next RoundTripper of the hook.
+	Transport http.RoundTripper `alias:"transport" json:"-" yaml:"-"`
+}
+
+// ClientOAuth2Token defines the token returned by the token endpoint.
+//
+// RFC 6749: The OAuth 2.0 Authorization Framework section 5.1.
+type ClientOAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresIn    int64     `json:"expires_in,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// ClientOAuth2Error defines the error returned by the token endpoint.
+//
+// RFC 6749: The OAuth 2.0 Authorization Framework section 5.2.
+type ClientOAuth2Error struct {
+	Status           int    `json:"-"`
+	ErrorCode        string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+	ErrorURI         string `json:"error_uri,omitempty"`
+}
+
+type clientHookOAuth2 struct {
+	next   http.RoundTripper
+	oauth2 *clientOAuth2
+}
+
+type clientOAuth2 struct {
+	sync.Mutex
+	Config  ClientOAuth2Config
+	Token   *ClientOAuth2Token
+	Error   error
+	waiting chan struct{}
+}
+
+// NewClientHookOAuth2 function creates [ClientHook] to implement OAuth2
+// client_credentials and refresh_token grant.
+//
+// The token is cached until expiry and shared by all [Client.NewClient]
+// copies, concurrent requests only trigger one token request.
+// When the token is about to expire, use the current token and refresh it in
+// the background.
+// When [StatusUnauthorized], refresh the token and retry the request once.
+func NewClientHookOAuth2(config *ClientOAuth2Config) ClientHook {
+	o := &clientOAuth2{Config: *config}
+	if o.Config.RefreshEarly <= 0 {
+		o.Config.RefreshEarly = DefaultClientOAuth2RefreshEarly
+	}
+	return &clientHookOAuth2{oauth2: o}
+}
+
+func (*clientHookOAuth2) Name() string { return "oauth2" }
+func (hook *clientHookOAuth2) String() string {
+	return fmt.Sprintf("oauth2 url=%s clientid=%s scopes=%v",
+		hook.oauth2.Config.TokenURL, hook.oauth2.Config.ClientID,
+		hook.oauth2.Config.Scopes,
+	)
+}
+
+func (hook *clientHookOAuth2) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookOAuth2{
+		next:   rt,
+		oauth2: hook.oauth2,
+	}
+}
+
+func (hook *clientHookOAuth2) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := hook.oauth2.GetToken(req.Context(), hook.next, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(HeaderAuthorization, token.Authorization())
+	resp, err := hook.next.RoundTrip(req)
+	if resp == nil || resp.StatusCode != StatusUnauthorized {
+		return resp, err
+	}
+
+	// refresh the rejected token and try again
+	err = resetBody(req)
+	if err != nil {
+		return resp, err
+	}
+	token, err = hook.oauth2.GetToken(req.Context(), hook.next, token)
+	if err != nil {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	req.Header.Set(HeaderAuthorization, token.Authorization())
+	return hook.next.RoundTrip(req)
+}
+
+// The GetToken method returns the cached token, if the token is expired or
+// equal to the rejected token, wait for the new token.
+func (o *clientOAuth2) GetToken(ctx context.Context, rt http.RoundTripper,
+	rejected *ClientOAuth2Token,
+) (*ClientOAuth2Token, error) {
+	now := time.Now()
+	o.Lock()
+	token := o.Token
+	valid := token != nil && token != rejected && !token.Expired(now)
+	if valid && !token.Expired(now.Add(o.Config.RefreshEarly)) {
+		o.Unlock()
+		return token, nil
+	}
+	if o.waiting == nil {
+		o.waiting = make(chan struct{})
+		go o.refresh(contextValues{ctx}, rt)
+	}
+	waiting := o.waiting
+	o.Unlock()
+	if valid {
+		return token, nil
+	}
+
+	select {
+	case <-waiting:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	o.Lock()
+	defer o.Unlock()
+	if o.Error != nil {
+		return nil, o.Error
+	}
+	return o.Token, nil
+}
+
+// The refresh method requests a new token, it is not canceled by the request
+// context because the result is shared.
+func (o *clientOAuth2) refresh(ctx context.Context, rt http.RoundTripper) {
+	o.Lock()
+	refresh := o.Config.RefreshToken
+	if o.Token != nil && o.Token.RefreshToken != "" {
+		refresh = o.Token.RefreshToken
+	}
+	o.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	token, err := o.request(ctx, rt, refresh)
+	var e *ClientOAuth2Error
+	if refresh != "" && errors.As(err, &e) {
+		o.Lock()
+		o.Config.RefreshToken = ""
+		if o.Token != nil {
+			o.Token.RefreshToken = ""
+		}
+		o.Unlock()
+		refresh = ""
+		if o.Config.ClientSecret != "" {
+			token, err = o.request(ctx, rt, "")
+		}
+	}
+	cancel()
+
+	o.Lock()
+	if err == nil {
+		if token.RefreshToken == "" {
+			token.RefreshToken = refresh
+		}
+		o.Token = token
+	}
+	o.Error = err
+	close(o.waiting)
+	o.waiting = nil
+	o.Unlock()
+}
+
+func (o *clientOAuth2) request(ctx context.Context, rt http.RoundTripper,
+	refresh string,
+) (*ClientOAuth2Token, error) {
+	form := url.Values{}
+	for key, vals := range o.Config.Params {
+		form[key] = append([]string(nil), vals...)
+	}
+	if refresh != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", refresh)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+	if len(o.Config.Scopes) > 0 {
+		form.Set("scope", strings.Join(o.Config.Scopes, " "))
+	}
+	if o.Config.AuthParams {
+		form.Set("client_id", o.Config.ClientID)
+		if o.Config.ClientSecret != "" {
+			form.Set("client_secret", o.Config.ClientSecret)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, MethodPost, o.Config.TokenURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(HeaderAccept, MimeApplicationJSON)
+	req.Header.Set(HeaderContentType, MimeApplicationForm)
+	if !o.Config.AuthParams {
+		req.SetBasicAuth(url.QueryEscape(o.Config.ClientID),
+			url.QueryEscape(o.Config.ClientSecret),
+		)
+	}
+	if o.Config.Transport != nil {
+		rt = o.Config.Transport
+	}
+
+	now := time.Now()
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != StatusOK {
+		e := &ClientOAuth2Error{Status: resp.StatusCode}
+		_ = json.Unmarshal(body, e)
+		return nil, e
+	}
+
+	token := &ClientOAuth2Token{}
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf(ErrClientOAuth2TokenInvalid, o.Config.TokenURL)
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// The Expired method returns whether the token is expired at the time,
+// the token without expiry never expires.
+func (token *ClientOAuth2Token) Expired(now time.Time) bool {
+	return !token.Expiry.IsZero() && !now.Before(token.Expiry)
+}
+
+// The Authorization method returns the [HeaderAuthorization] value.
+func (token *ClientOAuth2Token) Authorization() string {
+	if token.TokenType == "" || strings.EqualFold(token.TokenType, "bearer") {
+		return "Bearer " + token.AccessToken
+	}
+	return token.TokenType + " " + token.AccessToken
+}
+
+func (e *ClientOAuth2Error) Error() string {
+	msg := fmt.Sprintf(ErrClientOAuth2TokenStatus, e.Status, e.ErrorCode)
+	if e.ErrorDescription != "" {
+		msg += ": " + e.ErrorDescription
+	}
+	return msg
+}
+
+// contextValues defines the [context.Context] that only keeps the values,
+// it is never canceled.
+type contextValues struct {
+	context.Context
+}
+
+func (contextValues) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (contextValues) Done() <-chan struct{}       { return nil }
+func (contextValues) Err() error                  { return nil }
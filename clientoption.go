@@ -51,6 +51,7 @@ type ClientTrace struct {
 	GotConnLocalAddr      net.Addr             `json:"gotConnLocalAddr" yaml:"gotConnLocalAddr"`
 	GotConnRemoteAddr     net.Addr             `json:"gotConnRemoteAddr" yaml:"gotConnRemoteAddr"`
 	GotFirstResponseByte  time.Time            `json:"gotFirstResponseByte" yaml:"gotFirstResponseByte"`
+	WroteRequest          time.Time            `json:"wroteRequest" yaml:"wroteRequest"`
 	TLSHandshakeStart     time.Time            `json:"tlsHandshakeStart" yaml:"tlsHandshakeStart,omitempty"`
 	TLSHandshakeDone      time.Time            `json:"tlsHandshakeDone" yaml:"tlsHandshakeDone,omitempty"`
 	TLSHandshakeDuration  time.Duration        `json:"tlsHandshakeDuration,omitempty" yaml:"tlsHandshakeDuration,omitempty"`
@@ -315,6 +316,48 @@ func NewClientOptionUserAgent(ua string) http.Header {
 	return NewClientHeader(HeaderUserAgent, ua)
 }
 
+// NewClientOptionProgress function creates [ClientOption] to report the
+// upload progress of the request body,
+// total is -1 if the length is unknown.
+func NewClientOptionProgress(fn func(written, total int64)) func(*http.Request) {
+	return func(req *http.Request) {
+		if req.Body == nil || req.Body == http.NoBody {
+			return
+		}
+		total := req.ContentLength
+		if total == 0 {
+			total = -1
+		}
+		req.Body = &clientProgressBody{ReadCloser: req.Body, total: total, fn: fn}
+		if req.GetBody != nil {
+			getbody := req.GetBody
+			req.GetBody = func() (io.ReadCloser, error) {
+				body, err := getbody()
+				if err != nil {
+					return nil, err
+				}
+				return &clientProgressBody{ReadCloser: body, total: total, fn: fn}, nil
+			}
+		}
+	}
+}
+
+type clientProgressBody struct {
+	io.ReadCloser
+	written int64
+	total   int64
+	fn      func(int64, int64)
+}
+
+func (body *clientProgressBody) Read(p []byte) (int, error) {
+	n, err := body.ReadCloser.Read(p)
+	if n > 0 {
+		body.written += int64(n)
+		body.fn(body.written, body.total)
+	}
+	return n, err
+}
+
 // NewClientTraceWithContext function initializes and binds [ClientTrace] to
 // [context.Context].
 //
@@ -368,6 +411,7 @@ func NewClientTraceWithContext(ctx context.Context, trace *ClientTrace,
 			trace.GotConnRemoteAddr = info.Conn.RemoteAddr()
 		},
 		GotFirstResponseByte: func() { trace.GotFirstResponseByte = time.Now() },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { trace.WroteRequest = time.Now() },
 		TLSHandshakeStart:    func() { trace.TLSHandshakeStart = time.Now() },
 		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
 			trace.Lock()
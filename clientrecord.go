@@ -0,0 +1,371 @@
+package eudore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ClientRecord defines a request/response pair saved by
+// [NewClientHookRecorder].
+type ClientRecord struct {
+	Time     time.Time            `json:"time" yaml:"time"`
+	Duration time.Duration        `json:"duration" yaml:"duration"`
+	Request  ClientRecordRequest  `json:"request" yaml:"request"`
+	Response ClientRecordResponse `json:"response" yaml:"response"`
+	used     bool
+}
+
+// ClientRecordRequest defines the recorded request.
+type ClientRecordRequest struct {
+	Method   string      `json:"method" yaml:"method"`
+	URL      string      `json:"url" yaml:"url"`
+	Header   http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body     string      `json:"body,omitempty" yaml:"body,omitempty"`
+	Encoding string      `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+}
+
+// ClientRecordResponse defines the recorded response.
+type ClientRecordResponse struct {
+	Status   int         `json:"status" yaml:"status"`
+	Header   http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body     string      `json:"body,omitempty" yaml:"body,omitempty"`
+	Encoding string      `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+}
+
+// ClientRecordMatcher defines the function that matches the request and the
+// record in replay mode, the request header has been redacted.
+type ClientRecordMatcher func(req *http.Request, body []byte, record *ClientRecord) bool
+
+// Define the mode of [NewClientHookRecorder].
+const (
+	ClientRecordModeAuto   = "auto"
+	ClientRecordModeRecord = "record"
+	ClientRecordModeReplay = "replay"
+)
+
+type clientHookRecorder struct {
+	next     http.RoundTripper
+	recorder *clientRecorder
+}
+
+type clientRecorder struct {
+	sync.Mutex
+	Path     string
+	Mode     string
+	Matchers []ClientRecordMatcher
+	Records  []*ClientRecord
+	Error    error
+	once     sync.Once
+}
+
+// NewClientHookRecorder function creates [ClientHook] to implement request
+// recording and replay, the records are saved in the cassette file path,
+// if the path suffix is '.har', use HAR 1.2 format, otherwise use JSON.
+//
+// Available mode:
+//
+//	record: send the request and save the record.
+//	replay: return the matched record, if not matched, return an error.
+//	auto: replay if matched, otherwise send the request and save the record.
+//
+// Use the method and URL to match the request by default, and the matchers
+// are appended, such as [NewClientRecordMatcherHeader]
+// [NewClientRecordMatcherBody].
+// The matched records are used in order, and the last one is reused.
+//
+// The header in [DefaultClientRecordRedactHeaders] is redacted.
+//
+// The cassette file save error does not fail the request,
+// it is output to the request [Logger].
+func NewClientHookRecorder(path, mode string, matchers ...ClientRecordMatcher) ClientHook {
+	switch mode {
+	case ClientRecordModeRecord, ClientRecordModeReplay:
+	default:
+		mode = ClientRecordModeAuto
+	}
+	return &clientHookRecorder{recorder: &clientRecorder{
+		Path:     path,
+		Mode:     mode,
+		Matchers: append([]ClientRecordMatcher{clientRecordMatchURL}, matchers...),
+	}}
+}
+
+// NewClientRecordMatcherHeader function creates [ClientRecordMatcher] to
+// match the request header values.
+func NewClientRecordMatcherHeader(names ...string) ClientRecordMatcher {
+	return func(req *http.Request, _ []byte, record *ClientRecord) bool {
+		for _, name := range names {
+			if strings.Join(req.Header.Values(name), ", ") !=
+				strings.Join(record.Request.Header.Values(name), ", ") {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// NewClientRecordMatcherBody function creates [ClientRecordMatcher] to
+// match the request body.
+func NewClientRecordMatcherBody() ClientRecordMatcher {
+	return func(_ *http.Request, body []byte, record *ClientRecord) bool {
+		data, err := decodeClientRecordBody(record.Request.Body, record.Request.Encoding)
+		return err == nil && bytes.Equal(body, data)
+	}
+}
+
+func clientRecordMatchURL(req *http.Request, _ []byte, record *ClientRecord) bool {
+	return req.Method == record.Request.Method &&
+		req.URL.String() == record.Request.URL
+}
+
+func (*clientHookRecorder) Name() string { return "recorder" }
+func (hook *clientHookRecorder) String() string {
+	return "recorder mode=" + hook.recorder.Mode + " path=" + hook.recorder.Path
+}
+
+func (hook *clientHookRecorder) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookRecorder{
+		next:     rt,
+		recorder: hook.recorder,
+	}
+}
+
+func (hook *clientHookRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := hook.recorder
+	r.once.Do(r.load)
+	if r.Error != nil {
+		return nil, r.Error
+	}
+
+	body, err := readClientRecordBody(req)
+	if err != nil {
+		return nil, err
+	}
+	header := redactClientRecordHeader(req.Header)
+	if r.Mode != ClientRecordModeRecord {
+		record := r.Match(req, header, body)
+		if record != nil {
+			return record.NewResponse(req)
+		}
+		if r.Mode == ClientRecordModeReplay {
+			return nil, fmt.Errorf(ErrClientRecordNotFound, req.Method, req.URL.String())
+		}
+	}
+
+	now := time.Now()
+	resp, err := hook.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	record := &ClientRecord{
+		Time:     now,
+		Duration: time.Since(now),
+		Request: ClientRecordRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: header,
+		},
+		Response: ClientRecordResponse{
+			Status: resp.StatusCode,
+			Header: redactClientRecordHeader(resp.Header),
+		},
+		used: true,
+	}
+	record.Request.Body, record.Request.Encoding = encodeClientRecordBody(body)
+	record.Response.Body, record.Response.Encoding = encodeClientRecordBody(data)
+	// the response is valid, the cassette save error is only logged.
+	err = r.Save(record)
+	if err != nil {
+		NewLoggerWithContext(req.Context()).Errorf(
+			"client recorder save cassette %s error: %v", r.Path, err,
+		)
+	}
+	return resp, nil
+}
+
+// The load method reads the cassette file, the file does not exist in
+// record and auto mode is ignored.
+func (r *clientRecorder) load() {
+	data, err := os.ReadFile(r.Path)
+	if err != nil {
+		if !os.IsNotExist(err) || r.Mode == ClientRecordModeReplay {
+			r.Error = err
+		}
+		return
+	}
+	if r.Mode == ClientRecordModeRecord {
+		return
+	}
+
+	if strings.HasSuffix(r.Path, ".har") {
+		har := &ClientHAR{}
+		r.Error = json.Unmarshal(data, har)
+		r.Records = har.Records()
+	} else {
+		r.Error = json.Unmarshal(data, &r.Records)
+	}
+}
+
+// The Match method returns the first unused matched record,
+// or the last matched record.
+func (r *clientRecorder) Match(req *http.Request, header http.Header, body []byte,
+) *ClientRecord {
+	req = req.Clone(req.Context())
+	req.Header = header
+	r.Lock()
+	defer r.Unlock()
+	var last *ClientRecord
+	for _, record := range r.Records {
+		if !r.match(req, body, record) {
+			continue
+		}
+		if !record.used {
+			record.used = true
+			return record
+		}
+		last = record
+	}
+	return last
+}
+
+func (r *clientRecorder) match(req *http.Request, body []byte, record *ClientRecord) bool {
+	for _, fn := range r.Matchers {
+		if !fn(req, body, record) {
+			return false
+		}
+	}
+	return true
+}
+
+// The Save method appends the record and writes the cassette file.
+func (r *clientRecorder) Save(record *ClientRecord) error {
+	r.Lock()
+	defer r.Unlock()
+	r.Records = append(r.Records, record)
+
+	var data []byte
+	var err error
+	if strings.HasSuffix(r.Path, ".har") {
+		data, err = json.MarshalIndent(NewClientHARWithRecords(r.Records), "", "\t")
+	} else {
+		data, err = json.MarshalIndent(r.Records, "", "\t")
+	}
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(r.Path, data)
+}
+
+// The NewResponse method creates a response using the record.
+func (record *ClientRecord) NewResponse(req *http.Request) (*http.Response, error) {
+	body, err := decodeClientRecordBody(record.Response.Body, record.Response.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	header := record.Response.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", record.Response.Status, http.StatusText(record.Response.Status)),
+		StatusCode:    record.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func readClientRecordBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
+
+func redactClientRecordHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range DefaultClientRecordRedactHeaders {
+		vals := h.Values(name)
+		for i := range vals {
+			vals[i] = DefaultClientRecordRedactValue
+		}
+	}
+	return h
+}
+
+// The encodeClientRecordBody function uses base64 encoding if body is not
+// valid utf8.
+func encodeClientRecordBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+func decodeClientRecordBody(body, encoding string) ([]byte, error) {
+	if encoding == "base64" {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
+
+func getClientRecordBodySize(body, encoding string) int64 {
+	if encoding == "base64" {
+		return int64(base64.StdEncoding.DecodedLen(len(body)))
+	}
+	return int64(len(body))
+}
+
+// The writeFileAtomic function writes a temporary file and then renames it.
+func writeFileAtomic(name string, data []byte) error {
+	dir := filepath.Dir(name)
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if err1 := file.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), name)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return err
+}
@@ -0,0 +1,505 @@
+package eudore
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ClientSigner defines the function that signs the request, the body is the
+// request body read by [NewClientHookSigner].
+type ClientSigner func(req *http.Request, body []byte, now time.Time) error
+
+type clientHookSigner struct {
+	next   http.RoundTripper
+	signer ClientSigner
+}
+
+// NewClientHookSigner function creates [ClientHook] to sign the request,
+// such as [NewClientSignerHMAC] [NewClientSignerSigV4]
+// [NewClientSignerHTTPMessage].
+//
+// The request body is read to calculate the signature and then reset by
+// [http.Request.GetBody].
+//
+// Each request passing through the hook is signed, use [NewClientCustom] to
+// add the hook before [NewClientHookRedirect] and [NewClientHookRetry] to
+// re-sign the redirected and retried requests:
+//
+//	NewClientCustom(NewClientHookSigner(signer), NewClientHookTimeout(t), NewClientHookRedirect(nil))
+func NewClientHookSigner(signer ClientSigner) ClientHook {
+	return &clientHookSigner{signer: signer}
+}
+
+func (*clientHookSigner) Name() string { return "signer" }
+func (hook *clientHookSigner) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookSigner{
+		next:   rt,
+		signer: hook.signer,
+	}
+}
+
+func (hook *clientHookSigner) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := getClientSignerBody(req)
+	if err != nil {
+		return nil, err
+	}
+	err = hook.signer(req, body, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return hook.next.RoundTrip(req)
+}
+
+func getClientSignerBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		return readClientRecordBody(req)
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return body, resetBody(req)
+}
+
+// NewClientSignerHMAC function creates [ClientSigner] to implement
+// HMAC-SHA256 header signing, the headers default to
+// "(request-target) host date content-digest".
+//
+// Set [HeaderDate] and [HeaderContentDigest], and then set
+// [HeaderAuthorization]:
+//
+//	Signature keyId="kid",algorithm="hmac-sha256",headers="(request-target) host date",signature="base64"
+func NewClientSignerHMAC(keyid string, secret []byte, headers ...string) ClientSigner {
+	return func(req *http.Request, body []byte, now time.Time) error {
+		req.Header.Set(HeaderDate, now.UTC().Format(http.TimeFormat))
+		setClientContentDigest(req, body)
+		names := append([]string(nil), headers...)
+		if len(names) == 0 {
+			names = []string{"(request-target)", "host", "date"}
+			if req.Header.Get(HeaderContentDigest) != "" {
+				names = append(names, "content-digest")
+			}
+		}
+
+		lines := make([]string, len(names))
+		for i, name := range names {
+			name = strings.ToLower(name)
+			var val string
+			switch name {
+			case "(request-target)":
+				val = strings.ToLower(req.Method) + " " + req.URL.RequestURI()
+			case "host":
+				val = getClientSignerAuthority(req)
+			default:
+				vals := req.Header.Values(name)
+				if vals == nil {
+					return fmt.Errorf(ErrHTTPSignatureComponentMissing, name)
+				}
+				val = strings.Join(trimValues(vals), ", ")
+			}
+			names[i] = name
+			lines[i] = name + ": " + val
+		}
+
+		h := hmac.New(sha256.New, secret)
+		h.Write([]byte(strings.Join(lines, "\n")))
+		req.Header.Set(HeaderAuthorization, fmt.Sprintf(
+			`Signature keyId="%s",algorithm="hmac-sha256",headers="%s",signature="%s"`,
+			keyid, strings.Join(names, " "),
+			base64.StdEncoding.EncodeToString(h.Sum(nil)),
+		))
+		return nil
+	}
+}
+
+// NewClientSignerSigV4 function creates [ClientSigner] to implement
+// AWS Signature Version 4.
+//
+// Sign the host, content-type and x-amz-* headers,
+// the x-amz-security-token header is signed if it has been set.
+// If the service is s3 or the x-amz-content-sha256 header has been set,
+// such as UNSIGNED-PAYLOAD, the header is used as the payload hash.
+func NewClientSignerSigV4(accessKey, secretKey, region, service string) ClientSigner {
+	return func(req *http.Request, body []byte, now time.Time) error {
+		now = now.UTC()
+		date := now.Format("20060102")
+		req.Header.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+		payload := req.Header.Get("X-Amz-Content-Sha256")
+		if payload == "" {
+			sum := sha256.Sum256(body)
+			payload = hex.EncodeToString(sum[:])
+			if service == "s3" {
+				req.Header.Set("X-Amz-Content-Sha256", payload)
+			}
+		}
+
+		// canonical headers
+		headers := map[string]string{"host": getClientSignerAuthority(req)}
+		for key, vals := range req.Header {
+			key = strings.ToLower(key)
+			if key == "content-type" || strings.HasPrefix(key, "x-amz-") {
+				headers[key] = strings.Join(trimValues(vals), ",")
+			}
+		}
+		names := make([]string, 0, len(headers))
+		for key := range headers {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+		canonical := &strings.Builder{}
+		for _, key := range names {
+			canonical.WriteString(key + ":" + headers[key] + "\n")
+		}
+		signed := strings.Join(names, ";")
+
+		path := req.URL.EscapedPath()
+		if service == "s3" {
+			path = req.URL.Path
+		}
+		request := strings.Join([]string{
+			req.Method,
+			escapeSigV4(GetAnyDefault(path, "/"), false),
+			getSigV4Query(req.URL.Query()),
+			canonical.String(),
+			signed,
+			payload,
+		}, "\n")
+
+		scope := date + "/" + region + "/" + service + "/aws4_request"
+		sum := sha256.Sum256([]byte(request))
+		str := "AWS4-HMAC-SHA256\n" + req.Header.Get("X-Amz-Date") + "\n" +
+			scope + "\n" + hex.EncodeToString(sum[:])
+
+		key := []byte("AWS4" + secretKey)
+		for _, data := range [...]string{date, region, service, "aws4_request", str} {
+			h := hmac.New(sha256.New, key)
+			h.Write([]byte(data))
+			key = h.Sum(nil)
+		}
+		req.Header.Set(HeaderAuthorization, fmt.Sprintf(
+			"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%x",
+			accessKey, scope, signed, key,
+		))
+		return nil
+	}
+}
+
+func getSigV4Query(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var strs []string
+	for _, key := range keys {
+		vals := append([]string(nil), query[key]...)
+		sort.Strings(vals)
+		for _, val := range vals {
+			strs = append(strs, escapeSigV4(key, true)+"="+escapeSigV4(val, true))
+		}
+	}
+	return strings.Join(strs, "&")
+}
+
+// The escapeSigV4 function uses the AWS URI encoding, only the unreserved
+// characters are not encoded.
+func escapeSigV4(s string, slash bool) string {
+	b := &strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !slash) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// NewClientSignerHTTPMessage function creates [ClientSigner] to implement
+// HTTP Message Signatures, set [HeaderSignatureInput] and [HeaderSignature]
+// with the label sig1.
+//
+// The algorithm is determined by the key type:
+//
+//	[]byte: hmac-sha256
+//	ed25519.PrivateKey: ed25519
+//	*ecdsa.PrivateKey: ecdsa-p256-sha256 ecdsa-p384-sha384
+//	*rsa.PrivateKey: rsa-pss-sha512
+//
+// The components default to "@method" "@target-uri" and "content-digest"
+// when the request has a body, refer [GetHTTPSignatureBase].
+//
+// RFC 9421: HTTP Message Signatures.
+func NewClientSignerHTTPMessage(keyid string, key any, components ...string) ClientSigner {
+	return func(req *http.Request, body []byte, now time.Time) error {
+		alg, sign := newHTTPSignatureSigner(key)
+		if sign == nil {
+			return fmt.Errorf(ErrClientSignerInvalidKey, key)
+		}
+		setClientContentDigest(req, body)
+		names := components
+		if len(names) == 0 {
+			names = []string{"@method", "@target-uri"}
+			if req.Header.Get(HeaderContentDigest) != "" {
+				names = append(names, "content-digest")
+			}
+		}
+
+		items := make([]string, len(names))
+		for i, name := range names {
+			pos := strings.IndexByte(name+";", ';')
+			items[i] = strconv.Quote(strings.ToLower(name[:pos])) + name[pos:]
+		}
+		input := fmt.Sprintf(`(%s);created=%d;keyid=%s;alg="%s"`,
+			strings.Join(items, " "), now.Unix(), strconv.Quote(keyid), alg,
+		)
+		base, err := GetHTTPSignatureBase(req, input)
+		if err != nil {
+			return err
+		}
+		sig, err := sign(base)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(HeaderSignatureInput, "sig1="+input)
+		req.Header.Set(HeaderSignature, "sig1=:"+base64.StdEncoding.EncodeToString(sig)+":")
+		return nil
+	}
+}
+
+func newHTTPSignatureSigner(key any) (string, func([]byte) ([]byte, error)) {
+	switch key := key.(type) {
+	case []byte:
+		return "hmac-sha256", func(data []byte) ([]byte, error) {
+			h := hmac.New(sha256.New, key)
+			h.Write(data)
+			return h.Sum(nil), nil
+		}
+	case ed25519.PrivateKey:
+		return "ed25519", func(data []byte) ([]byte, error) {
+			return ed25519.Sign(key, data), nil
+		}
+	case *rsa.PrivateKey:
+		return "rsa-pss-sha512", func(data []byte) ([]byte, error) {
+			sum := sha512.Sum512(data)
+			return rsa.SignPSS(rand.Reader, key, crypto.SHA512, sum[:],
+				&rsa.PSSOptions{SaltLength: 64},
+			)
+		}
+	case *ecdsa.PrivateKey:
+		var h func() hash.Hash
+		var alg string
+		switch key.Curve.Params().BitSize {
+		case 256:
+			alg, h = "ecdsa-p256-sha256", sha256.New
+		case 384:
+			alg, h = "ecdsa-p384-sha384", sha512.New384
+		default:
+			return "", nil
+		}
+		return alg, func(data []byte) ([]byte, error) {
+			hh := h()
+			hh.Write(data)
+			r, s, err := ecdsa.Sign(rand.Reader, key, hh.Sum(nil))
+			if err != nil {
+				return nil, err
+			}
+			size := (key.Curve.Params().BitSize + 7) / 8
+			sig := make([]byte, size*2)
+			r.FillBytes(sig[:size])
+			s.FillBytes(sig[size:])
+			return sig, nil
+		}
+	}
+	return "", nil
+}
+
+// GetHTTPSignatureBase function returns the signature base of the request,
+// the input is the member value of [HeaderSignatureInput], such as:
+//
+//	("@method" "@authority" "content-digest");created=1618884473;keyid="test"
+//
+// Available components:
+//
+//	@method @target-uri @authority @scheme @request-target @path @query
+//	@query-param;name="key", the key is percent-encoded
+//	lowercase header name
+//
+// RFC 9421: HTTP Message Signatures section 2.5.
+func GetHTTPSignatureBase(req *http.Request, input string) ([]byte, error) {
+	items, err := parseHTTPSignatureInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	base := &strings.Builder{}
+	for _, item := range items {
+		pos := strings.IndexByte(item[1:], '"') + 1
+		name, params := item[1:pos], item[pos+1:]
+		vals, err := getHTTPSignatureComponent(req, name, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, val := range vals {
+			base.WriteString(item + ": " + val + "\n")
+		}
+	}
+	base.WriteString(`"@signature-params": ` + input)
+	return []byte(base.String()), nil
+}
+
+// The parseHTTPSignatureInput function returns the items of the inner list.
+func parseHTTPSignatureInput(input string) ([]string, error) {
+	if !strings.HasPrefix(input, "(") {
+		return nil, fmt.Errorf(ErrHTTPSignatureInputInvalid, input)
+	}
+	var items []string
+	str := input[1:]
+	for {
+		str = strings.TrimLeft(str, " ")
+		switch {
+		case strings.HasPrefix(str, ")"):
+			return items, nil
+		case !strings.HasPrefix(str, `"`):
+			return nil, fmt.Errorf(ErrHTTPSignatureInputInvalid, input)
+		}
+		// item and parameters
+		quote := false
+		pos := 0
+		for ; pos < len(str); pos++ {
+			if str[pos] == '"' {
+				quote = !quote
+			} else if !quote && (str[pos] == ' ' || str[pos] == ')') {
+				break
+			}
+		}
+		if pos == len(str) || strings.IndexByte(str[1:pos], '"') == -1 {
+			return nil, fmt.Errorf(ErrHTTPSignatureInputInvalid, input)
+		}
+		items = append(items, str[:pos])
+		str = str[pos:]
+	}
+}
+
+func getHTTPSignatureComponent(req *http.Request, name, params string) ([]string, error) {
+	switch name {
+	case "@method":
+		return []string{req.Method}, nil
+	case "@target-uri":
+		return []string{getClientSignerScheme(req) + "://" +
+			getClientSignerAuthority(req) + req.URL.RequestURI()}, nil
+	case "@authority":
+		return []string{getClientSignerAuthority(req)}, nil
+	case "@scheme":
+		return []string{getClientSignerScheme(req)}, nil
+	case "@request-target":
+		return []string{req.URL.RequestURI()}, nil
+	case "@path":
+		return []string{GetAnyDefault(req.URL.EscapedPath(), "/")}, nil
+	case "@query":
+		return []string{"?" + req.URL.RawQuery}, nil
+	case "@query-param":
+		// the name parameter is the encoded name.
+		key, err := strconv.Unquote(strings.TrimPrefix(params, ";name="))
+		if err == nil {
+			key, err = url.PathUnescape(key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf(ErrHTTPSignatureInputInvalid, name+params)
+		}
+		vals := req.URL.Query()[key]
+		for i := range vals {
+			vals[i] = encodeHTTPSignatureQuery(vals[i])
+		}
+		if vals != nil {
+			return vals, nil
+		}
+	default:
+		vals := req.Header.Values(name)
+		if params == "" && vals != nil && !strings.HasPrefix(name, "@") {
+			return []string{strings.Join(trimValues(vals), ", ")}, nil
+		}
+	}
+	return nil, fmt.Errorf(ErrHTTPSignatureComponentMissing, name+params)
+}
+
+// The encodeHTTPSignatureQuery function percent-encodes the query parameter
+// using the application/x-www-form-urlencoded percent-encode set,
+// and space is encoded as '%20' instead of '+'.
+//
+// RFC 9421: HTTP Message Signatures section 2.2.8.
+func encodeHTTPSignatureQuery(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '*', c == '-', c == '.', c == '_':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		}
+	}
+	return b.String()
+}
+
+func getClientSignerAuthority(req *http.Request) string {
+	return strings.ToLower(GetAnyDefault(req.Host, req.URL.Host))
+}
+
+func getClientSignerScheme(req *http.Request) string {
+	switch {
+	case req.URL.Scheme != "":
+		return strings.ToLower(req.URL.Scheme)
+	case req.TLS != nil:
+		return "https"
+	default:
+		return "http"
+	}
+}
+
+// The setClientContentDigest function sets the sha-256 [HeaderContentDigest]
+// if it is not set.
+//
+// RFC 9530: Digest Fields.
+func setClientContentDigest(req *http.Request, body []byte) {
+	if len(body) > 0 && req.Header.Get(HeaderContentDigest) == "" {
+		sum := sha256.Sum256(body)
+		req.Header.Set(HeaderContentDigest,
+			"sha-256=:"+base64.StdEncoding.EncodeToString(sum[:])+":",
+		)
+	}
+}
+
+func trimValues(vals []string) []string {
+	strs := make([]string, len(vals))
+	for i, val := range vals {
+		strs[i] = strings.Join(strings.Fields(val), " ")
+	}
+	return strs
+}
@@ -0,0 +1,543 @@
+package eudore
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// codecMaxDepth defines the max nesting depth of encoding and decoding,
+// which prevents circular references and malicious data.
+const codecMaxDepth = 1000
+
+var storageCodecFields sync.Map
+
+// codecWriter defines the writer of self-describing data format,
+// used by MessagePack and CBOR.
+type codecWriter interface {
+	writeNil()
+	writeBool(bool)
+	writeInt(int64)
+	writeUint(uint64)
+	writeFloat(float64, int)
+	writeString(string)
+	writeBytes([]byte)
+	writeArray(int)
+	writeMap(int)
+	writeTime(time.Time)
+}
+
+// codecMap defines the decoded map that keeps the key order and supports
+// keys of any type.
+type codecMap []codecPair
+
+type codecPair struct {
+	Key   any
+	Value any
+}
+
+type codecField struct {
+	Index []int
+	Name  string
+	Omit  bool
+}
+
+type codecFieldKey struct {
+	Type reflect.Type
+	Tag  string
+}
+
+// The getCodecFields function returns the exported fields of the struct,
+// the field name uses the first tag that exists, and the anonymous struct
+// without name is expanded.
+func getCodecFields(t reflect.Type, tags []string) []codecField {
+	key := codecFieldKey{t, strings.Join(tags, ",")}
+	fields, ok := storageCodecFields.Load(key)
+	if ok {
+		return fields.([]codecField)
+	}
+
+	fields = parseCodecFields(t, tags, nil)
+	storageCodecFields.Store(key, fields)
+	return fields.([]codecField)
+}
+
+func parseCodecFields(iType reflect.Type, tags []string, index []int,
+) []codecField {
+	var fields []codecField
+	for i := 0; i < iType.NumField(); i++ {
+		t := iType.Field(i)
+		embed := t.Anonymous && t.Type.Kind() == reflect.Struct
+		if !t.IsExported() && !embed {
+			continue
+		}
+
+		var tag string
+		for _, name := range tags {
+			val, ok := t.Tag.Lookup(name)
+			if ok {
+				tag = val
+				break
+			}
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+
+		idx := append(index[:len(index):len(index)], i)
+		switch {
+		case name == "" && embed:
+			fields = append(fields, parseCodecFields(t.Type, tags, idx)...)
+		case t.IsExported():
+			fields = append(fields, codecField{
+				Index: idx,
+				Name:  GetAnyDefault(name, t.Name),
+				Omit:  strings.Contains(","+opts+",", ",omitempty,"),
+			})
+		}
+	}
+	return fields
+}
+
+// The encodeCodecValue function walks through v using reflect and writes it
+// to w, the struct is written as a map.
+//
+//nolint:cyclop,gocyclo
+func encodeCodecValue(w codecWriter, v reflect.Value, tags []string,
+	depth int,
+) error {
+	if depth > codecMaxDepth {
+		return fmt.Errorf(ErrCodecMaxDepth, codecMaxDepth)
+	}
+	if !v.IsValid() {
+		w.writeNil()
+		return nil
+	}
+
+	t := v.Type()
+	switch {
+	case !v.CanInterface():
+		// the value of unexported field is encoded by kind.
+	case t == typeTimeTime:
+		w.writeTime(v.Interface().(time.Time))
+		return nil
+	case v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface &&
+		t.Implements(typeTextMarshaler):
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return err
+		}
+		w.writeString(string(text))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		w.writeBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w.writeInt(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		w.writeUint(v.Uint())
+	case reflect.Float32:
+		w.writeFloat(v.Float(), 32)
+	case reflect.Float64:
+		w.writeFloat(v.Float(), 64)
+	case reflect.String:
+		w.writeString(v.String())
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			w.writeNil()
+			return nil
+		}
+		return encodeCodecValue(w, v.Elem(), tags, depth+1)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			w.writeNil()
+			return nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			if v.Kind() == reflect.Slice {
+				w.writeBytes(v.Bytes())
+			} else {
+				b := make([]byte, v.Len())
+				reflect.Copy(reflect.ValueOf(b), v)
+				w.writeBytes(b)
+			}
+			return nil
+		}
+		w.writeArray(v.Len())
+		for i := 0; i < v.Len(); i++ {
+			err := encodeCodecValue(w, v.Index(i), tags, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			w.writeNil()
+			return nil
+		}
+		keys := v.MapKeys()
+		if t.Key().Kind() == reflect.String {
+			sort.Slice(keys, func(i, j int) bool {
+				return keys[i].String() < keys[j].String()
+			})
+		}
+		w.writeMap(len(keys))
+		for _, key := range keys {
+			err := encodeCodecValue(w, key, tags, depth+1)
+			if err != nil {
+				return err
+			}
+			err = encodeCodecValue(w, v.MapIndex(key), tags, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		fields := getCodecFields(t, tags)
+		vals := make([]reflect.Value, len(fields))
+		size := 0
+		for i, field := range fields {
+			vals[i] = v.FieldByIndex(field.Index)
+			if !field.Omit || !isCodecEmpty(vals[i]) {
+				size++
+			}
+		}
+		w.writeMap(size)
+		for i, field := range fields {
+			if field.Omit && isCodecEmpty(vals[i]) {
+				continue
+			}
+			w.writeString(field.Name)
+			err := encodeCodecValue(w, vals[i], tags, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf(ErrCodecUnsupportedType, t)
+	}
+	return nil
+}
+
+func isCodecEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// The decodeCodecValue function sets the decoded data to v,
+// the map is matched to the struct field name, and the name is case
+// insensitive.
+//
+//nolint:cyclop,funlen,gocyclo
+func decodeCodecValue(v reflect.Value, data any, tags []string) error {
+	t := v.Type()
+	switch {
+	case v.Kind() == reflect.Ptr:
+		if data == nil {
+			v.Set(reflect.Zero(t))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		return decodeCodecValue(v.Elem(), data, tags)
+	case v.Kind() == reflect.Interface && t.NumMethod() == 0:
+		if data == nil {
+			v.Set(reflect.Zero(t))
+			return nil
+		}
+		val, err := getCodecAny(data)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(val))
+		return nil
+	case data == nil:
+		v.Set(reflect.Zero(t))
+		return nil
+	case t == typeTimeTime:
+		val, ok := getCodecTime(data)
+		if ok {
+			v.Set(reflect.ValueOf(val))
+			return nil
+		}
+	case reflect.PointerTo(t).Implements(typeTextUnmarshaler) && v.CanAddr():
+		switch val := data.(type) {
+		case string:
+			return v.Addr().Interface().(encoding.TextUnmarshaler).
+				UnmarshalText([]byte(val))
+		case []byte:
+			return v.Addr().Interface().(encoding.TextUnmarshaler).
+				UnmarshalText(val)
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		val, ok := data.(bool)
+		if ok {
+			v.SetBool(val)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch val := data.(type) {
+		case int64:
+			if !v.OverflowInt(val) {
+				v.SetInt(val)
+				return nil
+			}
+		case uint64:
+			if val <= math.MaxInt64 && !v.OverflowInt(int64(val)) {
+				v.SetInt(int64(val))
+				return nil
+			}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		switch val := data.(type) {
+		case int64:
+			if val >= 0 && !v.OverflowUint(uint64(val)) {
+				v.SetUint(uint64(val))
+				return nil
+			}
+		case uint64:
+			if !v.OverflowUint(val) {
+				v.SetUint(val)
+				return nil
+			}
+		}
+	case reflect.Float32, reflect.Float64:
+		switch val := data.(type) {
+		case float64:
+			v.SetFloat(val)
+			return nil
+		case int64:
+			v.SetFloat(float64(val))
+			return nil
+		case uint64:
+			v.SetFloat(float64(val))
+			return nil
+		}
+	case reflect.String:
+		switch val := data.(type) {
+		case string:
+			v.SetString(val)
+			return nil
+		case []byte:
+			v.SetString(string(val))
+			return nil
+		}
+	case reflect.Slice:
+		switch val := data.(type) {
+		case []byte:
+			if t.Elem().Kind() == reflect.Uint8 {
+				v.SetBytes(append([]byte{}, val...))
+				return nil
+			}
+		case string:
+			if t.Elem().Kind() == reflect.Uint8 {
+				v.SetBytes([]byte(val))
+				return nil
+			}
+		case []any:
+			slice := reflect.MakeSlice(t, len(val), len(val))
+			for i := range val {
+				err := decodeCodecValue(slice.Index(i), val[i], tags)
+				if err != nil {
+					return err
+				}
+			}
+			v.Set(slice)
+			return nil
+		}
+	case reflect.Array:
+		switch val := data.(type) {
+		case []byte:
+			if t.Elem().Kind() == reflect.Uint8 {
+				reflect.Copy(v, reflect.ValueOf(val))
+				return nil
+			}
+		case []any:
+			for i := 0; i < v.Len() && i < len(val); i++ {
+				err := decodeCodecValue(v.Index(i), val[i], tags)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case reflect.Map:
+		val, ok := data.(codecMap)
+		if ok {
+			if v.IsNil() {
+				v.Set(reflect.MakeMapWithSize(t, len(val)))
+			}
+			for _, pair := range val {
+				key := reflect.New(t.Key()).Elem()
+				err := decodeCodecValue(key, pair.Key, tags)
+				if err != nil {
+					return err
+				}
+				if key.Kind() == reflect.Interface && !key.IsNil() &&
+					!key.Elem().Type().Comparable() {
+					return fmt.Errorf(ErrCodecDecodeType, getCodecTypeName(pair.Key), t.Key())
+				}
+				elem := reflect.New(t.Elem()).Elem()
+				err = decodeCodecValue(elem, pair.Value, tags)
+				if err != nil {
+					return err
+				}
+				v.SetMapIndex(key, elem)
+			}
+			return nil
+		}
+	case reflect.Struct:
+		val, ok := data.(codecMap)
+		if ok {
+			return decodeCodecStruct(v, val, tags)
+		}
+	}
+	return fmt.Errorf(ErrCodecDecodeType, getCodecTypeName(data), t)
+}
+
+func decodeCodecStruct(v reflect.Value, data codecMap, tags []string) error {
+	fields := getCodecFields(v.Type(), tags)
+	for _, pair := range data {
+		var name string
+		switch key := pair.Key.(type) {
+		case string:
+			name = key
+		case []byte:
+			name = string(key)
+		default:
+			continue
+		}
+
+		index := -1
+		for i := range fields {
+			if fields[i].Name == name {
+				index = i
+				break
+			} else if index == -1 && strings.EqualFold(fields[i].Name, name) {
+				index = i
+			}
+		}
+		if index != -1 {
+			err := decodeCodecValue(v.FieldByIndex(fields[index].Index),
+				pair.Value, tags,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// The getCodecAny function converts [codecMap] to map[string]any,
+// if any key is not a string, converts to map[any]any,
+// the key that is not comparable returns an error.
+func getCodecAny(data any) (any, error) {
+	var err error
+	switch val := data.(type) {
+	case []any:
+		for i := range val {
+			val[i], err = getCodecAny(val[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+	case codecMap:
+		strs := make(map[string]any, len(val))
+		for _, pair := range val {
+			key, ok := pair.Key.(string)
+			if !ok {
+				return getCodecAnyMap(val)
+			}
+			strs[key], err = getCodecAny(pair.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return strs, nil
+	}
+	return data, nil
+}
+
+func getCodecAnyMap(data codecMap) (any, error) {
+	anys := make(map[any]any, len(data))
+	for _, pair := range data {
+		key := pair.Key
+		if b, ok := key.([]byte); ok {
+			key = string(b)
+		}
+		if key != nil && !reflect.TypeOf(key).Comparable() {
+			return nil, fmt.Errorf(ErrCodecDecodeType, getCodecTypeName(key), typeAny)
+		}
+		val, err := getCodecAny(pair.Value)
+		if err != nil {
+			return nil, err
+		}
+		anys[key] = val
+	}
+	return anys, nil
+}
+
+func getCodecTime(data any) (time.Time, bool) {
+	switch val := data.(type) {
+	case time.Time:
+		return val, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, val)
+		return t, err == nil
+	case int64:
+		return time.Unix(val, 0), true
+	case uint64:
+		return time.Unix(int64(val), 0), val <= math.MaxInt64
+	case float64:
+		sec, frac := math.Modf(val)
+		return time.Unix(int64(sec), int64(frac*1e9)), true
+	}
+	return time.Time{}, false
+}
+
+func getCodecTypeName(data any) string {
+	switch data.(type) {
+	case codecMap:
+		return "map"
+	case []any:
+		return "array"
+	default:
+		return fmt.Sprintf("%T", data)
+	}
+}
+
+// The getCodecUint function returns uint64 as int64 if it does not overflow,
+// the decoded integers are int64 as much as possible.
+func getCodecUint(val uint64) any {
+	if val <= math.MaxInt64 {
+		return int64(val)
+	}
+	return val
+}
+
+func getCodecBigEndian(b []byte) uint64 {
+	var val uint64
+	for _, c := range b {
+		val = val<<8 | uint64(c)
+	}
+	return val
+}
@@ -0,0 +1,347 @@
+package eudore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Define the major types of CBOR.
+const (
+	cborUint byte = iota << 5
+	cborNegint
+	cborBytes
+	cborText
+	cborArray
+	cborMap
+	cborTag
+	cborSimple
+)
+
+type cborEncoder struct {
+	data []byte
+}
+
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+// The MarshalCBOR function encodes data to CBOR using reflect.
+//
+// The struct is encoded as a map, the field name uses [DefaultCodecCBORTags],
+// and [time.Time] uses the tag 0 of RFC 3339 string.
+//
+// RFC 8949: Concise Binary Object Representation (CBOR)
+func MarshalCBOR(data any) ([]byte, error) {
+	en := &cborEncoder{}
+	err := encodeCodecValue(en, reflect.ValueOf(data),
+		DefaultCodecCBORTags, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return en.data, nil
+}
+
+// The UnmarshalCBOR function decodes CBOR data to the pointer data.
+//
+// Supports indefinite length items, the tag 0 and 1 are decoded to
+// [time.Time], other tags are ignored.
+//
+// If data is *any, the map is decoded to map[string]any,
+// and the integer is decoded to int64 or uint64.
+func UnmarshalCBOR(body []byte, data any) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf(ErrCodecUnmarshalNotPtr, data)
+	}
+
+	de := &cborDecoder{data: body}
+	val, err := de.decode(0)
+	if err != nil {
+		return err
+	}
+	if de.pos != len(body) {
+		return fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	return decodeCodecValue(v.Elem(), val, DefaultCodecCBORTags)
+}
+
+func (en *cborEncoder) writeHead(major byte, val uint64) {
+	switch {
+	case val < 24:
+		en.data = append(en.data, major|byte(val))
+	case val <= math.MaxUint8:
+		en.data = append(en.data, major|24, byte(val))
+	case val <= math.MaxUint16:
+		en.data = binary.BigEndian.AppendUint16(append(en.data, major|25),
+			uint16(val),
+		)
+	case val <= math.MaxUint32:
+		en.data = binary.BigEndian.AppendUint32(append(en.data, major|26),
+			uint32(val),
+		)
+	default:
+		en.data = binary.BigEndian.AppendUint64(append(en.data, major|27), val)
+	}
+}
+
+func (en *cborEncoder) writeNil() {
+	en.data = append(en.data, cborSimple|22)
+}
+
+func (en *cborEncoder) writeBool(val bool) {
+	if val {
+		en.data = append(en.data, cborSimple|21)
+	} else {
+		en.data = append(en.data, cborSimple|20)
+	}
+}
+
+func (en *cborEncoder) writeInt(val int64) {
+	if val < 0 {
+		en.writeHead(cborNegint, uint64(^val))
+	} else {
+		en.writeHead(cborUint, uint64(val))
+	}
+}
+
+func (en *cborEncoder) writeUint(val uint64) {
+	en.writeHead(cborUint, val)
+}
+
+func (en *cborEncoder) writeFloat(val float64, bits int) {
+	if bits == 32 {
+		en.data = binary.BigEndian.AppendUint32(append(en.data, cborSimple|26),
+			math.Float32bits(float32(val)),
+		)
+	} else {
+		en.data = binary.BigEndian.AppendUint64(append(en.data, cborSimple|27),
+			math.Float64bits(val),
+		)
+	}
+}
+
+func (en *cborEncoder) writeString(val string) {
+	en.writeHead(cborText, uint64(len(val)))
+	en.data = append(en.data, val...)
+}
+
+func (en *cborEncoder) writeBytes(val []byte) {
+	en.writeHead(cborBytes, uint64(len(val)))
+	en.data = append(en.data, val...)
+}
+
+func (en *cborEncoder) writeArray(size int) {
+	en.writeHead(cborArray, uint64(size))
+}
+
+func (en *cborEncoder) writeMap(size int) {
+	en.writeHead(cborMap, uint64(size))
+}
+
+func (en *cborEncoder) writeTime(val time.Time) {
+	en.writeHead(cborTag, 0)
+	en.writeString(val.Format(time.RFC3339Nano))
+}
+
+func (de *cborDecoder) next(n uint64) ([]byte, error) {
+	if n > uint64(len(de.data)-de.pos) {
+		return nil, fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	de.pos += int(n)
+	return de.data[de.pos-int(n) : de.pos], nil
+}
+
+// The readHead method returns the major type, argument and whether the
+// length is indefinite.
+func (de *cborDecoder) readHead() (byte, uint64, bool, error) {
+	b, err := de.next(1)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	major, info := b[0]&0xe0, b[0]&0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), false, nil
+	case info <= 27:
+		b, err := de.next(1 << (info - 24))
+		if err != nil {
+			return 0, 0, false, err
+		}
+		return major, getCodecBigEndian(b), false, nil
+	case info == 31 && major >= cborBytes && major <= cborMap:
+		return major, 0, true, nil
+	}
+	return 0, 0, false, fmt.Errorf(ErrCodecInvalidData, de.pos-1)
+}
+
+//nolint:cyclop,gocyclo
+func (de *cborDecoder) decode(depth int) (any, error) {
+	if depth > codecMaxDepth {
+		return nil, fmt.Errorf(ErrCodecMaxDepth, codecMaxDepth)
+	}
+	pos := de.pos
+	major, arg, indefinite, err := de.readHead()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborUint:
+		return getCodecUint(arg), nil
+	case cborNegint:
+		if arg > math.MaxInt64 {
+			return nil, fmt.Errorf(ErrCodecInvalidData, pos)
+		}
+		return ^int64(arg), nil
+	case cborBytes, cborText:
+		b, err := de.decodeString(major, arg, indefinite)
+		if major == cborText {
+			return string(b), err
+		}
+		return b, err
+	case cborArray:
+		return de.decodeArray(arg, indefinite, depth)
+	case cborMap:
+		return de.decodeMap(arg, indefinite, depth)
+	case cborTag:
+		val, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if arg == 0 || arg == 1 {
+			t, ok := getCodecTime(val)
+			if !ok {
+				return nil, fmt.Errorf(ErrCodecInvalidData, pos)
+			}
+			return t, nil
+		}
+		return val, nil
+	}
+
+	// major type 7
+	switch de.data[pos] & 0x1f {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 25:
+		return getCBORHalfFloat(uint16(arg)), nil
+	case 26:
+		return float64(math.Float32frombits(uint32(arg))), nil
+	case 27:
+		return math.Float64frombits(arg), nil
+	}
+	return nil, fmt.Errorf(ErrCodecInvalidData, pos)
+}
+
+// The decodeString method decodes byte or text string,
+// the indefinite length string is the concatenation of definite chunks.
+func (de *cborDecoder) decodeString(major byte, size uint64, indefinite bool,
+) ([]byte, error) {
+	if !indefinite {
+		b, err := de.next(size)
+		return append([]byte{}, b...), err
+	}
+
+	var data []byte
+	for !de.isBreak() {
+		pos := de.pos
+		chunk, size, indefinite, err := de.readHead()
+		if err != nil {
+			return nil, err
+		}
+		if chunk != major || indefinite {
+			return nil, fmt.Errorf(ErrCodecInvalidData, pos)
+		}
+		b, err := de.next(size)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b...)
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	return data, nil
+}
+
+func (de *cborDecoder) decodeArray(size uint64, indefinite bool, depth int,
+) (any, error) {
+	// each element has at least one byte.
+	if size > uint64(len(de.data)-de.pos) {
+		return nil, fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	vals := make([]any, 0, size)
+	for i := uint64(0); indefinite || i < size; i++ {
+		if indefinite && de.isBreak() {
+			break
+		}
+		val, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+func (de *cborDecoder) decodeMap(size uint64, indefinite bool, depth int,
+) (any, error) {
+	if size > uint64(len(de.data)-de.pos)/2 {
+		return nil, fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	vals := make(codecMap, 0, size)
+	for i := uint64(0); indefinite || i < size; i++ {
+		if indefinite && de.isBreak() {
+			break
+		}
+		key, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		val, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, codecPair{key, val})
+	}
+	return vals, nil
+}
+
+// The isBreak method consumes the break stop code of indefinite length item,
+// if the data is truncated, the next decode returns an error.
+func (de *cborDecoder) isBreak() bool {
+	if de.pos < len(de.data) && de.data[de.pos] == 0xff {
+		de.pos++
+		return true
+	}
+	return false
+}
+
+func getCBORHalfFloat(h uint16) float64 {
+	exp, frac := int(h>>10&0x1f), float64(h&0x3ff)
+	var val float64
+	switch exp {
+	case 0:
+		val = math.Ldexp(frac, -24)
+	case 31:
+		if frac == 0 {
+			val = math.Inf(1)
+		} else {
+			val = math.NaN()
+		}
+	default:
+		val = math.Ldexp(frac+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		val = -val
+	}
+	return val
+}
@@ -0,0 +1,362 @@
+package eudore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+type msgpackEncoder struct {
+	data []byte
+}
+
+type msgpackDecoder struct {
+	data []byte
+	pos  int
+}
+
+// The MarshalMsgpack function encodes data to MessagePack using reflect.
+//
+// The struct is encoded as a map, the field name uses
+// [DefaultCodecMsgpackTags], and [time.Time] uses the timestamp extension.
+//
+// MessagePack: https://github.com/msgpack/msgpack/blob/master/spec.md
+func MarshalMsgpack(data any) ([]byte, error) {
+	en := &msgpackEncoder{}
+	err := encodeCodecValue(en, reflect.ValueOf(data),
+		DefaultCodecMsgpackTags, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return en.data, nil
+}
+
+// The UnmarshalMsgpack function decodes MessagePack data to the pointer data.
+//
+// If data is *any, the map is decoded to map[string]any,
+// and the integer is decoded to int64 or uint64.
+func UnmarshalMsgpack(body []byte, data any) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf(ErrCodecUnmarshalNotPtr, data)
+	}
+
+	de := &msgpackDecoder{data: body}
+	val, err := de.decode(0)
+	if err != nil {
+		return err
+	}
+	if de.pos != len(body) {
+		return fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	return decodeCodecValue(v.Elem(), val, DefaultCodecMsgpackTags)
+}
+
+func (en *msgpackEncoder) writeNil() {
+	en.data = append(en.data, 0xc0)
+}
+
+func (en *msgpackEncoder) writeBool(val bool) {
+	if val {
+		en.data = append(en.data, 0xc3)
+	} else {
+		en.data = append(en.data, 0xc2)
+	}
+}
+
+func (en *msgpackEncoder) writeInt(val int64) {
+	switch {
+	case val >= 0:
+		en.writeUint(uint64(val))
+	case val >= -32:
+		en.data = append(en.data, byte(val))
+	case val >= math.MinInt8:
+		en.data = append(en.data, 0xd0, byte(val))
+	case val >= math.MinInt16:
+		en.data = binary.BigEndian.AppendUint16(append(en.data, 0xd1),
+			uint16(val),
+		)
+	case val >= math.MinInt32:
+		en.data = binary.BigEndian.AppendUint32(append(en.data, 0xd2),
+			uint32(val),
+		)
+	default:
+		en.data = binary.BigEndian.AppendUint64(append(en.data, 0xd3),
+			uint64(val),
+		)
+	}
+}
+
+func (en *msgpackEncoder) writeUint(val uint64) {
+	switch {
+	case val <= math.MaxInt8:
+		en.data = append(en.data, byte(val))
+	case val <= math.MaxUint8:
+		en.data = append(en.data, 0xcc, byte(val))
+	case val <= math.MaxUint16:
+		en.data = binary.BigEndian.AppendUint16(append(en.data, 0xcd),
+			uint16(val),
+		)
+	case val <= math.MaxUint32:
+		en.data = binary.BigEndian.AppendUint32(append(en.data, 0xce),
+			uint32(val),
+		)
+	default:
+		en.data = binary.BigEndian.AppendUint64(append(en.data, 0xcf), val)
+	}
+}
+
+func (en *msgpackEncoder) writeFloat(val float64, bits int) {
+	if bits == 32 {
+		en.data = binary.BigEndian.AppendUint32(append(en.data, 0xca),
+			math.Float32bits(float32(val)),
+		)
+	} else {
+		en.data = binary.BigEndian.AppendUint64(append(en.data, 0xcb),
+			math.Float64bits(val),
+		)
+	}
+}
+
+func (en *msgpackEncoder) writeString(val string) {
+	en.writeHead(len(val), 0xa0, 32, 0xd9, 0xda)
+	en.data = append(en.data, val...)
+}
+
+func (en *msgpackEncoder) writeBytes(val []byte) {
+	en.writeHead(len(val), 0, 0, 0xc4, 0xc5)
+	en.data = append(en.data, val...)
+}
+
+func (en *msgpackEncoder) writeArray(size int) {
+	en.writeHead(size, 0x90, 16, 0, 0xdc)
+}
+
+func (en *msgpackEncoder) writeMap(size int) {
+	en.writeHead(size, 0x80, 16, 0, 0xde)
+}
+
+// The writeHead method writes the length using the fix format if size is
+// less than fixmax, otherwise uses the 8/16/32 bits format,
+// the 8 bits format is not supported if code8 is 0.
+func (en *msgpackEncoder) writeHead(size int, fix byte, fixmax int,
+	code8, code16 byte,
+) {
+	switch {
+	case size < fixmax:
+		en.data = append(en.data, fix|byte(size))
+	case size <= math.MaxUint8 && code8 != 0:
+		en.data = append(en.data, code8, byte(size))
+	case size <= math.MaxUint16:
+		en.data = binary.BigEndian.AppendUint16(append(en.data, code16),
+			uint16(size),
+		)
+	default:
+		en.data = binary.BigEndian.AppendUint32(append(en.data, code16+1),
+			uint32(size),
+		)
+	}
+}
+
+// The writeTime method uses the timestamp extension type -1.
+func (en *msgpackEncoder) writeTime(val time.Time) {
+	sec, nsec := val.Unix(), uint64(val.Nanosecond())
+	switch {
+	case sec>>34 == 0 && nsec == 0 && sec>>32 == 0:
+		en.data = binary.BigEndian.AppendUint32(append(en.data, 0xd6, 0xff),
+			uint32(sec),
+		)
+	case sec>>34 == 0:
+		en.data = binary.BigEndian.AppendUint64(append(en.data, 0xd7, 0xff),
+			nsec<<34|uint64(sec),
+		)
+	default:
+		en.data = binary.BigEndian.AppendUint32(
+			append(en.data, 0xc7, 12, 0xff), uint32(nsec),
+		)
+		en.data = binary.BigEndian.AppendUint64(en.data, uint64(sec))
+	}
+}
+
+func (de *msgpackDecoder) next(n int) ([]byte, error) {
+	if n < 0 || n > len(de.data)-de.pos {
+		return nil, fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	de.pos += n
+	return de.data[de.pos-n : de.pos], nil
+}
+
+func (de *msgpackDecoder) readSize(n int) (int, error) {
+	b, err := de.next(n)
+	if err != nil {
+		return 0, err
+	}
+	switch n {
+	case 1:
+		return int(b[0]), nil
+	case 2:
+		return int(binary.BigEndian.Uint16(b)), nil
+	default:
+		return int(binary.BigEndian.Uint32(b)), nil
+	}
+}
+
+//nolint:cyclop,funlen,gocyclo
+func (de *msgpackDecoder) decode(depth int) (any, error) {
+	if depth > codecMaxDepth {
+		return nil, fmt.Errorf(ErrCodecMaxDepth, codecMaxDepth)
+	}
+	b, err := de.next(1)
+	if err != nil {
+		return nil, err
+	}
+
+	code := b[0]
+	switch {
+	case code <= 0x7f:
+		return int64(code), nil
+	case code >= 0xe0:
+		return int64(int8(code)), nil
+	case code <= 0x8f:
+		return de.decodeMap(int(code&0x0f), depth)
+	case code <= 0x9f:
+		return de.decodeArray(int(code&0x0f), depth)
+	case code <= 0xbf:
+		b, err := de.next(int(code & 0x1f))
+		return string(b), err
+	}
+
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		size, err := de.readSize(1 << (code - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		b, err := de.next(size)
+		return append([]byte{}, b...), err
+	case 0xc7, 0xc8, 0xc9:
+		size, err := de.readSize(1 << (code - 0xc7))
+		if err != nil {
+			return nil, err
+		}
+		return de.decodeExt(size)
+	case 0xca:
+		b, err := de.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 0xcb:
+		b, err := de.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		b, err := de.next(1 << (code - 0xcc))
+		if err != nil {
+			return nil, err
+		}
+		return getCodecUint(getCodecBigEndian(b)), nil
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		b, err := de.next(1 << (code - 0xd0))
+		if err != nil {
+			return nil, err
+		}
+		val := getCodecBigEndian(b)
+		shift := 64 - 8*len(b)
+		return int64(val<<shift) >> shift, nil
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return de.decodeExt(1 << (code - 0xd4))
+	case 0xd9, 0xda, 0xdb:
+		size, err := de.readSize(1 << (code - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		b, err := de.next(size)
+		return string(b), err
+	case 0xdc, 0xdd:
+		size, err := de.readSize(2 << (code - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return de.decodeArray(size, depth)
+	case 0xde, 0xdf:
+		size, err := de.readSize(2 << (code - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return de.decodeMap(size, depth)
+	}
+	return nil, fmt.Errorf(ErrCodecInvalidData, de.pos-1)
+}
+
+func (de *msgpackDecoder) decodeArray(size, depth int) (any, error) {
+	// each element has at least one byte.
+	if size > len(de.data)-de.pos {
+		return nil, fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	vals := make([]any, size)
+	for i := range vals {
+		val, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = val
+	}
+	return vals, nil
+}
+
+func (de *msgpackDecoder) decodeMap(size, depth int) (any, error) {
+	if size > (len(de.data)-de.pos)/2 {
+		return nil, fmt.Errorf(ErrCodecInvalidData, de.pos)
+	}
+	vals := make(codecMap, size)
+	for i := range vals {
+		key, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		val, err := de.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = codecPair{key, val}
+	}
+	return vals, nil
+}
+
+// The decodeExt method decodes the timestamp extension type -1,
+// and the data of other extension types is returned as []byte.
+func (de *msgpackDecoder) decodeExt(size int) (any, error) {
+	b, err := de.next(size + 1)
+	if err != nil {
+		return nil, err
+	}
+	kind, b := int8(b[0]), b[1:]
+	if kind != -1 {
+		return append([]byte{}, b...), nil
+	}
+
+	switch len(b) {
+	case 4:
+		return time.Unix(int64(binary.BigEndian.Uint32(b)), 0), nil
+	case 8:
+		val := binary.BigEndian.Uint64(b)
+		return time.Unix(int64(val&0x3ffffffff), int64(val>>34)), nil
+	case 12:
+		return time.Unix(int64(binary.BigEndian.Uint64(b[4:])),
+			int64(binary.BigEndian.Uint32(b)),
+		), nil
+	}
+	return nil, fmt.Errorf(ErrCodecInvalidData, de.pos-size)
+}
@@ -0,0 +1,531 @@
+package eudore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Define the wire types of protobuf.
+const (
+	protobufVarint  = 0
+	protobufFixed64 = 1
+	protobufBytes   = 2
+	protobufFixed32 = 5
+)
+
+var storageProtobufFields sync.Map
+
+type protobufField struct {
+	Index  int
+	Number uint64
+}
+
+type protobufFields struct {
+	Fields  []protobufField
+	Numbers map[uint64]int
+}
+
+type protobufEncoder struct {
+	data []byte
+}
+
+// The MarshalProtobuf function encodes the struct to protobuf wire format
+// using reflect, does not require generated code.
+//
+// The field number uses the struct tag `protobuf:"1,name=name"`,
+// the field without tag uses the field index plus one,
+// tag "-" ignores the field.
+//
+// Type mapping:
+//   - bool int uint: varint, negative integers use 10 bytes like int64.
+//   - float32 float64: fixed32 fixed64.
+//   - string []byte: bytes.
+//   - struct: embedded message.
+//   - slice: packed if the element is numeric, otherwise repeated.
+//   - map: repeated message with the key of field 1 and value of field 2.
+//   - [time.Time]: message of google.protobuf.Timestamp.
+//   - any: encoded with the dynamic type.
+//
+// Protobuf: https://protobuf.dev/programming-guides/encoding/
+func MarshalProtobuf(data any) ([]byte, error) {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return []byte{}, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct || v.Type() == typeTimeTime {
+		return nil, fmt.Errorf(ErrCodecProtobufNotStruct, reflect.TypeOf(data))
+	}
+
+	en := &protobufEncoder{data: []byte{}}
+	err := en.encodeMessage(v, 0)
+	if err != nil {
+		return nil, err
+	}
+	return en.data, nil
+}
+
+// The UnmarshalProtobuf function decodes protobuf wire format data to the
+// pointer of struct, refer to [MarshalProtobuf] for the type mapping.
+//
+// Unknown fields are ignored, the repeated field accepts both packed and
+// unpacked data, and the bytes of any field are decoded to string.
+func UnmarshalProtobuf(body []byte, data any) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf(ErrCodecUnmarshalNotPtr, data)
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct || v.Type() == typeTimeTime {
+		return fmt.Errorf(ErrCodecProtobufNotStruct, v.Type())
+	}
+	return decodeProtobufMessage(v, body, 0)
+}
+
+func getProtobufFields(t reflect.Type) *protobufFields {
+	fields, ok := storageProtobufFields.Load(t)
+	if ok {
+		return fields.(*protobufFields)
+	}
+
+	pf := &protobufFields{Numbers: make(map[uint64]int)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("protobuf")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		num, err := strconv.ParseUint(strings.Split(tag, ",")[0], 10, 29)
+		if err != nil || num == 0 {
+			num = uint64(i + 1)
+		}
+		pf.Numbers[num] = len(pf.Fields)
+		pf.Fields = append(pf.Fields, protobufField{i, num})
+	}
+	storageProtobufFields.Store(t, pf)
+	return pf
+}
+
+func (en *protobufEncoder) writeKey(num uint64, wire byte) {
+	en.data = binary.AppendUvarint(en.data, num<<3|uint64(wire))
+}
+
+// The writeBytes method writes the length delimited data that is appended
+// to en.data from pos.
+func (en *protobufEncoder) writeBytes(num uint64, pos int) {
+	size := len(en.data) - pos
+	head := binary.AppendUvarint(nil, num<<3|protobufBytes)
+	head = binary.AppendUvarint(head, uint64(size))
+	en.data = append(en.data, head...)
+	copy(en.data[pos+len(head):], en.data[pos:pos+size])
+	copy(en.data[pos:], head)
+}
+
+func (en *protobufEncoder) encodeMessage(v reflect.Value, depth int) error {
+	if depth > codecMaxDepth {
+		return fmt.Errorf(ErrCodecMaxDepth, codecMaxDepth)
+	}
+	for _, field := range getProtobufFields(v.Type()).Fields {
+		err := en.encodeField(field.Number, v.Field(field.Index), false, depth)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The encodeField method encodes the field value,
+// the zero value is ignored unless force is true, used by repeated field.
+//
+//nolint:cyclop,funlen,gocyclo
+func (en *protobufEncoder) encodeField(num uint64, v reflect.Value,
+	force bool, depth int,
+) error {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+		force = true
+	}
+
+	t := v.Type()
+	if t == typeTimeTime {
+		val := v.Interface().(time.Time)
+		if val.IsZero() && !force {
+			return nil
+		}
+		pos := len(en.data)
+		if sec := val.Unix(); sec != 0 {
+			en.writeKey(1, protobufVarint)
+			en.data = binary.AppendUvarint(en.data, uint64(sec))
+		}
+		if nsec := val.Nanosecond(); nsec != 0 {
+			en.writeKey(2, protobufVarint)
+			en.data = binary.AppendUvarint(en.data, uint64(nsec))
+		}
+		en.writeBytes(num, pos)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() || force {
+			en.writeKey(num, protobufVarint)
+			en.data = append(en.data, getProtobufBool(v.Bool()))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() != 0 || force {
+			en.writeKey(num, protobufVarint)
+			en.data = binary.AppendUvarint(en.data, uint64(v.Int()))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		if v.Uint() != 0 || force {
+			en.writeKey(num, protobufVarint)
+			en.data = binary.AppendUvarint(en.data, v.Uint())
+		}
+	case reflect.Float32:
+		if v.Float() != 0 || force {
+			en.writeKey(num, protobufFixed32)
+			en.data = binary.LittleEndian.AppendUint32(en.data,
+				math.Float32bits(float32(v.Float())),
+			)
+		}
+	case reflect.Float64:
+		if v.Float() != 0 || force {
+			en.writeKey(num, protobufFixed64)
+			en.data = binary.LittleEndian.AppendUint64(en.data,
+				math.Float64bits(v.Float()),
+			)
+		}
+	case reflect.String:
+		if v.Len() > 0 || force {
+			en.writeKey(num, protobufBytes)
+			en.data = binary.AppendUvarint(en.data, uint64(v.Len()))
+			en.data = append(en.data, v.String()...)
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if v.Len() > 0 || force {
+				en.writeKey(num, protobufBytes)
+				en.data = binary.AppendUvarint(en.data, uint64(v.Len()))
+				en.data = append(en.data, v.Bytes()...)
+			}
+			return nil
+		}
+		if isProtobufPacked(t.Elem()) {
+			if v.Len() > 0 {
+				pos := len(en.data)
+				for i := 0; i < v.Len(); i++ {
+					en.encodePacked(v.Index(i))
+				}
+				en.writeBytes(num, pos)
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			if elem.Kind() == reflect.Slice && elem.Type().Elem().Kind() != reflect.Uint8 {
+				return fmt.Errorf(ErrCodecUnsupportedType, t)
+			}
+			err := en.encodeField(num, elem, true, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		if t.Key().Kind() == reflect.String {
+			sort.Slice(keys, func(i, j int) bool {
+				return keys[i].String() < keys[j].String()
+			})
+		}
+		for _, key := range keys {
+			pos := len(en.data)
+			err := en.encodeField(1, key, false, depth+1)
+			if err != nil {
+				return err
+			}
+			err = en.encodeField(2, v.MapIndex(key), false, depth+1)
+			if err != nil {
+				return err
+			}
+			en.writeBytes(num, pos)
+		}
+	case reflect.Struct:
+		pos := len(en.data)
+		err := en.encodeMessage(v, depth+1)
+		if err != nil {
+			return err
+		}
+		if len(en.data) > pos || force {
+			en.writeBytes(num, pos)
+		}
+	default:
+		return fmt.Errorf(ErrCodecUnsupportedType, t)
+	}
+	return nil
+}
+
+func (en *protobufEncoder) encodePacked(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		en.data = append(en.data, getProtobufBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		en.data = binary.AppendUvarint(en.data, uint64(v.Int()))
+	case reflect.Float32:
+		en.data = binary.LittleEndian.AppendUint32(en.data,
+			math.Float32bits(float32(v.Float())),
+		)
+	case reflect.Float64:
+		en.data = binary.LittleEndian.AppendUint64(en.data,
+			math.Float64bits(v.Float()),
+		)
+	default:
+		en.data = binary.AppendUvarint(en.data, v.Uint())
+	}
+}
+
+func isProtobufPacked(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// The readProtobufField function reads a field from data and returns the
+// field number, wire type, varint or fixed value, bytes value and the
+// remaining data.
+func readProtobufField(data []byte) (uint64, byte, uint64, []byte, []byte,
+	error,
+) {
+	key, n := binary.Uvarint(data)
+	if n <= 0 || key>>3 == 0 {
+		return 0, 0, 0, nil, nil, ErrCodecProtobufInvalid
+	}
+	num, wire, data := key>>3, byte(key&7), data[n:]
+
+	var val uint64
+	switch wire {
+	case protobufVarint:
+		val, n = binary.Uvarint(data)
+		if n <= 0 {
+			return 0, 0, 0, nil, nil, ErrCodecProtobufInvalid
+		}
+		return num, wire, val, nil, data[n:], nil
+	case protobufFixed64:
+		if len(data) < 8 {
+			return 0, 0, 0, nil, nil, ErrCodecProtobufInvalid
+		}
+		return num, wire, binary.LittleEndian.Uint64(data), nil, data[8:], nil
+	case protobufFixed32:
+		if len(data) < 4 {
+			return 0, 0, 0, nil, nil, ErrCodecProtobufInvalid
+		}
+		val = uint64(binary.LittleEndian.Uint32(data))
+		return num, wire, val, nil, data[4:], nil
+	case protobufBytes:
+		size, n := binary.Uvarint(data)
+		if n <= 0 || size > uint64(len(data)-n) {
+			return 0, 0, 0, nil, nil, ErrCodecProtobufInvalid
+		}
+		data = data[n:]
+		return num, wire, 0, data[:size], data[size:], nil
+	}
+	return 0, 0, 0, nil, nil, ErrCodecProtobufInvalid
+}
+
+func decodeProtobufMessage(v reflect.Value, data []byte, depth int) error {
+	if depth > codecMaxDepth {
+		return fmt.Errorf(ErrCodecMaxDepth, codecMaxDepth)
+	}
+	fields := getProtobufFields(v.Type())
+	for len(data) > 0 {
+		num, wire, val, body, next, err := readProtobufField(data)
+		if err != nil {
+			return err
+		}
+		data = next
+
+		index, ok := fields.Numbers[num]
+		if !ok {
+			continue
+		}
+		field := fields.Fields[index]
+		err = decodeProtobufValue(v.Field(field.Index), wire, val, body, depth)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//nolint:cyclop,funlen,gocyclo
+func decodeProtobufValue(v reflect.Value, wire byte, val uint64, body []byte,
+	depth int,
+) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	t := v.Type()
+	if t == typeTimeTime && wire == protobufBytes {
+		var sec, nsec int64
+		for len(body) > 0 {
+			num, wire, val, _, next, err := readProtobufField(body)
+			if err != nil {
+				return err
+			}
+			body = next
+			if wire == protobufVarint && num == 1 {
+				sec = int64(val)
+			} else if wire == protobufVarint && num == 2 {
+				nsec = int64(val)
+			}
+		}
+		v.Set(reflect.ValueOf(time.Unix(sec, nsec)))
+		return nil
+	}
+
+	switch {
+	case v.Kind() == reflect.Bool && wire == protobufVarint:
+		v.SetBool(val != 0)
+	case isProtobufInt(v.Kind()) && wire != protobufBytes:
+		if wire == protobufFixed32 {
+			val = uint64(int32(val))
+		}
+		v.SetInt(int64(val))
+	case isProtobufUint(v.Kind()) && wire != protobufBytes:
+		v.SetUint(val)
+	case v.Kind() == reflect.Float32 && wire == protobufFixed32:
+		v.SetFloat(float64(math.Float32frombits(uint32(val))))
+	case v.Kind() == reflect.Float64 && wire == protobufFixed64:
+		v.SetFloat(math.Float64frombits(val))
+	case v.Kind() == reflect.String && wire == protobufBytes:
+		v.SetString(string(body))
+	case v.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 &&
+		wire == protobufBytes:
+		v.SetBytes(append([]byte{}, body...))
+	case v.Kind() == reflect.Slice:
+		if wire == protobufBytes && isProtobufPacked(t.Elem()) {
+			return decodeProtobufPacked(v, body)
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		err := decodeProtobufValue(elem, wire, val, body, depth+1)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.Append(v, elem))
+	case v.Kind() == reflect.Map && wire == protobufBytes:
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(t))
+		}
+		key := reflect.New(t.Key()).Elem()
+		elem := reflect.New(t.Elem()).Elem()
+		for len(body) > 0 {
+			num, wire, val, data, next, err := readProtobufField(body)
+			if err != nil {
+				return err
+			}
+			body = next
+			switch num {
+			case 1:
+				err = decodeProtobufValue(key, wire, val, data, depth+1)
+			case 2:
+				err = decodeProtobufValue(elem, wire, val, data, depth+1)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		v.SetMapIndex(key, elem)
+	case v.Kind() == reflect.Struct && wire == protobufBytes:
+		return decodeProtobufMessage(v, body, depth+1)
+	case v.Kind() == reflect.Interface && t.NumMethod() == 0:
+		switch wire {
+		case protobufVarint:
+			v.Set(reflect.ValueOf(int64(val)))
+		case protobufFixed64:
+			v.Set(reflect.ValueOf(math.Float64frombits(val)))
+		case protobufFixed32:
+			v.Set(reflect.ValueOf(float64(math.Float32frombits(uint32(val)))))
+		default:
+			v.Set(reflect.ValueOf(string(body)))
+		}
+	default:
+		return fmt.Errorf(ErrCodecProtobufWireType, wire, t)
+	}
+	return nil
+}
+
+func decodeProtobufPacked(v reflect.Value, body []byte) error {
+	t := v.Type().Elem()
+	for len(body) > 0 {
+		elem := reflect.New(t).Elem()
+		switch t.Kind() {
+		case reflect.Float32:
+			if len(body) < 4 {
+				return ErrCodecProtobufInvalid
+			}
+			elem.SetFloat(float64(math.Float32frombits(
+				binary.LittleEndian.Uint32(body),
+			)))
+			body = body[4:]
+		case reflect.Float64:
+			if len(body) < 8 {
+				return ErrCodecProtobufInvalid
+			}
+			elem.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(body)))
+			body = body[8:]
+		default:
+			val, n := binary.Uvarint(body)
+			if n <= 0 {
+				return ErrCodecProtobufInvalid
+			}
+			body = body[n:]
+			switch {
+			case t.Kind() == reflect.Bool:
+				elem.SetBool(val != 0)
+			case isProtobufInt(t.Kind()):
+				elem.SetInt(int64(val))
+			default:
+				elem.SetUint(val)
+			}
+		}
+		v.Set(reflect.Append(v, elem))
+	}
+	return nil
+}
+
+func isProtobufInt(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isProtobufUint(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uintptr
+}
+
+func getProtobufBool(val bool) byte {
+	if val {
+		return 1
+	}
+	return 0
+}
@@ -12,15 +12,16 @@ import (
 
 var (
 	// defines reflect type.
-	typeAny           = reflect.TypeOf((*any)(nil)).Elem()
-	typeError         = reflect.TypeOf((*error)(nil)).Elem()
-	typeContext       = reflect.TypeOf((*Context)(nil)).Elem()
-	typeHandlerFunc   = reflect.TypeOf((*HandlerFunc)(nil)).Elem()
-	typeTimeDuration  = reflect.TypeOf((*time.Duration)(nil)).Elem()
-	typeTimeTime      = reflect.TypeOf((*time.Time)(nil)).Elem()
-	typeFmtStringer   = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
-	typeJSONMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
-	typeTextMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	typeAny             = reflect.TypeOf((*any)(nil)).Elem()
+	typeError           = reflect.TypeOf((*error)(nil)).Elem()
+	typeContext         = reflect.TypeOf((*Context)(nil)).Elem()
+	typeHandlerFunc     = reflect.TypeOf((*HandlerFunc)(nil)).Elem()
+	typeTimeDuration    = reflect.TypeOf((*time.Duration)(nil)).Elem()
+	typeTimeTime        = reflect.TypeOf((*time.Time)(nil)).Elem()
+	typeFmtStringer     = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	typeJSONMarshaler   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typeTextMarshaler   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	typeTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
 	// check interface.
 	_ Client          = (*clientStd)(nil)
 	_ ClientHook      = (*clientHookCookie)(nil)
@@ -177,9 +178,11 @@ const (
 	HeaderAllow                           = "Allow"
 	HeaderAltSvc                          = "Alt-Svc"
 	HeaderAuthorization                   = "Authorization"
+	HeaderBaggage                         = "Baggage"
 	HeaderCacheControl                    = "Cache-Control"
 	HeaderClearSiteData                   = "Clear-Site-Data"
 	HeaderConnection                      = "Connection"
+	HeaderContentDigest                   = "Content-Digest"
 	HeaderContentDisposition              = "Content-Disposition"
 	HeaderContentEncoding                 = "Content-Encoding"
 	HeaderContentLanguage                 = "Content-Language"
@@ -219,18 +222,30 @@ const (
 	HeaderPublicKeyPins                   = "Public-Key-Pins"
 	HeaderPublicKeyPinsReportOnly         = "Public-Key-Pins-Report-Only"
 	HeaderRange                           = "Range"
+	HeaderRateLimitLimit                  = "RateLimit-Limit"
+	HeaderRateLimitPolicy                 = "RateLimit-Policy"
+	HeaderRateLimitRemaining              = "RateLimit-Remaining"
+	HeaderRateLimitReset                  = "RateLimit-Reset"
 	HeaderReferer                         = "Referer"
 	HeaderReferrerPolicy                  = "Referrer-Policy"
 	HeaderRetryAfter                      = "Retry-After"
 	HeaderSecWebSocketAccept              = "Sec-WebSocket-Accept"
+	HeaderSecWebSocketExtensions          = "Sec-WebSocket-Extensions"
+	HeaderSecWebSocketKey                 = "Sec-WebSocket-Key"
+	HeaderSecWebSocketProtocol            = "Sec-WebSocket-Protocol"
+	HeaderSecWebSocketVersion             = "Sec-WebSocket-Version"
 	HeaderServer                          = "Server"
 	HeaderServerTiming                    = "Server-Timing"
 	HeaderSetCookie                       = "Set-Cookie"
+	HeaderSignature                       = "Signature"
+	HeaderSignatureInput                  = "Signature-Input"
 	HeaderSourceMap                       = "SourceMap"
 	HeaderStrictTransportSecurity         = "Strict-Transport-Security"
 	HeaderTE                              = "Te"
 	HeaderTimingAllowOrigin               = "Timing-Allow-Origin"
 	HeaderTk                              = "Tk"
+	HeaderTraceparent                     = "Traceparent"
+	HeaderTracestate                      = "Tracestate"
 	HeaderTrailer                         = "Trailer"
 	HeaderTransferEncoding                = "Transfer-Encoding"
 	HeaderUpgrade                         = "Upgrade"
@@ -276,9 +291,13 @@ const (
 	MimeApplicationYAML            = "application/yaml"
 	MimeApplicationXML             = "application/xml"
 	MimeApplicationProtobuf        = "application/protobuf"
+	MimeApplicationMsgpack         = "application/msgpack"
+	MimeApplicationCBOR            = "application/cbor"
 	MimeApplicationJSON            = "application/json"
+	MimeApplicationNDJSON          = "application/x-ndjson"
 	MimeApplicationForm            = "application/x-www-form-urlencoded"
 	MimeApplicationOctetStream     = "application/octet-stream"
+	MimeApplicationOpenMetrics     = "application/openmetrics-text"
 	MimeMultipartForm              = "multipart/form-data"
 	MimeMultipartMixed             = "multipart/mixed"
 	MimeCharsetUtf8                = "charset=utf-8"
@@ -304,10 +323,12 @@ const (
 	ParamAutoIndex       = "autoindex"       // NewHandlerFileSystem
 	ParamBrowser         = "browser"         // middlewae.NewUaserAgentFunc
 	ParamControllerGroup = "controllergroup" // ControllerInjectAutoRoute
+	ParamLayout          = "layout"          // NewHandlerDataRenderTemplateEngine
 	ParamLoggerKind      = "loggerkind"      // Router.Group
 	ParamRouteHost       = "route-host"      // NewRouterCoreHost
+	ParamRouteName       = "name"            // Router.URL
 	ParamRoute           = "route"           // NewRouter
-	ParamTemplate        = "template"        // NewHandlerDataRenderTemplates
+	ParamTemplate        = "template"        // NewHandlerDataRenderTemplates NewHandlerDataRenderTemplateEngine
 
 	// Logger Field.
 
@@ -317,10 +338,35 @@ const (
 	FieldFile       = "file"
 	FieldFunc       = "func"
 	FieldLogger     = "logger"
+	FieldSpanID     = "span_id"
 	FieldStack      = "stack"
 	FieldTime       = "time"
+	FieldTraceID    = "trace_id"
 	FieldXRequestID = "x_request_id"
 	FieldXTraceID   = "x_trace_id"
+
+	// Websocket Message Type.
+
+	WebsocketMessageText   = 1
+	WebsocketMessageBinary = 2
+	WebsocketMessageClose  = 8
+	WebsocketMessagePing   = 9
+	WebsocketMessagePong   = 10
+
+	// Websocket Close Code.
+
+	WebsocketCloseNormalClosure           = 1000 // RFC 6455, 7.4.1
+	WebsocketCloseGoingAway               = 1001 // RFC 6455, 7.4.1
+	WebsocketCloseProtocolError           = 1002 // RFC 6455, 7.4.1
+	WebsocketCloseUnsupportedData         = 1003 // RFC 6455, 7.4.1
+	WebsocketCloseNoStatusReceived        = 1005 // RFC 6455, 7.4.1
+	WebsocketCloseAbnormalClosure         = 1006 // RFC 6455, 7.4.1
+	WebsocketCloseInvalidFramePayloadData = 1007 // RFC 6455, 7.4.1
+	WebsocketClosePolicyViolation         = 1008 // RFC 6455, 7.4.1
+	WebsocketCloseMessageTooBig           = 1009 // RFC 6455, 7.4.1
+	WebsocketCloseMandatoryExtension      = 1010 // RFC 6455, 7.4.1
+	WebsocketCloseInternalServerErr       = 1011 // RFC 6455, 7.4.1
+	WebsocketCloseTLSHandshake            = 1015 // RFC 6455, 7.4.1
 )
 
 var templateEmbedIndex = `<!DOCTYPE html><html>
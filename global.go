@@ -7,6 +7,7 @@ import (
 	"html/template"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"time"
 )
@@ -34,6 +35,21 @@ var (
 	ContextKeyDaemonSignal    = NewContextKey("daemon-signal")
 	ContextKeyEventHub        = NewContextKey("event-hub")
 	ContextKeyTrace           = NewContextKey("trace")
+	ContextKeyTraceSpan       = NewContextKey("trace-span")
+	ContextKeyMetrics         = NewContextKey("metrics")
+	ContextKeySession         = NewContextKey("session")
+	// DefaultClientBalancerEjectTime defines the time that an endpoint is
+	// ejected by [NewClientHookBalancer].
+	DefaultClientBalancerEjectTime = 10 * time.Second
+	// DefaultClientBalancerFailures defines the number of consecutive failures
+	// to eject an endpoint by [NewClientHookBalancer].
+	DefaultClientBalancerFailures = 3
+	// DefaultClientBalancerReplicas defines the number of virtual nodes of
+	// each endpoint in the consistent hashing of [NewClientHookBalancer].
+	DefaultClientBalancerReplicas = 100
+	// DefaultClientCacheBodyLimit defines the max body length of the
+	// response cached by [NewClientHookCache].
+	DefaultClientCacheBodyLimit int64 = 4 << 20
 	// DefaultClientCheckBodyLength global defines the max length of the
 	// [NewClientCheckBody] output string.
 	DefaultClientCheckBodyLength = 128
@@ -46,6 +62,9 @@ var (
 	// DefaultClientInternalHost global defines default Host used by [Client]
 	// internal connections.
 	DefaultClientInternalHost = "internalhost"
+	// DefaultClientOAuth2RefreshEarly defines how long before expiry the
+	// token is refreshed by [NewClientHookOAuth2].
+	DefaultClientOAuth2RefreshEarly = 30 * time.Second
 	// DefaultClientOptionLoggerError global defines whether
 	// [ClientOption.ResponseHooks] outputs [LoggerError] logs.
 	DefaultClientOptionLoggerError = true
@@ -55,6 +74,16 @@ var (
 		StatusBadRequest,
 		StatusNetworkAuthenticationRequired,
 	}
+	// DefaultClientRecordRedactHeaders defines the headers redacted by
+	// [NewClientHookRecorder].
+	DefaultClientRecordRedactHeaders = []string{
+		HeaderAuthorization,
+		HeaderProxyAuthorization,
+		HeaderCookie,
+		HeaderSetCookie,
+	}
+	// DefaultClientRecordRedactValue defines the value of the redacted header.
+	DefaultClientRecordRedactValue = "REDACTED"
 	// DefaultClientTimeout defines the default client timeout.
 	DefaultClientTimeout = 30 * time.Second
 	// DefaultClinetHopHeaders defines Hop to Hop Header, not used.
@@ -89,6 +118,12 @@ var (
 		3200 * time.Millisecond, 800 * time.Millisecond,
 		6400 * time.Millisecond, 1600 * time.Millisecond,
 	}
+	// DefaultCodecCBORTags defines the struct tags used by [MarshalCBOR]
+	// and [UnmarshalCBOR] to get the field name.
+	DefaultCodecCBORTags = []string{"cbor", "json"}
+	// DefaultCodecMsgpackTags defines the struct tags used by
+	// [MarshalMsgpack] and [UnmarshalMsgpack] to get the field name.
+	DefaultCodecMsgpackTags = []string{"msgpack", "json"}
 	// DefaultConfigAllParseFunc defines the all parse used by [NewConfig].
 	DefaultConfigAllParseFunc = []ConfigParseFunc{
 		NewConfigParseEnvFile(),
@@ -142,15 +177,21 @@ var (
 		MimeApplicationForm:        HandlerDataBindForm,
 		MimeMultipartForm:          HandlerDataBindForm,
 		MimeApplicationXML:         HandlerDataBindXML,
+		MimeApplicationProtobuf:    HandlerDataBindProtobuf,
+		MimeApplicationMsgpack:     HandlerDataBindMsgpack,
+		MimeApplicationCBOR:        HandlerDataBindCBOR,
 	}
 	// DefaultHandlerDataRenders defines all [HandlerDataFuncs] processed
 	// by [NewHandlerDataRenders].
 	DefaultHandlerDataRenders = map[string]HandlerDataFunc{
-		MimeAll:             HandlerDataRenderJSON,
-		MimeText:            HandlerDataRenderText,
-		MimeTextPlain:       HandlerDataRenderText,
-		MimeTextHTML:        NewHandlerDataRenderTemplates(nil, nil),
-		MimeApplicationJSON: HandlerDataRenderJSON,
+		MimeAll:                 HandlerDataRenderJSON,
+		MimeText:                HandlerDataRenderText,
+		MimeTextPlain:           HandlerDataRenderText,
+		MimeTextHTML:            NewHandlerDataRenderTemplates(nil, nil),
+		MimeApplicationJSON:     HandlerDataRenderJSON,
+		MimeApplicationProtobuf: HandlerDataRenderProtobuf,
+		MimeApplicationMsgpack:  HandlerDataRenderMsgpack,
+		MimeApplicationCBOR:     HandlerDataRenderCBOR,
 	}
 	// DefaultHandlerDataRenderTemplateAppend defines the non-existent template
 	// to be append when Render the template.
@@ -210,6 +251,7 @@ var (
 		NewHandlerHTTPFunc1,
 		NewHandlerHTTPFunc2,
 		NewHandlerHTTPHandler,
+		NewHandlerWebsocket,
 		NewHandlerFileEmbed,
 		NewHandlerFileIOFS,
 		NewHandlerFileSystem,
@@ -238,6 +280,10 @@ var (
 	DefaultLoggerFormatterKeyMessage = "message"
 	// DefaultLoggerFormatterKeyTime defines the Time field output name.
 	DefaultLoggerFormatterKeyTime = "time"
+	// DefaultLoggerFormatterResource defines the OTLP resource attributes.
+	DefaultLoggerFormatterResource = map[string]string{
+		"service.name": filepath.Base(os.Args[0]),
+	}
 	// DefaultLoggerHookFatal defines whether HookFatal is enabled by default.
 	DefaultLoggerHookFatal = false
 	// DefaultLoggerLevelStrings global defines the log level output strings.
@@ -267,6 +313,16 @@ var (
 	DefaultLoggerPriorityWriterAsync  = 80
 	DefaultLoggerPriorityWriterStdout = 90
 	DefaultLoggerPriorityWriterFile   = 100
+	// DefaultMetricsBuckets defines the histogram buckets of the request
+	// duration seconds.
+	DefaultMetricsBuckets = []float64{
+		0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10,
+	}
+	// DefaultMetricsSizeBuckets defines the histogram buckets of the body
+	// size bytes.
+	DefaultMetricsSizeBuckets = []float64{
+		100, 1000, 10000, 100000, 1000000, 10000000,
+	}
 	// DefaultRouterAllMethod defines all methods that the router is allowed.
 	//
 	// Used global in [ControllerInjectAutoRoute].
@@ -318,6 +374,15 @@ var (
 	}
 	// DefaultValueTimeLocation global defines the timezone used for parsing times.
 	DefaultValueTimeLocation = time.Local //nolint:gosmopolitan
+	// DefaultWebsocketCloseTimeout global defines the write timeout of
+	// [WebsocketConn] control frames.
+	DefaultWebsocketCloseTimeout = 5 * time.Second
+	// DefaultWebsocketFrameSize global defines the max payload length of
+	// frames written by [WebsocketConn].
+	DefaultWebsocketFrameSize = 64 << 10
+	// DefaultWebsocketReadLimit global defines the max length of messages
+	// read by [WebsocketConn].
+	DefaultWebsocketReadLimit int64 = 16 << 20
 	// DefaultDaemonPidfile defines the default pid file
 	// used by [daemon.Command].
 	DefaultDaemonPidfile = "/var/run/eudore.pid"
@@ -327,6 +392,8 @@ var (
 	ErrLoggerLevelUnmarshalText = "LoggerLevel: UnmarshalText invalid data: %s"
 	ErrLoggerInitUnmounted      = errors.New("Logger: loggerInit has been Unmounted, please check the logger initialization order")
 
+	ErrMetricsRegisterConflict = "Metrics: metric %s has been registered as %s with labels %v"
+
 	ErrConfigParseDecoder = "Config: decoder %s parse file '%s' error: %w"
 	ErrConfigParseError   = "Config: parse func %v error: %v"
 
@@ -335,18 +402,50 @@ var (
 	ErrRouterAddHandlerMethodInvalid    = "Router: addHandler method '%s' is invalid, add fullpath: '%s'"
 	ErrRouterAddHandlerRecover          = "Router: addHandler method is '%s' and path is '%s', recover error: %v"
 	ErrRouterHandlerFuncsUnregisterType = "Router: newHandlerFuncs path is '%s', %dth handler parameter type is '%s', this is the unregistered handler type"
+	ErrRouterMuxConflictAmbiguous       = "routerCoreMux: route '%s %s' is ambiguous with '%s %s'"
+	ErrRouterMuxConflictOverwrite       = "routerCoreMux: route '%s %s' overwrites '%s %s'"
+	ErrRouterMuxConflictOverlap         = "routerCoreMux: route '%s %s' overlaps '%s %s' by param check"
+	ErrRouterMuxConflictShadowed        = "routerCoreMux: route '%s %s' is shadowed by '%s %s'"
 	ErrRouterMuxLoadInvalidFunc         = "routerCoreMux: load path '%s' is invalid, error: %w"
+	ErrRouterURLDuplicate               = "Router: URL name '%s' path '%s' is already used by path '%s'"
+	ErrRouterURLNotFound                = "Router: URL name '%s' not found"
+	ErrRouterURLParamInvalid            = "Router: URL name '%s' param '%s' value '%s' is invalid"
+	ErrRouterURLParamMissing            = "Router: URL name '%s' param '%s' is missing"
+
+	ErrClientBodyInvalidLines   = errors.New("ClientBody: json lines data is not chan, slice or iterator")
+	ErrClientBodyNotGetBody     = errors.New("ClientBody: cannot copy body")
+	ErrClientBreakerOpen        = errors.New("ClientBreaker: circuit breaker is open")
+	ErrClientBreakerRejected    = "ClientBreaker: key '%s' state is %s, retry after %s"
+	ErrClientOAuth2TokenInvalid = "ClientOAuth2: token endpoint %s response not has access_token"
+	ErrClientOAuth2TokenStatus  = "ClientOAuth2: token endpoint status is %d, error: %s"
+	ErrClientOptionInvalidType  = "ClientOption: invalid option type %T"
+	ErrClientCheckStatusError   = "Client: check %s %s status is %d not in %v"
+	ErrClientParseBodyError     = "Client: parse not suppert Content-Type: %s"
+	ErrClientParseEventInvalid  = "Client: parse event invalid data: %s"
+	ErrClientRecordNotFound     = "ClientRecorder: not found record %s %s"
+	ErrClientSignerInvalidKey   = "ClientSigner: invalid key type %T"
 
-	ErrClientBodyNotGetBody    = errors.New("ClientBody: cannot copy body")
-	ErrClientOptionInvalidType = "ClientOption: invalid option type %T"
-	ErrClientCheckStatusError  = "Client: check %s %s status is %d not in %v"
-	ErrClientParseBodyError    = "Client: parse not suppert Content-Type: %s"
-	ErrClientParseEventInvalid = "Client: parse event invalid data: %s"
+	ErrHTTPSignatureComponentMissing = "HTTPSignature: component %s not found"
+	ErrHTTPSignatureInputInvalid     = "HTTPSignature: invalid signature input %s"
+
+	ErrCodecDecodeType        = "Codec: cannot decode %s into Go value of type %s"
+	ErrCodecInvalidData       = "Codec: invalid data at offset %d"
+	ErrCodecMaxDepth          = "Codec: exceeded max depth %d"
+	ErrCodecProtobufInvalid   = errors.New("Codec: invalid protobuf data")
+	ErrCodecProtobufNotStruct = "Codec: protobuf value type %s must be a struct"
+	ErrCodecProtobufWireType  = "Codec: protobuf wire type %d cannot decode into type %s"
+	ErrCodecUnmarshalNotPtr   = "Codec: unmarshal value type %T must be a non-nil pointer"
+	ErrCodecUnsupportedType   = "Codec: unsupported type %s"
 
 	ErrContextParseFormNotSupportContentType = "Context: parse form not support Content-Type: %s"
 	ErrContextRedirectInvalid                = "Context: invalid redirect status code %d"
 	ErrContextNotHijacker                    = errors.New("ResponseWriter: http.Hijacker interface is not supported")
 
+	ErrWebsocketHandshakeInvalid = "Websocket: handshake invalid %s"
+	ErrWebsocketHandshakeOrigin  = errors.New("Websocket: handshake request origin not allowed")
+	ErrWebsocketHandshakeVersion = errors.New("Websocket: handshake unsupported Sec-WebSocket-Version")
+	ErrWebsocketMessageType      = "Websocket: invalid message type %d"
+
 	ErrHandlerDataBindNotSupportContentType = "HandlerData: bind not support Content-Type: %s"
 	ErrHandlerDataBindMustSturct            = "HandlerData: bind value type %s must be a struct"
 	ErrHandlerDataRenderTemplateNotFound    = "HandlerData: render not found template %s"
@@ -118,6 +118,7 @@ func NewHandlerFuncsCombine(hs1, hs2 HandlerFuncs) HandlerFuncs {
 var (
 	handlerFuncLocker   = sync.RWMutex{}
 	handlerFuncCreator  = make(map[uintptr]reflect.Type)
+	handlerFuncTypes    = make(map[uintptr]reflect.Type)
 	handlerNameFormater = strings.NewReplacer("*", "", "(", "", ")", "")
 	handlerParamsName   = "[...]"
 	handlerPackageName  = typeHandlerFunc.PkgPath() + "."
@@ -180,6 +181,23 @@ func (h HandlerFunc) String() string {
 	return handlerNameFormater.Replace(name) + ext
 }
 
+// setHandlerFuncType function records the func type converted by
+// [HandlerExtender], key is the closure address of [HandlerFunc].
+func setHandlerFuncType(h HandlerFunc, t reflect.Type) {
+	handlerFuncLocker.Lock()
+	handlerFuncTypes[*(*uintptr)(unsafe.Pointer(&h))] = t
+	handlerFuncLocker.Unlock()
+}
+
+// getHandlerFuncType function returns the original func type of
+// [HandlerFunc], or nil if it is not converted by [HandlerExtender].
+func getHandlerFuncType(h HandlerFunc) reflect.Type {
+	handlerFuncLocker.RLock()
+	t := handlerFuncTypes[*(*uintptr)(unsafe.Pointer(&h))]
+	handlerFuncLocker.RUnlock()
+	return t
+}
+
 // methodValue from src/reflect/makefunc.go .
 type methodValue struct {
 	_ [4]uintptr
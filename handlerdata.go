@@ -1,11 +1,15 @@
 package eudore
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"html/template"
+	"io"
 	"io/fs"
 	"net/http"
 	"os"
@@ -169,6 +173,33 @@ func HandlerDataBindXML(ctx Context, data any) error {
 	return xml.NewDecoder(ctx).Decode(data)
 }
 
+// The HandlerDataBindProtobuf function uses [UnmarshalProtobuf] to Bind data.
+func HandlerDataBindProtobuf(ctx Context, data any) error {
+	body, err := io.ReadAll(ctx)
+	if err != nil {
+		return err
+	}
+	return UnmarshalProtobuf(body, data)
+}
+
+// The HandlerDataBindMsgpack function uses [UnmarshalMsgpack] to Bind data.
+func HandlerDataBindMsgpack(ctx Context, data any) error {
+	body, err := io.ReadAll(ctx)
+	if err != nil {
+		return err
+	}
+	return UnmarshalMsgpack(body, data)
+}
+
+// The HandlerDataBindCBOR function uses [UnmarshalCBOR] to Bind data.
+func HandlerDataBindCBOR(ctx Context, data any) error {
+	body, err := io.ReadAll(ctx)
+	if err != nil {
+		return err
+	}
+	return UnmarshalCBOR(body, data)
+}
+
 // The NewHandlerDataRenders method uses [HeaderAccept] to matching for
 // Render functions in renders.
 // [DefaultHandlerDataRenders] is used by default.
@@ -211,6 +242,133 @@ func NewHandlerDataRenders(renders map[string]HandlerDataFunc) HandlerDataFunc {
 	}
 }
 
+// The NewHandlerDataRenderETag function creates Render that buffers the
+// response body and generates [HeaderETag],
+// return [StatusNotModified] if the request [HeaderIfNoneMatch] or
+// [HeaderIfModifiedSince] matches.
+//
+// Only GET and HEAD requests with [StatusOK] are processed,
+// if cacheControl is not empty, set it to [HeaderCacheControl] by default.
+//
+//	app.SetValue(ContextKeyRender, NewHandlerDataRenderETag(
+//		NewHandlerDataRenders(nil), true, "no-cache",
+//	))
+func NewHandlerDataRenderETag(render HandlerDataFunc, weak bool,
+	cacheControl string,
+) HandlerDataFunc {
+	if render == nil {
+		render = NewHandlerDataRenders(nil)
+	}
+	return func(ctx Context, data any) error {
+		w := ctx.Response()
+		method := ctx.Method()
+		if (method != MethodGet && method != MethodHead) ||
+			w.Status() != StatusOK || w.Size() > 0 {
+			return render(ctx, data)
+		}
+
+		etag := &responseWriterETag{ResponseWriter: w}
+		ctx.SetResponse(etag)
+		err := render(ctx, data)
+		ctx.SetResponse(w)
+		body := etag.buf.Bytes()
+		if len(body) == 0 {
+			return err
+		}
+
+		h := w.Header()
+		if err == nil && w.Status() == StatusOK {
+			if h.Get(HeaderETag) == "" {
+				h.Set(HeaderETag, NewETag(body, weak))
+			}
+			if cacheControl != "" && h.Get(HeaderCacheControl) == "" {
+				h.Set(HeaderCacheControl, cacheControl)
+			}
+			if CheckNotModified(ctx.Request(), h) {
+				h.Del(HeaderContentType)
+				w.WriteHeader(StatusNotModified)
+				return nil
+			}
+		}
+		_, err2 := w.Write(body)
+		if err == nil {
+			err = err2
+		}
+		return err
+	}
+}
+
+// The NewETag function generates [HeaderETag] using the sha256 of body.
+func NewETag(body []byte, weak bool) string {
+	sum := sha256.Sum256(body)
+	if weak {
+		return `W/"` + hex.EncodeToString(sum[:8]) + `"`
+	}
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// The CheckNotModified function checks whether the conditional request of
+// GET and HEAD matches the response [HeaderETag] or [HeaderLastModified].
+//
+// If [HeaderIfNoneMatch] exists, [HeaderIfModifiedSince] is ignored,
+// refer RFC 9110 Section 13.2.2.
+func CheckNotModified(r *http.Request, h http.Header) bool {
+	if r.Method != MethodGet && r.Method != MethodHead {
+		return false
+	}
+	if match := r.Header.Get(HeaderIfNoneMatch); match != "" {
+		etag := strings.TrimPrefix(h.Get(HeaderETag), "W/")
+		if etag == "" {
+			return false
+		}
+		for _, val := range strings.Split(match, ",") {
+			val = strings.TrimSpace(val)
+			if val == "*" || strings.TrimPrefix(val, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get(HeaderIfModifiedSince))
+	if err != nil {
+		return false
+	}
+	modtime, err := http.ParseTime(h.Get(HeaderLastModified))
+	return err == nil && !modtime.After(since)
+}
+
+// responseWriterETag defines the response body buffer of ETag Render.
+type responseWriterETag struct {
+	ResponseWriter
+	buf bytes.Buffer
+}
+
+func (w *responseWriterETag) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriterETag) Write(data []byte) (int, error) {
+	return w.buf.Write(data)
+}
+
+func (w *responseWriterETag) WriteString(data string) (int, error) {
+	return w.buf.WriteString(data)
+}
+
+// The WriteHeader method only records the status code,
+// which is written with the body.
+func (w *responseWriterETag) WriteHeader(code int) {
+	w.ResponseWriter.WriteStatus(code)
+}
+
+// The Flush method is not supported.
+func (w *responseWriterETag) Flush() {}
+
+func (w *responseWriterETag) Size() int {
+	return w.buf.Len()
+}
+
 func renderSetContentType(ctx Context, mime string) {
 	h := ctx.Response().Header()
 	if val := h.Get(HeaderContentType); len(val) == 0 {
@@ -257,6 +415,52 @@ func HandlerDataRenderJSON(ctx Context, data any) error {
 	return encoder.Encode(data)
 }
 
+// The HandlerDataRenderProtobuf function uses [MarshalProtobuf] to Render
+// data.
+//
+// If data is not a struct, use [NewContextMessgae] to wrap it.
+func HandlerDataRenderProtobuf(ctx Context, data any) error {
+	if reflect.Indirect(reflect.ValueOf(data)).Kind() != reflect.Struct {
+		data = NewContextMessgae(ctx, nil, data)
+	}
+	return renderCodecData(ctx, data, MimeApplicationProtobuf, MarshalProtobuf)
+}
+
+// The HandlerDataRenderMsgpack function uses [MarshalMsgpack] to Render data.
+//
+// If data is not a struct, map, slice or array, use [NewContextMessgae] to
+// wrap it.
+func HandlerDataRenderMsgpack(ctx Context, data any) error {
+	return renderCodecData(ctx, data, MimeApplicationMsgpack, MarshalMsgpack)
+}
+
+// The HandlerDataRenderCBOR function uses [MarshalCBOR] to Render data.
+//
+// If data is not a struct, map, slice or array, use [NewContextMessgae] to
+// wrap it.
+func HandlerDataRenderCBOR(ctx Context, data any) error {
+	return renderCodecData(ctx, data, MimeApplicationCBOR, MarshalCBOR)
+}
+
+// The renderCodecData function sets [HeaderContentType] after the data is
+// encoded successfully, so [NewHandlerDataRenders] can try the next Render.
+func renderCodecData(ctx Context, data any, mime string,
+	marshal func(any) ([]byte, error),
+) error {
+	switch reflect.Indirect(reflect.ValueOf(data)).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+	default:
+		data = NewContextMessgae(ctx, nil, data)
+	}
+	body, err := marshal(data)
+	if err != nil {
+		return err
+	}
+	renderSetContentType(ctx, mime)
+	_, err = ctx.Write(body)
+	return err
+}
+
 // The HandlerDataRenderHTML function creates Render using [template.Template].
 //
 // patterns will load templates from both [template.ParseFS] and
@@ -368,21 +572,7 @@ func renderTemplatesData(ctx Context, data any,
 ) error {
 	name := ctx.GetParam(ParamTemplate)
 	if name == "" {
-		var err error
-		switch v := data.(type) {
-		case []byte:
-			renderSetContentType(ctx, MimeTextPlainCharsetUtf8)
-			_, err = ctx.Write(v)
-		case string:
-			renderSetContentType(ctx, MimeTextPlainCharsetUtf8)
-			_, err = ctx.WriteString(v)
-		case fmt.Stringer:
-			renderSetContentType(ctx, MimeTextPlainCharsetUtf8)
-			_, err = ctx.WriteString(v.String())
-		default:
-			return ErrHandlerDataRenderTemplateNeedName
-		}
-		return err
+		return renderTemplatesText(ctx, data)
 	}
 
 	t := temp.Lookup(name)
@@ -390,11 +580,33 @@ func renderTemplatesData(ctx Context, data any,
 		return fmt.Errorf(ErrHandlerDataRenderTemplateNotFound, name)
 	}
 
+	renderTemplatesHeader(ctx, hr)
+	return t.Execute(ctx, data)
+}
+
+func renderTemplatesText(ctx Context, data any) error {
+	var err error
+	switch v := data.(type) {
+	case []byte:
+		renderSetContentType(ctx, MimeTextPlainCharsetUtf8)
+		_, err = ctx.Write(v)
+	case string:
+		renderSetContentType(ctx, MimeTextPlainCharsetUtf8)
+		_, err = ctx.WriteString(v)
+	case fmt.Stringer:
+		renderSetContentType(ctx, MimeTextPlainCharsetUtf8)
+		_, err = ctx.WriteString(v.String())
+	default:
+		return ErrHandlerDataRenderTemplateNeedName
+	}
+	return err
+}
+
+func renderTemplatesHeader(ctx Context, hr http.Header) {
 	hw := ctx.Response().Header()
 	for k, v := range hr {
 		if hw.Values(k) == nil {
 			hw[k] = v
 		}
 	}
-	return t.Execute(ctx, data)
 }
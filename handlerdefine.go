@@ -285,3 +285,19 @@ func NewHandlerHTTPHandler(h http.Handler) HandlerFunc {
 		h.ServeHTTP(ctx.Response(), ctx.Request())
 	}
 }
+
+// NewHandlerWebsocket function converts func(Context, *WebsocketConn),
+// uses [NewWebsocketConn] to upgrade the request and closes the
+// [WebsocketConn] after the function returns.
+func NewHandlerWebsocket(fn func(Context, *WebsocketConn)) HandlerFunc {
+	name := getCallerName(fn)
+	return func(ctx Context) {
+		conn, err := NewWebsocketConn(ctx, nil)
+		if err != nil {
+			ctx.WithField(FieldCaller, name).Fatal(err)
+			return
+		}
+		defer conn.Close()
+		fn(ctx, conn)
+	}
+}
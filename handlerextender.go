@@ -203,6 +203,9 @@ func (he *handlerExtenderBase) exec(p string, fn, v reflect.Value) HandlerFunc {
 		handlerFuncCreator[ptr] = fn.Type().In(0)
 		handlerFuncLocker.Unlock()
 	}
+	if v.Kind() == reflect.Func {
+		setHandlerFuncType(h, v.Type())
+	}
 	return h
 }
 
@@ -0,0 +1,276 @@
+package eudore
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"text/template/parse"
+	"time"
+)
+
+// TemplateEngine defines the template loading rules of
+// [NewHandlerDataRenderTemplateEngine].
+//
+// Layouts, Partials and Pages are directories of FS,
+// the template name is the file path relative to the directory,
+// e.g. "user/list.html".
+type TemplateEngine struct {
+	// FS defines the file system of templates, the default is [os.DirFS] ".".
+	FS fs.FS `alias:"fs" json:"-" xml:"-" yaml:"-"`
+	// Layouts defines the directories of layout templates,
+	// the layout defines the page skeleton and uses block as default content.
+	Layouts []string `alias:"layouts" json:"layouts" xml:"layouts" yaml:"layouts"`
+	// Partials defines the directories of partial templates,
+	// layouts and pages call them using the template action.
+	Partials []string `alias:"partials" json:"partials" xml:"partials" yaml:"partials"`
+	// Pages defines the directories of page templates,
+	// each page is parsed with its own copy of layouts and partials,
+	// the blocks defined by a page do not affect other pages.
+	Pages []string `alias:"pages" json:"pages" xml:"pages" yaml:"pages"`
+	// Layout defines the default layout used by the page that only defines
+	// blocks, the route param [ParamLayout] overrides it.
+	Layout string `alias:"layout" json:"layout" xml:"layout" yaml:"layout"`
+	// Reload defines checking the modification time of template files for
+	// each request and reparse them when changed, used in development.
+	//
+	// If Reload is false, the templates are parsed only once.
+	Reload bool `alias:"reload" json:"reload" xml:"reload" yaml:"reload"`
+	// Funcs defines the template functions,
+	// which override the functions created by [NewTemplateFuncs].
+	Funcs template.FuncMap `alias:"funcs" json:"-" xml:"-" yaml:"-"`
+}
+
+type templateEngine struct {
+	*TemplateEngine
+	funcs   template.FuncMap
+	headers http.Header
+	mu      sync.RWMutex
+	pages   map[string]*templatePage
+	files   map[string]time.Time
+}
+
+type templatePage struct {
+	*template.Template
+	// the page only defines blocks and executes the layout.
+	Blocks bool
+}
+
+// NewHandlerDataRenderTemplateEngine function creates Render using
+// [TemplateEngine].
+//
+// The route param [ParamTemplate] selects the page,
+// if the page only defines blocks, executes the layout selected by the
+// route param [ParamLayout] or [TemplateEngine.Layout].
+//
+// The template functions are created by [NewTemplateFuncs] using the
+// [ContextKeyFuncCreator] of ctx.
+//
+// When returning an HTML response,
+// append [DefaultHandlerDataRenderTemplateHeaders].
+func NewHandlerDataRenderTemplateEngine(ctx context.Context,
+	engine *TemplateEngine,
+) HandlerDataFunc {
+	if engine.FS == nil {
+		engine.FS = os.DirFS(".")
+	}
+	funcs := NewTemplateFuncs(NewFuncCreatorWithContext(ctx))
+	for k, v := range engine.Funcs {
+		funcs[k] = v
+	}
+	e := &templateEngine{
+		TemplateEngine: engine,
+		funcs:          funcs,
+		headers:        templateHeaders(),
+	}
+
+	pages, files, err := e.load()
+	if err != nil && !engine.Reload {
+		return func(ctx Context, _ any) error {
+			return renderTemplatesError(ctx, err)
+		}
+	}
+	e.pages, e.files = pages, files
+	return e.render
+}
+
+// NewTemplateFuncs function creates [template.FuncMap] using [FuncCreator].
+//
+// All [FuncCreateSetString] functions are added by name,
+// e.g. {{hide .Phone}} {{tolower .Name}},
+// the functions created with arguments and the builtin functions of
+// template are not added.
+//
+// The function funccreator creates the function using the fullname,
+// e.g. {{funccreator "trim=-" .Name}}.
+func NewTemplateFuncs(fc FuncCreator) template.FuncMap {
+	funcs := template.FuncMap{
+		"funccreator": func(name, val string) (string, error) {
+			fn, err := fc.CreateFunc(FuncCreateSetString, name)
+			if err != nil {
+				return "", err
+			}
+			return fn.(func(string) string)(val), nil
+		},
+	}
+	builtins := " and call html index slice js len not or print printf " +
+		"println urlquery eq ge gt le lt ne "
+	for _, item := range fc.List() {
+		name, kind, _ := strings.Cut(item, ": ")
+		_, arg := getFuncNameArg(name)
+		if kind != "func(string) string" || arg != "" ||
+			strings.Contains(builtins, " "+name+" ") {
+			continue
+		}
+		fn, err := fc.CreateFunc(FuncCreateSetString, name)
+		if err == nil {
+			funcs[name] = fn
+		}
+	}
+	return funcs
+}
+
+func (e *templateEngine) render(ctx Context, data any) error {
+	name := ctx.GetParam(ParamTemplate)
+	if name == "" {
+		return renderTemplatesText(ctx, data)
+	}
+	if e.Reload {
+		err := e.reload()
+		if err != nil {
+			return renderTemplatesError(ctx, err)
+		}
+	}
+
+	e.mu.RLock()
+	page := e.pages[name]
+	e.mu.RUnlock()
+	if page == nil {
+		return fmt.Errorf(ErrHandlerDataRenderTemplateNotFound, name)
+	}
+
+	t := page.Template
+	if page.Blocks {
+		name = GetAnyDefault(ctx.GetParam(ParamLayout), e.Layout)
+		t = t.Lookup(name)
+		if name == "" || t == nil {
+			return fmt.Errorf(ErrHandlerDataRenderTemplateNotFound, name)
+		}
+	}
+
+	renderTemplatesHeader(ctx, e.headers)
+	return t.Execute(ctx, data)
+}
+
+// The reload method reparses templates if any file is added, removed or
+// modified.
+func (e *templateEngine) reload() error {
+	files := make(map[string]time.Time, len(e.files))
+	for _, dirs := range [][]string{e.Layouts, e.Partials, e.Pages} {
+		for _, dir := range dirs {
+			err := e.walk(dir, files, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	e.mu.RLock()
+	changed := len(files) != len(e.files) || e.pages == nil
+	for k, v := range files {
+		if !e.files[k].Equal(v) {
+			changed = true
+			break
+		}
+	}
+	e.mu.RUnlock()
+	if !changed {
+		return nil
+	}
+
+	pages, files, err := e.load()
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	e.pages, e.files = pages, files
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *templateEngine) load() (map[string]*templatePage,
+	map[string]time.Time, error,
+) {
+	files := make(map[string]time.Time)
+	base := template.New("").Funcs(e.funcs)
+	for _, dir := range append(e.Layouts[:len(e.Layouts):len(e.Layouts)],
+		e.Partials...) {
+		err := e.walk(dir, files, func(name string, data []byte) error {
+			_, err := base.New(name).Parse(string(data))
+			return err
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, t := range DefaultHandlerDataRenderTemplateAppend.Templates() {
+		if base.Lookup(t.Name()) == nil {
+			_, _ = base.AddParseTree(t.Name(), t.Tree)
+		}
+	}
+
+	pages := make(map[string]*templatePage)
+	for _, dir := range e.Pages {
+		err := e.walk(dir, files, func(name string, data []byte) error {
+			t, err := base.Clone()
+			if err != nil {
+				return err
+			}
+			t, err = t.New(name).Parse(string(data))
+			if err != nil {
+				return err
+			}
+			pages[name] = &templatePage{t, parse.IsEmptyTree(t.Tree.Root)}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return pages, files, nil
+}
+
+// The walk method saves the modification time of all files in dir,
+// and calls fn using the file name relative to dir if fn is not nil.
+func (e *templateEngine) walk(dir string, files map[string]time.Time,
+	fn func(string, []byte) error,
+) error {
+	dir = path.Clean(dir)
+	return fs.WalkDir(e.FS, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files[p] = info.ModTime()
+		if fn == nil {
+			return nil
+		}
+
+		data, err := fs.ReadFile(e.FS, p)
+		if err != nil {
+			return err
+		}
+		if dir != "." {
+			p = strings.TrimPrefix(p, dir+"/")
+		}
+		return fn(p, data)
+	})
+}
@@ -90,8 +90,9 @@ type LoggerHandler interface {
 // LoggerConfig defines [NewLogger] configuration,
 // initializes [Logger] and creates default [LoggerHandler].
 //
-// If Formatter is json/text, use [NewLoggerFormatterJSON] or
-// [NewLoggerFormatterText].
+// If Formatter is json/text/logfmt/otlp, use [NewLoggerFormatterJSON],
+// [NewLoggerFormatterText], [NewLoggerFormatterLogfmt] or
+// [NewLoggerFormatterOTLP].
 //
 // If AsyncSize is greater than 0, use [NewLoggerWriterAsync].
 //
@@ -198,6 +199,10 @@ func (c *LoggerConfig) getFormatter() []LoggerHandler {
 		return []LoggerHandler{NewLoggerFormatterJSON(c.TimeFormat)}
 	case "text":
 		return []LoggerHandler{NewLoggerFormatterText(c.TimeFormat)}
+	case "logfmt":
+		return []LoggerHandler{NewLoggerFormatterLogfmt(c.TimeFormat)}
+	case "otlp":
+		return []LoggerHandler{NewLoggerFormatterOTLP(nil)}
 	default:
 		return []LoggerHandler{}
 	}
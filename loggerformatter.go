@@ -4,7 +4,9 @@ import (
 	"encoding"
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
 	"unicode/utf8"
@@ -149,6 +151,296 @@ func (h *loggerFormatterJSON) HandlerEntry(entry *LoggerEntry) {
 	entry.Buffer = en.data
 }
 
+type loggerFormatterLogfmt struct {
+	TimeFormat string
+}
+
+// The NewLoggerFormatterLogfmt function creates [LoggerHandler] to implement
+// logfmt formatted logging.
+//
+// Format: time=Time level=Level message=Message key=value.
+//
+// The value is quoted when it contains space, '=', '"' or
+// control characters, and the complex value uses Text format.
+func NewLoggerFormatterLogfmt(timeformat string) LoggerHandler {
+	return &loggerFormatterLogfmt{
+		TimeFormat: timeformat,
+	}
+}
+
+func (h *loggerFormatterLogfmt) HandlerPriority() int {
+	return DefaultLoggerPriorityFormatter
+}
+
+func (h *loggerFormatterLogfmt) HandlerEntry(entry *LoggerEntry) {
+	en := &loggerEncoder{
+		data: entry.Buffer,
+	}
+	en.WriteString(DefaultLoggerFormatterKeyTime)
+	en.WriteBytes('=')
+	pos := len(en.data)
+	en.data = entry.Time.AppendFormat(en.data, h.TimeFormat)
+	en.quoteLogfmt(pos)
+	en.WriteBytes(' ')
+	en.WriteString(DefaultLoggerFormatterKeyLevel)
+	en.WriteBytes('=')
+	en.data = append(en.data, loggerLevelDefaultBytes[entry.Level]...)
+	if entry.Message != "" {
+		en.WriteBytes(' ')
+		en.WriteString(DefaultLoggerFormatterKeyMessage)
+		en.WriteBytes('=')
+		en.formatLogfmt(entry.Message)
+	}
+
+	for i := range entry.Keys {
+		en.WriteBytes(' ')
+		en.WriteString(entry.Keys[i])
+		en.WriteBytes('=')
+		str, ok := getLoggerString(entry.Vals[i])
+		if ok {
+			en.formatLogfmt(str)
+		} else {
+			pos := len(en.data)
+			en.formatText(reflect.ValueOf(entry.Vals[i]))
+			en.quoteLogfmt(pos)
+		}
+	}
+	en.data = append(en.data, '\r', '\n')
+	entry.Buffer = en.data
+}
+
+// The getLoggerString function returns the string of string, error and
+// [fmt.Stringer] values.
+func getLoggerString(val any) (string, bool) {
+	switch v := val.(type) {
+	case string:
+		return v, true
+	case error, fmt.Stringer:
+		rv := reflect.ValueOf(v)
+		if tableEncodeTypePtr[rv.Kind()] && rv.IsNil() {
+			return "", false
+		}
+		if err, ok := v.(error); ok {
+			return err.Error(), true
+		}
+		return v.(fmt.Stringer).String(), true
+	}
+	return "", false
+}
+
+// The formatLogfmt method writes the logfmt value of s.
+func (en *loggerEncoder) formatLogfmt(s string) {
+	if !loggerNeedQuote(s) {
+		en.WriteString(s)
+		return
+	}
+	en.WriteBytes('"')
+	en.formatString(s)
+	en.WriteBytes('"')
+}
+
+// The quoteLogfmt method quotes the value written after pos if needed.
+func (en *loggerEncoder) quoteLogfmt(pos int) {
+	val := en.data[pos:]
+	if !loggerNeedQuote(*(*string)(unsafe.Pointer(&val))) {
+		return
+	}
+	s := string(val)
+	en.data = en.data[:pos]
+	en.formatLogfmt(s)
+}
+
+func loggerNeedQuote(s string) bool {
+	if s == "" {
+		return true
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] <= ' ' || s[i] == '=' || s[i] == '"' || s[i] == '\\' ||
+			s[i] == 0x7f {
+			return true
+		}
+	}
+	return !utf8.ValidString(s)
+}
+
+type loggerFormatterOTLP struct {
+	Prefix []byte
+}
+
+// The NewLoggerFormatterOTLP function creates [LoggerHandler] to implement
+// OpenTelemetry OTLP/JSON formatted logging,
+// each line is an ExportLogsServiceRequest with one LogRecord.
+//
+// The Level is converted to severityNumber DEBUG=5 INFO=9 WARN=13 ERROR=17
+// FATAL=21, Message is the body,
+// and the fields are attributes.
+// The field [FieldXTraceID] value is a 32 hex trace id or W3C traceparent,
+// converted to traceId and spanId,
+// and the fields [FieldTraceID] [FieldSpanID] are also converted.
+//
+// The resource defines the resource attributes,
+// if resource is nil, use [DefaultLoggerFormatterResource].
+func NewLoggerFormatterOTLP(resource map[string]string) LoggerHandler {
+	if resource == nil {
+		resource = DefaultLoggerFormatterResource
+	}
+	keys := make([]string, 0, len(resource))
+	for key := range resource {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	en := &loggerEncoder{}
+	en.WriteString(`{"resourceLogs":[{"resource":{"attributes":[`)
+	for i, key := range keys {
+		if i > 0 {
+			en.WriteBytes(',')
+		}
+		en.WriteString(`{"key":"`)
+		en.formatString(key)
+		en.WriteString(`","value":{"stringValue":"`)
+		en.formatString(resource[key])
+		en.WriteString(`"}}`)
+	}
+	en.WriteString(`]},"scopeLogs":[{"scope":{"name":"eudore"},"logRecords":[{`)
+	return &loggerFormatterOTLP{
+		Prefix: en.data,
+	}
+}
+
+func (h *loggerFormatterOTLP) HandlerPriority() int {
+	return DefaultLoggerPriorityFormatter
+}
+
+func (h *loggerFormatterOTLP) HandlerEntry(entry *LoggerEntry) {
+	en := &loggerEncoder{
+		data: entry.Buffer,
+	}
+	en.data = append(en.data, h.Prefix...)
+	en.WriteString(`"timeUnixNano":"`)
+	en.data = strconv.AppendInt(en.data, entry.Time.UnixNano(), 10)
+	en.WriteString(`","severityNumber":`)
+	en.data = strconv.AppendInt(en.data, int64(entry.Level)*4+5, 10)
+	en.WriteString(`,"severityText":"`)
+	en.data = append(en.data, loggerLevelDefaultBytes[entry.Level]...)
+	en.WriteString(`","body":{"stringValue":"`)
+	en.formatString(entry.Message)
+	en.WriteString(`"},"attributes":[`)
+
+	var traceid, spanid string
+	pos := len(en.data)
+	for i := range entry.Keys {
+		str, _ := entry.Vals[i].(string)
+		switch {
+		case entry.Keys[i] == FieldXTraceID && traceid == "":
+			traceid, spanid = getLoggerTraceID(str)
+			if traceid != "" {
+				continue
+			}
+		case entry.Keys[i] == FieldTraceID && len(str) == 32 && isLowerHex(str):
+			traceid = str
+			continue
+		case entry.Keys[i] == FieldSpanID && len(str) == 16 && isLowerHex(str):
+			spanid = str
+			continue
+		}
+		en.WriteString(`{"key":"`)
+		en.formatString(entry.Keys[i])
+		en.WriteString(`","value":`)
+		en.formatOTLP(entry.Vals[i])
+		en.WriteString(`},`)
+	}
+	if pos < len(en.data) {
+		en.data = en.data[:len(en.data)-1]
+	}
+	en.WriteBytes(']')
+	if traceid != "" {
+		en.WriteString(`,"traceId":"`)
+		en.WriteString(traceid)
+		en.WriteBytes('"')
+	}
+	if spanid != "" {
+		en.WriteString(`,"spanId":"`)
+		en.WriteString(spanid)
+		en.WriteBytes('"')
+	}
+	en.WriteString("}]}]}]}\r\n")
+	entry.Buffer = en.data
+}
+
+// The formatOTLP method writes the OTLP AnyValue of val,
+// the complex value is converted to JSON string.
+func (en *loggerEncoder) formatOTLP(val any) {
+	v := reflect.ValueOf(val)
+	if v.IsValid() && v.Type().NumMethod() == 0 {
+		switch v.Kind() {
+		case reflect.Bool:
+			en.WriteString(`{"boolValue":`)
+			en.data = strconv.AppendBool(en.data, v.Bool())
+			en.WriteBytes('}')
+			return
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			en.WriteString(`{"intValue":"`)
+			en.data = strconv.AppendInt(en.data, v.Int(), 10)
+			en.WriteString(`"}`)
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			en.WriteString(`{"intValue":"`)
+			en.data = strconv.AppendUint(en.data, v.Uint(), 10)
+			en.WriteString(`"}`)
+			return
+		case reflect.Float32, reflect.Float64:
+			// proto3 JSON writes non-finite double as string.
+			en.WriteString(`{"doubleValue":`)
+			switch f := v.Float(); {
+			case math.IsNaN(f):
+				en.WriteString(`"NaN"`)
+			case math.IsInf(f, 1):
+				en.WriteString(`"Infinity"`)
+			case math.IsInf(f, -1):
+				en.WriteString(`"-Infinity"`)
+			default:
+				en.data = strconv.AppendFloat(en.data, f, 'g', -1, 64)
+			}
+			en.WriteBytes('}')
+			return
+		}
+	}
+
+	str, ok := getLoggerString(val)
+	if !ok {
+		pos := len(en.data)
+		en.formatJSON(v)
+		str = string(en.data[pos:])
+		en.data = en.data[:pos]
+	}
+	en.WriteString(`{"stringValue":"`)
+	en.formatString(str)
+	en.WriteString(`"}`)
+}
+
+// The getLoggerTraceID function parses the trace id and span id of
+// 32 hex trace id or W3C traceparent.
+func getLoggerTraceID(val string) (string, string) {
+	switch {
+	case len(val) == 32 && isLowerHex(val):
+		return val, ""
+	case len(val) == 55 && val[2] == '-' && val[35] == '-' && val[52] == '-' &&
+		isLowerHex(val[3:35]) && isLowerHex(val[36:52]):
+		return val[3:35], val[36:52]
+	}
+	return "", ""
+}
+
+func isLowerHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && (s[i] < 'a' || s[i] > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
 type loggerEncoder struct {
 	data     []byte
 	pointers []uintptr
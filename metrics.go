@@ -0,0 +1,410 @@
+package eudore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Define the kind of metric.
+const (
+	MetricsKindCounter   = "counter"
+	MetricsKindGauge     = "gauge"
+	MetricsKindHistogram = "histogram"
+)
+
+// Metrics defines the registry of counter, gauge and histogram with labels,
+// and encodes them in Prometheus text or OpenMetrics format.
+//
+// If the same name is registered again, returns the registered metric,
+// if the kind or labels are different, it will panic.
+//
+// The label values of the metric method are in the order of labels,
+// missing values are empty and extra values are ignored.
+type Metrics interface {
+	NewCounter(name, help string, labels ...string) MetricsCounter
+	NewGauge(name, help string, labels ...string) MetricsGauge
+	NewHistogram(name, help string, buckets []float64, labels ...string) MetricsHistogram
+	// The Encode method writes all metrics, the format is [MimeTextPlain]
+	// or [MimeApplicationOpenMetrics].
+	Encode(w io.Writer, format string) error
+}
+
+// MetricsCounter defines the counter that only increases.
+type MetricsCounter interface {
+	Inc(values ...string)
+	// The Add method ignores the negative val.
+	Add(val float64, values ...string)
+}
+
+// MetricsGauge defines the gauge that can increase and decrease.
+type MetricsGauge interface {
+	Inc(values ...string)
+	Dec(values ...string)
+	Add(val float64, values ...string)
+	Set(val float64, values ...string)
+}
+
+// MetricsHistogram defines the histogram that counts observations in
+// buckets.
+type MetricsHistogram interface {
+	Observe(val float64, values ...string)
+}
+
+type metricsStd struct {
+	sync.RWMutex
+	Context  context.Context
+	Families map[string]*metricsFamily
+}
+
+type metricsFamily struct {
+	sync.RWMutex
+	Name    string
+	Help    string
+	Kind    string
+	Labels  []string
+	Buckets []float64
+	Series  map[string]*metricsSeries
+}
+
+type metricsSeries struct {
+	sync.Mutex
+	Values  []string
+	Value   float64
+	Count   uint64
+	Buckets []uint64
+}
+
+// NewMetrics function creates the default [Metrics].
+//
+// If it is set to [ContextKeyMetrics] of [App], the Metadata of
+// [MetadataLogger] [MetadataServer] [MetadataClient] [MetadataEventHub] in
+// [ContextKeyAppValues] is exported when encoding.
+func NewMetrics() Metrics {
+	return &metricsStd{Context: context.Background()}
+}
+
+// The Mount method saves the [App] context used to get the Metadata.
+func (m *metricsStd) Mount(ctx context.Context) {
+	m.Lock()
+	m.Context = ctx
+	m.Unlock()
+}
+
+func (m *metricsStd) NewCounter(name, help string, labels ...string) MetricsCounter {
+	return m.register(name, help, MetricsKindCounter, nil, labels)
+}
+
+func (m *metricsStd) NewGauge(name, help string, labels ...string) MetricsGauge {
+	return m.register(name, help, MetricsKindGauge, nil, labels)
+}
+
+func (m *metricsStd) NewHistogram(name, help string, buckets []float64,
+	labels ...string,
+) MetricsHistogram {
+	if buckets == nil {
+		buckets = DefaultMetricsBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return m.register(name, help, MetricsKindHistogram, buckets, labels)
+}
+
+func (m *metricsStd) register(name, help, kind string, buckets []float64,
+	labels []string,
+) *metricsFamily {
+	m.Lock()
+	defer m.Unlock()
+	f, ok := m.Families[name]
+	if ok {
+		if f.Kind != kind || strings.Join(f.Labels, ",") != strings.Join(labels, ",") {
+			panic(fmt.Errorf(ErrMetricsRegisterConflict, name, f.Kind, f.Labels))
+		}
+		return f
+	}
+
+	if m.Families == nil {
+		m.Families = make(map[string]*metricsFamily)
+	}
+	f = newMetricsFamily(name, help, kind, buckets, labels...)
+	m.Families[name] = f
+	return f
+}
+
+func (m *metricsStd) Encode(w io.Writer, format string) error {
+	m.RLock()
+	ctx := m.Context
+	families := make([]*metricsFamily, 0, len(m.Families))
+	for _, f := range m.Families {
+		families = append(families, f)
+	}
+	m.RUnlock()
+	families = append(families, newMetricsMetadata(ctx)...)
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].Name < families[j].Name
+	})
+
+	openmetrics := strings.HasPrefix(format, MimeApplicationOpenMetrics)
+	buf := &bytes.Buffer{}
+	for _, f := range families {
+		f.encode(buf, openmetrics)
+	}
+	if openmetrics {
+		buf.WriteString("# EOF\n")
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func newMetricsFamily(name, help, kind string, buckets []float64,
+	labels ...string,
+) *metricsFamily {
+	return &metricsFamily{
+		Name:    name,
+		Help:    help,
+		Kind:    kind,
+		Labels:  labels,
+		Buckets: buckets,
+		Series:  make(map[string]*metricsSeries),
+	}
+}
+
+// The series method returns the series of label values, creating it if it
+// does not exist.
+func (f *metricsFamily) series(values []string) *metricsSeries {
+	if len(values) != len(f.Labels) {
+		vals := make([]string, len(f.Labels))
+		copy(vals, values)
+		values = vals
+	}
+	key := strings.Join(values, "\xff")
+	f.RLock()
+	s, ok := f.Series[key]
+	f.RUnlock()
+	if ok {
+		return s
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	s, ok = f.Series[key]
+	if !ok {
+		s = &metricsSeries{Values: append([]string(nil), values...)}
+		if f.Kind == MetricsKindHistogram {
+			s.Buckets = make([]uint64, len(f.Buckets)+1)
+		}
+		f.Series[key] = s
+	}
+	return s
+}
+
+func (f *metricsFamily) Inc(values ...string) {
+	f.Add(1, values...)
+}
+
+func (f *metricsFamily) Dec(values ...string) {
+	f.Add(-1, values...)
+}
+
+func (f *metricsFamily) Add(val float64, values ...string) {
+	if f.Kind == MetricsKindCounter && !(val >= 0) {
+		return
+	}
+	s := f.series(values)
+	s.Lock()
+	s.Value += val
+	s.Unlock()
+}
+
+func (f *metricsFamily) Set(val float64, values ...string) {
+	s := f.series(values)
+	s.Lock()
+	s.Value = val
+	s.Unlock()
+}
+
+func (f *metricsFamily) Observe(val float64, values ...string) {
+	s := f.series(values)
+	i := sort.SearchFloat64s(f.Buckets, val)
+	s.Lock()
+	s.Value += val
+	s.Count++
+	s.Buckets[i]++
+	s.Unlock()
+}
+
+// The encode method writes the family, the counter in OpenMetrics format
+// uses the name without the suffix '_total' as the family name.
+func (f *metricsFamily) encode(buf *bytes.Buffer, openmetrics bool) {
+	f.RLock()
+	series := make([]*metricsSeries, 0, len(f.Series))
+	for _, s := range f.Series {
+		series = append(series, s)
+	}
+	f.RUnlock()
+	if len(series) == 0 {
+		return
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return strings.Join(series[i].Values, "\xff") <
+			strings.Join(series[j].Values, "\xff")
+	})
+
+	name, sample := f.Name, f.Name
+	if openmetrics && f.Kind == MetricsKindCounter {
+		name = strings.TrimSuffix(f.Name, "_total")
+		sample = name + "_total"
+	}
+	if f.Help != "" {
+		fmt.Fprintf(buf, "# HELP %s %s\n", name, metricsHelpReplacer.Replace(f.Help))
+	}
+	fmt.Fprintf(buf, "# TYPE %s %s\n", name, f.Kind)
+	for _, s := range series {
+		s.Lock()
+		if f.Kind != MetricsKindHistogram {
+			f.encodeSample(buf, sample, s.Values, "", s.Value)
+			s.Unlock()
+			continue
+		}
+
+		var count uint64
+		for i, bucket := range f.Buckets {
+			count += s.Buckets[i]
+			f.encodeSample(buf, name+"_bucket", s.Values,
+				formatMetricsFloat(bucket), float64(count),
+			)
+		}
+		f.encodeSample(buf, name+"_bucket", s.Values, "+Inf", float64(s.Count))
+		f.encodeSample(buf, name+"_sum", s.Values, "", s.Value)
+		f.encodeSample(buf, name+"_count", s.Values, "", float64(s.Count))
+		s.Unlock()
+	}
+}
+
+func (f *metricsFamily) encodeSample(buf *bytes.Buffer, name string,
+	values []string, le string, val float64,
+) {
+	buf.WriteString(name)
+	if len(values) > 0 || le != "" {
+		buf.WriteByte('{')
+		for i := range values {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(f.Labels[i])
+			buf.WriteString(`="`)
+			buf.WriteString(metricsLabelReplacer.Replace(values[i]))
+			buf.WriteByte('"')
+		}
+		if le != "" {
+			if len(values) > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(`le="`)
+			buf.WriteString(le)
+			buf.WriteByte('"')
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(formatMetricsFloat(val))
+	buf.WriteByte('\n')
+}
+
+var (
+	metricsHelpReplacer  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	metricsLabelReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func formatMetricsFloat(val float64) string {
+	switch {
+	case math.IsInf(val, 1):
+		return "+Inf"
+	case math.IsInf(val, -1):
+		return "-Inf"
+	case math.IsNaN(val):
+		return "NaN"
+	}
+	return strconv.FormatFloat(val, 'g', -1, 64)
+}
+
+// The newMetricsMetadata function creates the families of the Metadata in
+// [ContextKeyAppValues], the label key is the name of the value key.
+func newMetricsMetadata(ctx context.Context) []*metricsFamily {
+	vals, _ := ctx.Value(ContextKeyAppValues).([]any)
+	families := make(map[string]*metricsFamily)
+	family := func(name, help, kind string, labels ...string) *metricsFamily {
+		f, ok := families[name]
+		if !ok {
+			f = newMetricsFamily(name, help, kind, nil, labels...)
+			families[name] = f
+		}
+		return f
+	}
+	health := func(key, name string, healthy bool) {
+		val := 0.0
+		if healthy {
+			val = 1
+		}
+		family("eudore_metadata_health", "Metadata health status.",
+			MetricsKindGauge, "key", "name",
+		).Set(val, key, name)
+	}
+
+	for i := 0; i+1 < len(vals); i += 2 {
+		key := fmt.Sprint(vals[i])
+		switch meta := anyMetadata(vals[i+1]).(type) {
+		case MetadataLogger:
+			health(key, meta.Name, meta.Health)
+			f := family("eudore_logger_entries_total", "Total number of log entries by level.",
+				MetricsKindCounter, "key", "level",
+			)
+			for level, count := range meta.Count {
+				f.Add(float64(count), key, DefaultLoggerLevelStrings[level])
+			}
+			family("eudore_logger_written_bytes_total", "Total bytes written by logger.",
+				MetricsKindCounter, "key",
+			).Add(float64(meta.Size), key)
+		case MetadataServer:
+			health(key, meta.Name, meta.Health)
+			family("eudore_server_errors_total", "Total number of server errors.",
+				MetricsKindCounter, "key",
+			).Add(float64(meta.ErrorCount), key)
+			family("eudore_server_ports", "Number of server listening ports.",
+				MetricsKindGauge, "key",
+			).Set(float64(len(meta.Ports)), key)
+		case MetadataClient:
+			health(key, meta.Name, meta.Health)
+			family("eudore_client_hooks", "Number of client hooks.",
+				MetricsKindGauge, "key",
+			).Set(float64(len(meta.Hooks)), key)
+			for _, b := range meta.Breakers {
+				family("eudore_client_breaker_state", "Client breaker state.",
+					MetricsKindGauge, "key", "breaker", "state",
+				).Set(1, key, b.Key, b.State)
+				f := family("eudore_client_breaker_requests_total", "Total number of client breaker requests by result.",
+					MetricsKindCounter, "key", "breaker", "result",
+				)
+				f.Add(float64(b.TotalSuccesses), key, b.Key, "success")
+				f.Add(float64(b.TotalFailures), key, b.Key, "failure")
+			}
+		case MetadataEventHub:
+			health(key, meta.Name, meta.Health)
+			family("eudore_eventhub_topics", "Number of event hub topics.",
+				MetricsKindGauge, "key",
+			).Set(float64(len(meta.Topics)), key)
+		}
+	}
+
+	metas := make([]*metricsFamily, 0, len(families))
+	for _, f := range families {
+		metas = append(metas, f)
+	}
+	return metas
+}
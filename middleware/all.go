@@ -1,14 +1,20 @@
 package middleware
 
 import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
 	"crypto/md5"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/textproto"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -73,6 +79,138 @@ func NewBodyLimitFunc(size int64) Middleware {
 	}
 }
 
+// NewBodyDecompressFunc function creates middleware to implement
+// decompress the request body by [eudore.HeaderContentEncoding].
+//
+// The decoders default is [DefaultDecompressionDecoder],
+// supports zstd, br, gzip and deflate.
+// Multiple encodings are decoded in reverse order.
+//
+// If the encoding is not supported,
+// [eudore.StatusUnsupportedMediaType] is returned.
+// If the decompressed length exceeds size, read returns [http.MaxBytesError],
+// size less than or equal to 0 means no limit,
+// invalid compressed data returns [eudore.StatusBadRequest] error.
+//
+//go:noinline
+func NewBodyDecompressFunc(size int64,
+	decoders map[string]func(io.Reader) (io.Reader, error),
+) Middleware {
+	if decoders == nil {
+		decoders = DefaultDecompressionDecoder
+	}
+	names := make([]string, 0, len(decoders))
+	for name := range decoders {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return getCompresssPriority(names[i]) < getCompresssPriority(names[j])
+	})
+	accept := strings.Join(names, ", ")
+
+	return func(ctx eudore.Context) {
+		r := ctx.Request()
+		encoding := r.Header.Get(eudore.HeaderContentEncoding)
+		if encoding == "" || encoding == CompressionNameIdentity {
+			return
+		}
+
+		encodings := strings.Split(encoding, ",")
+		fns := make([]func(io.Reader) (io.Reader, error), len(encodings))
+		for i := range encodings {
+			fn := decoders[strings.ToLower(strings.TrimSpace(encodings[i]))]
+			if fn == nil {
+				ctx.SetHeader(eudore.HeaderAcceptEncoding, accept)
+				writePage(ctx, eudore.StatusUnsupportedMediaType,
+					DefaultPageDecompress, encoding,
+				)
+				ctx.End()
+				return
+			}
+			fns[len(fns)-1-i] = fn
+		}
+
+		r.Body = &readerDecompress{
+			body:     r.Body,
+			decoders: fns,
+			limit:    size,
+			remain:   size,
+		}
+		r.ContentLength = -1
+		r.Header.Del(eudore.HeaderContentLength)
+		r.Header.Del(eudore.HeaderContentEncoding)
+	}
+}
+
+func decompressionReaderGzip(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+// The decompressionReaderFlate function decodes zlib format,
+// and raw deflate if zlib header is invalid.
+func decompressionReaderFlate(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(2)
+	if err == nil && header[0]&0x0f == 8 &&
+		(uint(header[0])<<8|uint(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
+
+// readerDecompress defines the lazy decompression body with size limit.
+type readerDecompress struct {
+	body     io.ReadCloser
+	reader   io.Reader
+	decoders []func(io.Reader) (io.Reader, error)
+	limit    int64
+	remain   int64
+	err      error
+}
+
+func (r *readerDecompress) Read(p []byte) (int, error) {
+	if r.reader == nil && r.err == nil {
+		r.reader = r.body
+		for _, fn := range r.decoders {
+			r.reader, r.err = fn(r.reader)
+			if r.err != nil {
+				r.err = eudore.NewErrorWithStatus(r.err, eudore.StatusBadRequest)
+				break
+			}
+		}
+	}
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.limit > 0 && int64(len(p)) > r.remain+1 {
+		p = p[:r.remain+1]
+	}
+	n, err := r.reader.Read(p)
+	if r.limit > 0 && int64(n) > r.remain {
+		n = int(r.remain)
+		err = &http.MaxBytesError{Limit: r.limit}
+	}
+	r.remain -= int64(n)
+
+	var maxBytesErr *http.MaxBytesError
+	if err != nil && err != io.EOF && !errors.As(err, &maxBytesErr) {
+		err = eudore.NewErrorWithStatus(err, eudore.StatusBadRequest)
+	}
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
+}
+
+func (r *readerDecompress) Close() error {
+	closer, ok := r.reader.(io.Closer)
+	if ok && closer != r.body {
+		_ = closer.Close()
+	}
+	return r.body.Close()
+}
+
 // NewBodySizeFunc function creates the middleware implement
 // update the request Body Size.
 //
@@ -223,6 +361,75 @@ func NewMetadataFunc(app context.Context) Middleware {
 	}
 }
 
+// NewMetricsFunc function creates middleware to implement recording
+// the request metrics to [eudore.Metrics].
+//
+// The labels are method, [eudore.ParamRoute] and status, record the request
+// count, duration, request and response size,
+// and the requests in flight by method.
+//
+// The size buckets use [eudore.DefaultMetricsSizeBuckets].
+func NewMetricsFunc(metrics eudore.Metrics) Middleware {
+	labels := []string{"method", "route", "status"}
+	inflight := metrics.NewGauge("eudore_server_requests_in_flight",
+		"Current number of requests being served.", "method",
+	)
+	total := metrics.NewCounter("eudore_server_requests_total",
+		"Total number of requests by route and status.", labels...,
+	)
+	duration := metrics.NewHistogram("eudore_server_request_duration_seconds",
+		"Histogram of latencies for requests.", eudore.DefaultMetricsBuckets, labels...,
+	)
+	reqsize := metrics.NewHistogram("eudore_server_request_size_bytes",
+		"Histogram of request size for requests.", eudore.DefaultMetricsSizeBuckets, labels...,
+	)
+	respsize := metrics.NewHistogram("eudore_server_response_size_bytes",
+		"Histogram of response size for requests.", eudore.DefaultMetricsSizeBuckets, labels...,
+	)
+	release := func(ctx eudore.Context, now time.Time) {
+		method := ctx.Method()
+		inflight.Dec(method)
+		values := []string{
+			method, ctx.GetParam(eudore.ParamRoute),
+			strconv.Itoa(ctx.Response().Status()),
+		}
+		size := ctx.Request().ContentLength
+		if size < 0 {
+			size = 0
+		}
+		total.Inc(values...)
+		duration.Observe(time.Since(now).Seconds(), values...)
+		reqsize.Observe(float64(size), values...)
+		respsize.Observe(float64(ctx.Response().Size()), values...)
+	}
+	return func(ctx eudore.Context) {
+		inflight.Inc(ctx.Method())
+		defer release(ctx, time.Now())
+		ctx.Next()
+	}
+}
+
+// NewMetricsExportFunc function creates [eudore.HandlerFunc] to export
+// [eudore.Metrics].
+//
+// If the [eudore.HeaderAccept] accepts [eudore.MimeApplicationOpenMetrics],
+// use OpenMetrics format, otherwise use Prometheus text format.
+func NewMetricsExportFunc(metrics eudore.Metrics) Middleware {
+	return func(ctx eudore.Context) {
+		format := eudore.MimeTextPlain
+		contentType := eudore.MimeTextPlain + "; version=0.0.4; " + eudore.MimeCharsetUtf8
+		if strings.Contains(ctx.GetHeader(eudore.HeaderAccept), eudore.MimeApplicationOpenMetrics) {
+			format = eudore.MimeApplicationOpenMetrics
+			contentType = eudore.MimeApplicationOpenMetrics + "; version=1.0.0; " + eudore.MimeCharsetUtf8
+		}
+		ctx.SetHeader(eudore.HeaderContentType, contentType)
+		err := metrics.Encode(ctx, format)
+		if err != nil {
+			ctx.Fatal(err)
+		}
+	}
+}
+
 func anyMetadata(i any) any {
 	metaer, ok := i.(interface{ Metadata() any })
 	if ok {
@@ -410,6 +617,47 @@ func NewRequestIDFunc(fn func(eudore.Context) string) Middleware {
 	}
 }
 
+// NewTraceFunc function creates middleware to implement W3C Trace Context
+// propagation.
+//
+// Start a server [eudore.TraceSpan] for each request, the remote parent is
+// parsed from [eudore.HeaderTraceparent] [eudore.HeaderTracestate]
+// [eudore.HeaderBaggage], and save it to [eudore.ContextKeyTraceSpan],
+// the client uses [eudore.NewClientHookTrace] to propagate it.
+//
+// Set [eudore.HeaderXTraceID] and the Logger fields [eudore.FieldTraceID]
+// [eudore.FieldSpanID].
+func NewTraceFunc(exporter eudore.TraceExporter) Middleware {
+	return func(ctx eudore.Context) {
+		span := eudore.NewTraceSpanWithHeader(ctx.Request().Header,
+			ctx.Method(), eudore.TraceKindServer, exporter,
+		)
+		span.SetAttribute("http.request.method", ctx.Method())
+		span.SetAttribute("url.path", ctx.Path())
+		defer span.End()
+		ctx.SetValue(eudore.ContextKeyTraceSpan, span)
+		ctx.SetHeader(eudore.HeaderXTraceID, span.TraceID)
+		ctx.SetValue(eudore.ContextKeyLogger,
+			ctx.Value(eudore.ContextKeyLogger).(eudore.Logger).
+				WithField(eudore.FieldTraceID, span.TraceID).
+				WithField(eudore.FieldSpanID, span.SpanID).
+				WithField(eudore.FieldLogger, true),
+		)
+
+		ctx.Next()
+		status := ctx.Response().Status()
+		route := ctx.GetParam(eudore.ParamRoute)
+		if route != "" {
+			span.Name = ctx.Method() + " " + route
+			span.SetAttribute("http.route", route)
+		}
+		span.SetAttribute("http.response.status_code", status)
+		if status >= eudore.StatusInternalServerError {
+			span.SetError(errors.New(http.StatusText(status)))
+		}
+	}
+}
+
 // NewRoutesFunc function creates middleware to implement
 // uses Routes to create [NewRouterFunc] middleware.
 func NewRoutesFunc(routes map[string]any) Middleware {
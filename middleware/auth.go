@@ -2,13 +2,24 @@ package middleware
 
 import (
 	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/hmac"
+	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"hash"
 	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -61,12 +72,22 @@ func NewBasicAuthFunc(names map[string]string) Middleware {
 // the process is skipped. Other middleware can be used to implement
 // Bearer data format checks. If parsing fails, a [eudore.StatusUnauthorized] response is returned.
 //
-// The parameter key is the JWT key, with built-in HS256 parsing by default;
+// The parameter key is the JWT key, the built-in signing method is selected
+// by the alg header and the key type:
+//
+//	[]byte string: HS256 HS384 HS512
+//	*rsa.PublicKey: RS256 RS384 RS512 PS256 PS384 PS512
+//	*ecdsa.PublicKey: ES256 ES384 ES512
+//	ed25519.PublicKey: EdDSA
+//	map[string]any: the keys of the kid header
+//
 // it is compatible with [github.com/golang-jwt/jwt/v5.SigningMethod] as the parsing method.
 //
 //	type signatureUser struct {
 //		Userid     json.Number `json:"userid"`
 //		Username   string      `json:"username,omitempty"`
+//		Issuer     string      `json:"iss,omitempty"`
+//		Audience   []string    `json:"aud,omitempty"`
 //		NotBefore  int64       `json:"nbf,omitempty"`
 //		Expiration int64       `json:"exp,omitempty"`
 //	}
@@ -75,21 +96,23 @@ func NewBasicAuthFunc(names map[string]string) Middleware {
 // use single route at the same time.
 //
 // options: [NewOptionKeyFunc]
-// [NewOptionBearerSignaturer] [NewOptionBearerPayload].
+// [NewOptionBearerSignaturer] [NewOptionBearerPayload]
+// [NewOptionBearerClaims] [NewOptionBearerJWKS].
 //
 // RFC 6750: The OAuth 2.0 Authorization Framework: Bearer Token Usage
 //
 // RFC 7519: JSON Web Token (JWT).
 func NewBearerAuthFunc(key any, options ...Option) Middleware {
 	b := &bearer{
-		Signing: newSigningMethodHS256(key),
 		GetKeyFunc: func(ctx eudore.Context) string {
 			return ctx.GetHeader(eudore.HeaderAuthorization)
 		},
 		key: key,
 	}
+	if keys, ok := key.(map[string]any); ok {
+		b.Keys, b.key = keys, nil
+	}
 	applyOption(b, options)
-	b.head = base64Encoding.EncodeToString([]byte(`{"alg":"` + b.Signing.Alg() + `","typ":"JWT"}`))
 
 	return func(ctx eudore.Context) {
 		token := b.GetKeyFunc(ctx)
@@ -178,11 +201,250 @@ func NewDigestAuthFunc(dig *eudore.ClientDigest, names map[string]string) Middle
 	}
 }
 
+// NewSignatureAuthFunc function creates middleware to implement verifying
+// HTTP Message Signatures.
+//
+// keys is a map that stores the key of the keyid, the algorithm is
+// determined by the key type, if the alg parameter exists, it must match:
+//
+//	[]byte string: hmac-sha256
+//	ed25519.PublicKey: ed25519
+//	*ecdsa.PublicKey: ecdsa-p256-sha256 ecdsa-p384-sha384
+//	*rsa.PublicKey: rsa-pss-sha512 rsa-v1_5-sha256
+//
+// Any signature label that verifies is accepted, the signature must cover
+// [DefaultSignatureComponents], and "content-digest" if the request has a
+// body, and the [eudore.HeaderContentDigest] is verified.
+// The created parameter must be within [DefaultSignatureMaxAge].
+//
+// Set keyid to [eudore.ParamUserid] if verified, otherwise return
+// [eudore.StatusUnauthorized].
+//
+// options: [NewOptionSignatureComponents] [NewOptionSignatureMaxAge].
+//
+// RFC 9421: HTTP Message Signatures.
+//
+// RFC 9530: Digest Fields.
+func NewSignatureAuthFunc(keys map[string]any, options ...Option) Middleware {
+	s := &signature{
+		Keys:       keys,
+		Components: DefaultSignatureComponents,
+		MaxAge:     DefaultSignatureMaxAge,
+	}
+	applyOption(s, options)
+
+	return func(ctx eudore.Context) {
+		keyid, err := s.Verify(ctx)
+		if err == nil {
+			ctx.SetParam(eudore.ParamUserid, keyid)
+			return
+		}
+
+		ctx.SetHeader(eudore.HeaderWWWAuthenticate, "Signature")
+		writePage(ctx, eudore.StatusUnauthorized, DefaultPageSignatureAuth, err.Error())
+		ctx.End()
+	}
+}
+
+type signature struct {
+	Keys       map[string]any
+	Components []string
+	MaxAge     time.Duration
+}
+
+// The Verify method returns the keyid of the first verified signature.
+func (s *signature) Verify(ctx eudore.Context) (string, error) {
+	inputs := splitSignatureDictionary(ctx.GetHeader(eudore.HeaderSignatureInput))
+	sigs := splitSignatureDictionary(ctx.GetHeader(eudore.HeaderSignature))
+	if len(inputs) == 0 {
+		return "", ErrSignatureMissing
+	}
+	body, err := ctx.Body()
+	if err != nil {
+		return "", err
+	}
+	if len(body) > 0 {
+		err = verifySignatureDigest(ctx.GetHeader(eudore.HeaderContentDigest), body)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for label, input := range inputs {
+		var keyid string
+		keyid, err = s.verify(ctx.Request(), input, sigs[label], len(body) > 0)
+		if err == nil {
+			return keyid, nil
+		}
+	}
+	return "", err
+}
+
+func (s *signature) verify(req *http.Request, input, sig string, body bool) (string, error) {
+	pos := strings.LastIndexByte(input, ')')
+	if pos == -1 {
+		return "", fmt.Errorf(eudore.ErrHTTPSignatureInputInvalid, input)
+	}
+	params := parseSignatureParams(input[pos+1:])
+	components := strings.Fields(input[1:pos])
+	for _, name := range s.Components {
+		if sliceIndex(components, strconv.Quote(name)) == -1 {
+			return "", fmt.Errorf(ErrSignatureComponentNotCovered, name)
+		}
+	}
+	if body && sliceIndex(components, `"content-digest"`) == -1 {
+		return "", fmt.Errorf(ErrSignatureComponentNotCovered, "content-digest")
+	}
+
+	now := time.Now()
+	created, err := strconv.ParseInt(params["created"], 10, 64)
+	if err != nil || now.Sub(time.Unix(created, 0)).Abs() > s.MaxAge {
+		return "", fmt.Errorf(ErrSignatureExpired, params["created"])
+	}
+	expires, err := strconv.ParseInt(eudore.GetAnyDefault(params["expires"], "0"), 10, 64)
+	if err != nil || (expires != 0 && now.Unix() > expires) {
+		return "", fmt.Errorf(ErrSignatureExpired, params["expires"])
+	}
+
+	keyid := params["keyid"]
+	key, ok := s.Keys[keyid]
+	if !ok {
+		return "", fmt.Errorf(ErrSignatureKeyNotFound, keyid)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.Trim(sig, ":"))
+	if err != nil {
+		return "", err
+	}
+	base, err := eudore.GetHTTPSignatureBase(req, input)
+	if err != nil {
+		return "", err
+	}
+	return keyid, verifySignature(key, params["alg"], base, data)
+}
+
+func verifySignature(key any, alg string, base, sig []byte) error {
+	var valid bool
+	switch key := key.(type) {
+	case string:
+		return verifySignature([]byte(key), alg, base, sig)
+	case []byte:
+		h := hmac.New(sha256.New, key)
+		h.Write(base)
+		valid = alg == "" || alg == "hmac-sha256"
+		valid = valid && hmac.Equal(sig, h.Sum(nil))
+	case ed25519.PublicKey:
+		valid = (alg == "" || alg == "ed25519") && ed25519.Verify(key, base, sig)
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		var hashed []byte
+		switch {
+		case size == 32 && (alg == "" || alg == "ecdsa-p256-sha256"):
+			sum := sha256.Sum256(base)
+			hashed = sum[:]
+		case size == 48 && (alg == "" || alg == "ecdsa-p384-sha384"):
+			sum := sha512.Sum384(base)
+			hashed = sum[:]
+		}
+		valid = hashed != nil && len(sig) == size*2 && ecdsa.Verify(key, hashed,
+			new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:]),
+		)
+	case *rsa.PublicKey:
+		switch alg {
+		case "", "rsa-pss-sha512":
+			sum := sha512.Sum512(base)
+			valid = rsa.VerifyPSS(key, crypto.SHA512, sum[:], sig, nil) == nil
+		case "rsa-v1_5-sha256":
+			sum := sha256.Sum256(base)
+			valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], sig) == nil
+		}
+	default:
+		return fmt.Errorf(ErrSignatureKeyInvalid, key)
+	}
+	if !valid {
+		return ErrSignatureInvalid
+	}
+	return nil
+}
+
+// The verifySignatureDigest function verifies the sha-256 or sha-512
+// [eudore.HeaderContentDigest] of the body.
+func verifySignatureDigest(digest string, body []byte) error {
+	for alg, val := range splitSignatureDictionary(digest) {
+		var sum []byte
+		switch alg {
+		case "sha-256":
+			s := sha256.Sum256(body)
+			sum = s[:]
+		case "sha-512":
+			s := sha512.Sum512(body)
+			sum = s[:]
+		default:
+			continue
+		}
+		if strings.Trim(val, ":") != base64.StdEncoding.EncodeToString(sum) {
+			return ErrSignatureDigestInvalid
+		}
+		return nil
+	}
+	return ErrSignatureDigestInvalid
+}
+
+// The splitSignatureDictionary function splits the structured field
+// dictionary, the comma in quotes and inner lists is ignored.
+func splitSignatureDictionary(str string) map[string]string {
+	dict := make(map[string]string)
+	quote, depth, start := false, 0, 0
+	for i := 0; i <= len(str); i++ {
+		if i < len(str) {
+			switch {
+			case str[i] == '"':
+				quote = !quote
+			case quote:
+			case str[i] == '(':
+				depth++
+			case str[i] == ')':
+				depth--
+			}
+			if str[i] != ',' || quote || depth != 0 {
+				continue
+			}
+		}
+		member := strings.TrimSpace(str[start:i])
+		pos := strings.IndexByte(member, '=')
+		if pos > 0 {
+			dict[member[:pos]] = member[pos+1:]
+		}
+		start = i + 1
+	}
+	return dict
+}
+
+// The parseSignatureParams function parses the parameters such as
+// ;created=1618884473;keyid="test".
+func parseSignatureParams(str string) map[string]string {
+	params := make(map[string]string)
+	for _, param := range strings.Split(str, ";") {
+		key, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if key == "" {
+			continue
+		}
+		if unquote, err := strconv.Unquote(val); err == nil {
+			val = unquote
+		}
+		params[key] = val
+	}
+	return params
+}
+
 type bearer struct {
+	sync.RWMutex
 	Signing     signingMethod
 	GetKeyFunc  func(ctx eudore.Context) string
 	PayloadFunc func(ctx eudore.Context, str []byte)
-	head        string
+	Keys        map[string]any
+	Issuer      string
+	Audience    string
+	Leeway      time.Duration
 	key         any
 }
 
@@ -197,19 +459,56 @@ type signingMethod interface {
 	Alg() string                                            // returns the alg identifier for this method (example: 'HS256')
 }
 
+type signatureHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
 type signatureUser struct {
-	Userid     json.Number `json:"userid"`
-	Username   string      `json:"username,omitempty"`
-	NotBefore  int64       `json:"nbf,omitempty"`
-	Expiration int64       `json:"exp,omitempty"`
+	Userid     json.Number       `json:"userid"`
+	Username   string            `json:"username,omitempty"`
+	Issuer     string            `json:"iss,omitempty"`
+	Audience   signatureAudience `json:"aud,omitempty"`
+	NotBefore  int64             `json:"nbf,omitempty"`
+	Expiration int64             `json:"exp,omitempty"`
+}
+
+// signatureAudience defines the JWT aud claim, which is a string or an
+// array of strings.
+type signatureAudience []string
+
+func (aud *signatureAudience) UnmarshalJSON(data []byte) error {
+	var str string
+	if json.Unmarshal(data, &str) == nil {
+		*aud = signatureAudience{str}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(aud))
 }
 
 func (b *bearer) Parse(ctx eudore.Context, token string) (*signatureUser, error) {
 	parts := strings.Split(token, ".")
-	if len(parts) != 3 || parts[0] != b.head {
+	if len(parts) != 3 {
 		return nil, ErrBearerTokenInvalid
 	}
 
+	var head signatureHeader
+	data, err := base64Encoding.DecodeString(parts[0])
+	if err != nil || json.Unmarshal(data, &head) != nil {
+		return nil, ErrBearerTokenInvalid
+	}
+	signing := b.Signing
+	if signing == nil {
+		signing = bearerSigningMethods[head.Algorithm]
+	}
+	if signing == nil || signing.Alg() != head.Algorithm {
+		return nil, fmt.Errorf(ErrBearerAlgorithmInvalid, head.Algorithm)
+	}
+	key, err := b.getKey(head.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
 	payload, err := base64Encoding.DecodeString(parts[1])
 	if err != nil {
 		return nil, err
@@ -218,7 +517,7 @@ func (b *bearer) Parse(ctx eudore.Context, token string) (*signatureUser, error)
 	if err != nil {
 		return nil, err
 	}
-	err = b.Signing.Verify(token[:len(token)-len(parts[2])-1], sig, b.key)
+	err = signing.Verify(token[:len(token)-len(parts[2])-1], sig, key)
 	if err != nil {
 		return nil, err
 	}
@@ -228,12 +527,9 @@ func (b *bearer) Parse(ctx eudore.Context, token string) (*signatureUser, error)
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().Unix()
-	if user.NotBefore != 0 && user.NotBefore > now {
-		return nil, fmt.Errorf(ErrBearerTokenNotValid, user.NotBefore)
-	}
-	if user.Expiration != 0 && user.Expiration < now {
-		return nil, fmt.Errorf(ErrBearerTokenExpired, user.Expiration)
+	err = b.validate(&user)
+	if err != nil {
+		return nil, err
 	}
 	if b.PayloadFunc != nil {
 		b.PayloadFunc(ctx, payload)
@@ -241,6 +537,49 @@ func (b *bearer) Parse(ctx eudore.Context, token string) (*signatureUser, error)
 	return &user, nil
 }
 
+// The getKey method returns the key of the kid in the key set,
+// if kid is empty and the key set has only one key, returns it.
+//
+// The single key is used only when the key set is not configured or loaded,
+// unknown kid does not fall back to it.
+func (b *bearer) getKey(kid string) (any, error) {
+	b.RLock()
+	defer b.RUnlock()
+	if b.Keys != nil {
+		key, ok := b.Keys[kid]
+		if ok {
+			return key, nil
+		}
+		if kid == "" && len(b.Keys) == 1 {
+			for _, key := range b.Keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf(ErrBearerKeyNotFound, kid)
+	}
+	if b.key == nil {
+		return nil, fmt.Errorf(ErrBearerKeyNotFound, kid)
+	}
+	return b.key, nil
+}
+
+func (b *bearer) validate(user *signatureUser) error {
+	now := time.Now()
+	if user.NotBefore != 0 && user.NotBefore > now.Add(b.Leeway).Unix() {
+		return fmt.Errorf(ErrBearerTokenNotValid, user.NotBefore)
+	}
+	if user.Expiration != 0 && user.Expiration < now.Add(-b.Leeway).Unix() {
+		return fmt.Errorf(ErrBearerTokenExpired, user.Expiration)
+	}
+	if b.Issuer != "" && user.Issuer != b.Issuer {
+		return fmt.Errorf(ErrBearerIssuerInvalid, user.Issuer)
+	}
+	if b.Audience != "" && sliceIndex(user.Audience, b.Audience) == -1 {
+		return fmt.Errorf(ErrBearerAudienceInvalid, []string(user.Audience))
+	}
+	return nil
+}
+
 // NewOptionBearerSignaturer function creates BearerAuth custom signing method [Option].
 //
 // The signaturer parameter must implement the [SigningMethod] interface.
@@ -286,33 +625,203 @@ func NewOptionBearerPayload[T any](key any) Option {
 	}
 }
 
-func newSigningMethodHS256(data any) signingMethod {
-	var key []byte
-	switch val := data.(type) {
-	case []byte:
-		key = val
-	case string:
-		key = []byte(val)
+// NewOptionBearerClaims function creates BearerAuth [Option] to validate
+// the iss and aud claims if they are not empty,
+// and allows the clock skew leeway when validating nbf and exp.
+func NewOptionBearerClaims(issuer, audience string, leeway time.Duration) Option {
+	return func(data any) {
+		b, ok := data.(*bearer)
+		if ok {
+			b.Issuer = issuer
+			b.Audience = audience
+			b.Leeway = leeway
+		}
 	}
+}
 
-	return signingHmac{
-		pool: &sync.Pool{
-			New: func() any {
-				return hmac.New(sha256.New, key)
-			},
-		},
+// NewOptionBearerJWKS function creates BearerAuth [Option] to load the keys
+// from the JWKS document and refresh it periodically until ctx is done.
+//
+// The source is a file path or a URL requested by the [eudore.Client] of ctx.
+// The keys are selected by the kid header of the token, and the key of
+// [NewBearerAuthFunc] is only used before the keys are loaded.
+// Load failures and the invalid keys are output to the [eudore.Logger] of
+// ctx, the invalid keys are skipped.
+//
+// RFC 7517: JSON Web Key (JWK).
+func NewOptionBearerJWKS(ctx context.Context, source string, interval time.Duration) Option {
+	return func(data any) {
+		b, ok := data.(*bearer)
+		if !ok {
+			return
+		}
+		load := func() {
+			keys, err := loadBearerJWKS(ctx, source)
+			if err != nil {
+				log, ok := ctx.Value(eudore.ContextKeyLogger).(eudore.Logger)
+				if ok {
+					log.Errorf("bearer load jwks %s error: %v", source, err)
+				}
+				return
+			}
+			b.Lock()
+			b.Keys = keys
+			b.Unlock()
+		}
+		load()
+		if interval > 0 {
+			go func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						load()
+					}
+				}
+			}()
+		}
 	}
 }
 
+type bearerJWK struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use,omitempty"`
+	Curve   string `json:"crv,omitempty"`
+	N       string `json:"n,omitempty"`
+	E       string `json:"e,omitempty"`
+	X       string `json:"x,omitempty"`
+	Y       string `json:"y,omitempty"`
+	K       string `json:"k,omitempty"`
+}
+
+func loadBearerJWKS(ctx context.Context, source string) (map[string]any, error) {
+	var jwks struct {
+		Keys []bearerJWK `json:"keys"`
+	}
+	if strings.HasPrefix(source, valueSchemeHTTP) || strings.HasPrefix(source, valueSchemeHTTPS) {
+		client, ok := ctx.Value(eudore.ContextKeyClient).(eudore.Client)
+		if !ok {
+			client = eudore.NewClient()
+		}
+		err := client.GetRequest(source, ctx,
+			eudore.NewClientCheckStatus(eudore.StatusOK),
+			eudore.NewClientParse(&jwks),
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(data, &jwks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use == "enc" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			log, ok := ctx.Value(eudore.ContextKeyLogger).(eudore.Logger)
+			if ok {
+				log.Warningf("bearer load jwks %s skip key: %v", source, err)
+			}
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+	return keys, nil
+}
+
+// The PublicKey method returns the key of the RSA, EC, OKP or oct type.
+func (jwk *bearerJWK) PublicKey() (any, error) {
+	decode := func(strs ...string) ([]*big.Int, error) {
+		nums := make([]*big.Int, len(strs))
+		for i, str := range strs {
+			data, err := base64Encoding.DecodeString(str)
+			if err != nil || len(data) == 0 {
+				return nil, fmt.Errorf(ErrBearerJWKInvalid, jwk.KeyID, jwk.KeyType)
+			}
+			nums[i] = new(big.Int).SetBytes(data)
+		}
+		return nums, nil
+	}
+
+	switch jwk.KeyType {
+	case "RSA":
+		nums, err := decode(jwk.N, jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: nums[0], E: int(nums[1].Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{
+			"P-256": elliptic.P256(),
+			"P-384": elliptic.P384(),
+			"P-521": elliptic.P521(),
+		}
+		nums, err := decode(jwk.X, jwk.Y)
+		if err != nil || curves[jwk.Curve] == nil {
+			return nil, fmt.Errorf(ErrBearerJWKInvalid, jwk.KeyID, jwk.KeyType)
+		}
+		return &ecdsa.PublicKey{Curve: curves[jwk.Curve], X: nums[0], Y: nums[1]}, nil
+	case "OKP":
+		data, err := base64Encoding.DecodeString(jwk.X)
+		if err == nil && jwk.Curve == "Ed25519" && len(data) == ed25519.PublicKeySize {
+			return ed25519.PublicKey(data), nil
+		}
+	case "oct":
+		data, err := base64Encoding.DecodeString(jwk.K)
+		if err == nil {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf(ErrBearerJWKInvalid, jwk.KeyID, jwk.KeyType)
+}
+
+var bearerSigningMethods = map[string]signingMethod{
+	"HS256": signingHmac{"HS256", sha256.New},
+	"HS384": signingHmac{"HS384", sha512.New384},
+	"HS512": signingHmac{"HS512", sha512.New},
+	"RS256": signingRSA{"RS256", crypto.SHA256, false},
+	"RS384": signingRSA{"RS384", crypto.SHA384, false},
+	"RS512": signingRSA{"RS512", crypto.SHA512, false},
+	"PS256": signingRSA{"PS256", crypto.SHA256, true},
+	"PS384": signingRSA{"PS384", crypto.SHA384, true},
+	"PS512": signingRSA{"PS512", crypto.SHA512, true},
+	"ES256": signingECDSA{"ES256", crypto.SHA256, 32},
+	"ES384": signingECDSA{"ES384", crypto.SHA384, 48},
+	"ES512": signingECDSA{"ES512", crypto.SHA512, 66},
+	"EdDSA": signingEd25519{},
+}
+
 type signingHmac struct {
-	pool *sync.Pool
+	alg  string
+	hash func() hash.Hash
 }
 
-func (fn signingHmac) Verify(signingString string, sig []byte, _ any) error {
-	h := fn.pool.Get().(hash.Hash)
-	defer fn.pool.Put(h)
+func (fn signingHmac) Verify(signingString string, sig []byte, key any) error {
+	var secret []byte
+	switch val := key.(type) {
+	case []byte:
+		secret = val
+	case string:
+		secret = []byte(val)
+	default:
+		return ErrBearerSignatureInvalid
+	}
 
-	h.Reset()
+	h := hmac.New(fn.hash, secret)
 	h.Write([]byte(signingString))
 	if !hmac.Equal(sig, h.Sum(nil)) {
 		return ErrBearerSignatureInvalid
@@ -321,5 +830,76 @@ func (fn signingHmac) Verify(signingString string, sig []byte, _ any) error {
 }
 
 func (fn signingHmac) Alg() string {
-	return "HS256"
+	return fn.alg
+}
+
+type signingRSA struct {
+	alg  string
+	hash crypto.Hash
+	pss  bool
+}
+
+func (fn signingRSA) Verify(signingString string, sig []byte, key any) error {
+	pub, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return ErrBearerSignatureInvalid
+	}
+
+	h := fn.hash.New()
+	h.Write([]byte(signingString))
+	var err error
+	if fn.pss {
+		err = rsa.VerifyPSS(pub, fn.hash, h.Sum(nil), sig, nil)
+	} else {
+		err = rsa.VerifyPKCS1v15(pub, fn.hash, h.Sum(nil), sig)
+	}
+	if err != nil {
+		return ErrBearerSignatureInvalid
+	}
+	return nil
+}
+
+func (fn signingRSA) Alg() string {
+	return fn.alg
+}
+
+type signingECDSA struct {
+	alg  string
+	hash crypto.Hash
+	size int
+}
+
+func (fn signingECDSA) Verify(signingString string, sig []byte, key any) error {
+	pub, ok := key.(*ecdsa.PublicKey)
+	if !ok || (pub.Curve.Params().BitSize+7)/8 != fn.size || len(sig) != fn.size*2 {
+		return ErrBearerSignatureInvalid
+	}
+
+	h := fn.hash.New()
+	h.Write([]byte(signingString))
+	r := new(big.Int).SetBytes(sig[:fn.size])
+	s := new(big.Int).SetBytes(sig[fn.size:])
+	if !ecdsa.Verify(pub, h.Sum(nil), r, s) {
+		return ErrBearerSignatureInvalid
+	}
+	return nil
+}
+
+func (fn signingECDSA) Alg() string {
+	return fn.alg
+}
+
+type signingEd25519 struct{}
+
+func (signingEd25519) Verify(signingString string, sig []byte, key any) error {
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok || len(pub) != ed25519.PublicKeySize ||
+		!ed25519.Verify(pub, []byte(signingString), sig) {
+		return ErrBearerSignatureInvalid
+	}
+	return nil
+}
+
+func (signingEd25519) Alg() string {
+	return "EdDSA"
 }
@@ -3,10 +3,18 @@ package middleware
 import (
 	"bufio"
 	"bytes"
+	"container/list"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,17 +25,32 @@ import (
 type cache struct {
 	sync.Mutex
 	waits      map[string]*sync.WaitGroup
-	storage    cacheData
+	storage    CacheData
+	control    string
 	GetKeyFunc func(ctx eudore.Context) string
 }
 
-type cacheData interface {
-	LoadData(key string) *cacheResponse
-	SaveData(key string, val *cacheResponse)
+// CacheData defines the storage of [NewCacheFunc].
+//
+// Implementations: [NewCacheDataMap] [NewCacheDataLRU] [NewCacheDataFile]
+// [NewCacheDataStore].
+type CacheData interface {
+	// LoadData method returns unexpired data, or nil if not found.
+	LoadData(key string) *CacheResponse
+	SaveData(key string, val *CacheResponse)
+}
+
+// CacheStore defines the key/value store used by [NewCacheDataStore],
+// such as redis or memcached client wrapper.
+//
+// Get returns an error if the key does not exist.
+type CacheStore interface {
+	Get(key string) ([]byte, error)
+	Set(key string, val []byte, ttl time.Duration) error
 }
 
-// cacheResponse defines the cached data type.
-type cacheResponse struct {
+// CacheResponse defines the cached data type.
+type CacheResponse struct {
 	Expired time.Time
 	Status  int
 	Header  http.Header
@@ -43,10 +66,16 @@ type cacheResponse struct {
 //
 // Skip non-Get methods, Websocket and SSE by default.
 //
-// This middleware does not support cluster mode.
-// Cache requests are idempotent and do not rely on the cluster.
+// The response is buffered until the handler is done,
+// the cached data has [eudore.HeaderETag] and [eudore.HeaderLastModified],
+// and returns [eudore.StatusNotModified] if the conditional request matches.
 //
-// options: [NewOptionKeyFunc] [NewOptionCacheClear].
+// Use [NewOptionCacheData] to modify the storage, [NewCacheDataStore] can
+// share cached data between multiple instances,
+// but SingleFlight only takes effect in the current instance.
+//
+// options: [NewOptionKeyFunc] [NewOptionCacheData] [NewOptionCacheCleanup]
+// [NewOptionCacheControl].
 func NewCacheFunc(dura time.Duration, options ...Option) Middleware {
 	c := newCache(options)
 	return func(ctx eudore.Context) {
@@ -78,13 +107,32 @@ func NewCacheFunc(dura time.Duration, options ...Option) Middleware {
 				eudore.HeaderLastModified: {now.UTC().Format(http.TimeFormat)},
 			},
 		}
+		if c.control != "" {
+			w.h.Set(eudore.HeaderCacheControl, c.control)
+		}
 		headerCopy(w.h, w.ResponseWriter.Header())
 		ctx.SetResponse(w)
-		defer ctx.SetResponse(w.ResponseWriter)
 		ctx.Next()
-		c.storage.SaveData(fullkey, &cacheResponse{
+		ctx.SetResponse(w.ResponseWriter)
+
+		status := w.Status()
+		if status != eudore.StatusNotModified && w.h.Get(eudore.HeaderETag) == "" {
+			w.h.Set(eudore.HeaderETag, eudore.NewETag(w.w.Bytes(), false))
+		}
+		headerCopy(w.ResponseWriter.Header(), w.h)
+		if status == eudore.StatusOK &&
+			eudore.CheckNotModified(ctx.Request(), w.h) {
+			w.ResponseWriter.WriteHeader(eudore.StatusNotModified)
+		} else {
+			w.ResponseWriter.WriteHeader(status)
+			_, _ = w.ResponseWriter.Write(w.w.Bytes())
+		}
+		if status == eudore.StatusNotModified {
+			return
+		}
+		c.storage.SaveData(fullkey, &CacheResponse{
 			Expired: now.Add(dura),
-			Status:  w.Status(),
+			Status:  status,
 			Header:  w.h,
 			Body:    w.w.Bytes(),
 		})
@@ -94,7 +142,7 @@ func NewCacheFunc(dura time.Duration, options ...Option) Middleware {
 func newCache(options []Option) *cache {
 	c := &cache{
 		waits:   make(map[string]*sync.WaitGroup),
-		storage: new(cacheMap),
+		storage: NewCacheDataMap(),
 		GetKeyFunc: func(ctx eudore.Context) string {
 			if ctx.Method() != eudore.MethodGet ||
 				ctx.GetHeader(eudore.HeaderConnection) ==
@@ -117,6 +165,12 @@ func (c *cache) load(ctx eudore.Context, key string) *sync.WaitGroup {
 		if data != nil {
 			// write cache data
 			headerCopy(ctx.Response().Header(), data.Header)
+			if data.Status == eudore.StatusOK &&
+				eudore.CheckNotModified(ctx.Request(), data.Header) {
+				ctx.WriteHeader(eudore.StatusNotModified)
+				ctx.End()
+				return nil
+			}
 			ctx.WriteHeader(data.Status)
 			if len(data.Body) != 0 {
 				_, _ = ctx.Write(data.Body)
@@ -154,11 +208,13 @@ func formatAccept(accept string) string {
 	return strings.Join(accepts, ",")
 }
 
-// responseWriterCache defines cached response data.
+// responseWriterCache defines cached response data,
+// the response is buffered until the handler is done.
 type responseWriterCache struct {
 	eudore.ResponseWriter
-	w bytes.Buffer
-	h http.Header
+	w      bytes.Buffer
+	h      http.Header
+	status int
 }
 
 func (w *responseWriterCache) Unwrap() http.ResponseWriter {
@@ -166,33 +222,32 @@ func (w *responseWriterCache) Unwrap() http.ResponseWriter {
 }
 
 func (w *responseWriterCache) Write(data []byte) (int, error) {
-	if w.Size() == 0 {
-		headerCopy(w.ResponseWriter.Header(), w.h)
-	}
-	w.w.Write(data)
-	return w.ResponseWriter.Write(data)
+	return w.w.Write(data)
 }
 
 func (w *responseWriterCache) WriteString(data string) (int, error) {
-	if w.Size() == 0 {
-		headerCopy(w.ResponseWriter.Header(), w.h)
-	}
-	w.w.WriteString(data)
-	return w.ResponseWriter.WriteString(data)
+	return w.w.WriteString(data)
 }
 
-// The WriteHeader method adds the Cache-Header when writing for the first time.
 func (w *responseWriterCache) WriteHeader(code int) {
-	if w.Size() == 0 {
-		headerCopy(w.ResponseWriter.Header(), w.h)
-	}
-	w.ResponseWriter.WriteHeader(code)
+	w.status = code
 }
 
 func (w *responseWriterCache) Header() http.Header {
 	return w.h
 }
 
+func (w *responseWriterCache) Status() int {
+	if w.status == 0 {
+		return eudore.StatusOK
+	}
+	return w.status
+}
+
+func (w *responseWriterCache) Size() int {
+	return w.w.Len()
+}
+
 // refer: [responseWriterTimeout.Body].
 func (w *responseWriterCache) Body() []byte {
 	return w.w.Bytes()
@@ -206,16 +261,72 @@ func (w *responseWriterCache) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return nil, nil, eudore.ErrContextNotHijacker
 }
 
+// MarshalBinary method implements the [encoding.BinaryMarshaler] interface,
+// the format is the expired time and status line, MIME header and body.
+func (resp *CacheResponse) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %d\r\n", resp.Expired.Format(time.RFC3339Nano), resp.Status)
+	err := resp.Header.Write(&buf)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("\r\n")
+	buf.Write(resp.Body)
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary method implements the [encoding.BinaryUnmarshaler] interface.
+func (resp *CacheResponse) UnmarshalBinary(data []byte) error {
+	r := textproto.NewReader(bufio.NewReader(bytes.NewReader(data)))
+	line, err := r.ReadLine()
+	if err != nil {
+		return err
+	}
+	expired, status, _ := strings.Cut(line, " ")
+	resp.Expired, err = time.Parse(time.RFC3339Nano, expired)
+	if err != nil {
+		return err
+	}
+	resp.Status, err = strconv.Atoi(status)
+	if err != nil {
+		return err
+	}
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		return err
+	}
+	resp.Header = http.Header(header)
+	resp.Body, err = io.ReadAll(r.R)
+	return err
+}
+
+func (resp *CacheResponse) size(key string) int64 {
+	size := len(key) + len(resp.Body)
+	for k, vals := range resp.Header {
+		size += len(k)
+		for _, v := range vals {
+			size += len(v)
+		}
+	}
+	return int64(size)
+}
+
 type cacheMap struct {
 	sync.Map
 }
 
-func (c *cacheMap) LoadData(key string) *cacheResponse {
+// NewCacheDataMap function creates [CacheData] using [sync.Map],
+// which is the default storage.
+func NewCacheDataMap() CacheData {
+	return &cacheMap{}
+}
+
+func (c *cacheMap) LoadData(key string) *CacheResponse {
 	data, ok := c.Map.Load(key)
 	if !ok {
 		return nil
 	}
-	item := data.(*cacheResponse)
+	item := data.(*CacheResponse)
 	if time.Now().After(item.Expired) {
 		c.Map.Delete(key)
 		return nil
@@ -223,7 +334,7 @@ func (c *cacheMap) LoadData(key string) *cacheResponse {
 	return item
 }
 
-func (c *cacheMap) SaveData(key string, val *cacheResponse) {
+func (c *cacheMap) SaveData(key string, val *CacheResponse) {
 	c.Map.Store(key, val)
 }
 
@@ -232,7 +343,7 @@ func (c *cacheMap) cleanupExpired(ctx context.Context, ttl time.Duration) {
 		select {
 		case now := <-time.After(ttl):
 			c.Map.Range(func(key, value any) bool {
-				item := value.(*cacheResponse)
+				item := value.(*CacheResponse)
 				if now.After(item.Expired) {
 					c.Map.Delete(key)
 				}
@@ -243,3 +354,211 @@ func (c *cacheMap) cleanupExpired(ctx context.Context, ttl time.Duration) {
 		}
 	}
 }
+
+type cacheLRU struct {
+	sync.Mutex
+	list       *list.List
+	items      map[string]*list.Element
+	size       int64
+	maxEntries int
+	maxBytes   int64
+}
+
+type cacheLRUEntry struct {
+	key  string
+	size int64
+	data *CacheResponse
+}
+
+// NewCacheDataLRU function creates a bounded LRU [CacheData] in memory.
+//
+// The least recently used data is evicted when the number exceeds maxEntries
+// or the size of key, header and body exceeds maxBytes,
+// data larger than maxBytes will not be saved.
+// A limit less than or equal to 0 means no limit.
+func NewCacheDataLRU(maxEntries int, maxBytes int64) CacheData {
+	return &cacheLRU{
+		list:       list.New(),
+		items:      make(map[string]*list.Element),
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+	}
+}
+
+func (c *cacheLRU) LoadData(key string) *CacheResponse {
+	c.Lock()
+	defer c.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*cacheLRUEntry)
+	if time.Now().After(entry.data.Expired) {
+		c.remove(elem)
+		return nil
+	}
+	c.list.MoveToFront(elem)
+	return entry.data
+}
+
+func (c *cacheLRU) SaveData(key string, val *CacheResponse) {
+	size := val.size(key)
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+	if c.maxBytes > 0 && size > c.maxBytes {
+		return
+	}
+
+	c.items[key] = c.list.PushFront(&cacheLRUEntry{key, size, val})
+	c.size += size
+	for (c.maxEntries > 0 && c.list.Len() > c.maxEntries) ||
+		(c.maxBytes > 0 && c.size > c.maxBytes) {
+		c.remove(c.list.Back())
+	}
+}
+
+func (c *cacheLRU) remove(elem *list.Element) {
+	entry := c.list.Remove(elem).(*cacheLRUEntry)
+	delete(c.items, entry.key)
+	c.size -= entry.size
+}
+
+func (c *cacheLRU) cleanupExpired(ctx context.Context, ttl time.Duration) {
+	for {
+		select {
+		case now := <-time.After(ttl):
+			c.Lock()
+			for elem := c.list.Back(); elem != nil; {
+				prev := elem.Prev()
+				if now.After(elem.Value.(*cacheLRUEntry).data.Expired) {
+					c.remove(elem)
+				}
+				elem = prev
+			}
+			c.Unlock()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type cacheStore struct {
+	CacheStore
+}
+
+// NewCacheDataStore function creates [CacheData] using any key/value
+// [CacheStore], data is encoded by [CacheResponse.MarshalBinary].
+//
+// If the store is shared, multiple instances can share cached data.
+func NewCacheDataStore(store CacheStore) CacheData {
+	return &cacheStore{store}
+}
+
+func (c *cacheStore) LoadData(key string) *CacheResponse {
+	body, err := c.Get(key)
+	if err != nil || body == nil {
+		return nil
+	}
+	data := &CacheResponse{}
+	err = data.UnmarshalBinary(body)
+	if err != nil || time.Now().After(data.Expired) {
+		return nil
+	}
+	return data
+}
+
+func (c *cacheStore) SaveData(key string, val *CacheResponse) {
+	ttl := time.Until(val.Expired)
+	if ttl <= 0 {
+		return
+	}
+	body, err := val.MarshalBinary()
+	if err == nil {
+		_ = c.Set(key, body, ttl)
+	}
+}
+
+func (c *cacheStore) cleanupExpired(ctx context.Context, ttl time.Duration) {
+	clean, ok := c.CacheStore.(interface {
+		cleanupExpired(context.Context, time.Duration)
+	})
+	if ok {
+		clean.cleanupExpired(ctx, ttl)
+	}
+}
+
+type cacheFile struct {
+	dir string
+}
+
+// NewCacheDataFile function creates [CacheData] that saves data to files in
+// the dir, the file name is the sha256 of the key.
+//
+// If dir is a shared file system, multiple instances can share cached data.
+func NewCacheDataFile(dir string) CacheData {
+	return &cacheStore{&cacheFile{dir}}
+}
+
+func (c *cacheFile) name(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+func (c *cacheFile) Get(key string) ([]byte, error) {
+	return os.ReadFile(c.name(key))
+}
+
+// The Set method writes a temporary file and then renames it.
+func (c *cacheFile) Set(key string, val []byte, _ time.Duration) error {
+	err := os.MkdirAll(c.dir, 0o755)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(val)
+	if err1 := file.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), c.name(key))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return err
+}
+
+func (c *cacheFile) cleanupExpired(ctx context.Context, ttl time.Duration) {
+	for {
+		select {
+		case now := <-time.After(ttl):
+			entries, _ := os.ReadDir(c.dir)
+			for _, entry := range entries {
+				name := filepath.Join(c.dir, entry.Name())
+				if c.isExpired(name, now) {
+					_ = os.Remove(name)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// The isExpired method only reads the first line of the file.
+func (c *cacheFile) isExpired(name string, now time.Time) bool {
+	file, err := os.Open(name)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+	line, _ := bufio.NewReader(file).ReadString(' ')
+	expired, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(line))
+	return err == nil && now.After(expired)
+}
@@ -3,8 +3,10 @@ package middleware
 import (
 	"compress/flate"
 	"compress/gzip"
+	"encoding/binary"
 	"fmt"
 	"io"
+	"math/bits"
 	"net/http"
 	"sort"
 	"strconv"
@@ -44,7 +46,7 @@ func compressionWriterFlate() any {
 //	}
 //
 // Use [eudore.HeaderAcceptEncoding] to negotiate whether to compress the
-// response body.
+// response body, the compression with zero weight is not acceptable.
 // Disable compression in the following cases.
 //
 // 1. Websocket	The [net.Conn] used does not have a response body.
@@ -57,8 +59,11 @@ func compressionWriterFlate() any {
 // The compression effect is poor.
 // The mime define: [DefaultCompressionDisableMime].
 //
+// options: [NewOptionCompressionLength] [NewOptionCompressionMime].
+//
 //go:noinline
-func NewCompressionFunc(name string, fn func() any) Middleware {
+func NewCompressionFunc(name string, fn func() any, options ...Option,
+) Middleware {
 	const ae = eudore.HeaderAcceptEncoding
 	const conn = eudore.HeaderConnection
 	if fn == nil {
@@ -67,11 +72,12 @@ func NewCompressionFunc(name string, fn func() any) Middleware {
 	if fn == nil {
 		panic(fmt.Errorf(ErrCompressMissingEncoder, name))
 	}
-	pool := newCompressPool(name, fn)
+	names := []string{name}
+	pool := newCompressPool(name, fn, newCompressConfig(options))
 	return func(ctx eudore.Context) {
 		// check enable compression
-		if !strings.Contains(ctx.GetHeader(ae), name) ||
-			ctx.GetHeader(conn) == eudore.HeaderValueUpgrade {
+		if ctx.GetHeader(conn) == eudore.HeaderValueUpgrade ||
+			getCompresssOrder(ctx.GetHeader(ae), names) == -1 {
 			return
 		}
 
@@ -85,29 +91,30 @@ func NewCompressionFunc(name string, fn func() any) Middleware {
 // The default compresss is: [DefaultCompressionEncoder].
 //
 // The default compress is [CompressionNameGzip] and [CompressionNameDeflate],
-// and the compress [CompressionNameZstandard] and [CompressionNameBrotli] require a
-// specified constructor.
+// the compress [CompressionNameZstandard] and [CompressionNameBrotli] require
+// a specified constructor, or use [NewOptionCompressionEncoder] to add the
+// pure Go [NewCompressionWriterZstandard] and [NewCompressionWriterBrotli].
+//
+// [eudore.HeaderAcceptEncoding] selects the compress with the highest weight,
+// and the same weight order is based on [DefaultCompressionOrder].
 //
-// [eudore.HeaderAcceptEncoding] value ignores non-zero weight values,
-// and the order is based on [DefaultCompressionOrder].
+// options: [NewOptionCompressionLength] [NewOptionCompressionMime]
+// [NewOptionCompressionEncoder].
 //
 // refer: [NewCompressionFunc].
-func NewCompressionMixinsFunc(compresss map[string]func() any) Middleware {
-	names, pools := initCompress(compresss)
+func NewCompressionMixinsFunc(compresss map[string]func() any,
+	options ...Option,
+) Middleware {
+	names, pools := initCompress(compresss, newCompressConfig(options))
 	return func(ctx eudore.Context) {
-		encoding := ctx.GetHeader(eudore.HeaderAcceptEncoding)
-		if encoding == "" ||
-			encoding == CompressionNameIdentity ||
-			ctx.GetHeader(eudore.HeaderConnection) ==
-				eudore.HeaderValueUpgrade {
+		if ctx.GetHeader(eudore.HeaderConnection) ==
+			eudore.HeaderValueUpgrade {
 			return
 		}
 
-		for i := range names {
-			if strings.Contains(encoding, names[i]) {
-				handlerCompress(ctx, pools[i])
-				return
-			}
+		i := getCompresssOrder(ctx.GetHeader(eudore.HeaderAcceptEncoding), names)
+		if i != -1 {
+			handlerCompress(ctx, pools[i])
 		}
 	}
 }
@@ -125,10 +132,34 @@ func handlerCompress(ctx eudore.Context, pool *sync.Pool) {
 	ctx.Next()
 }
 
-func initCompress(compresss map[string]func() any) ([]string, []*sync.Pool) {
+// compressConfig defines the options of compression.
+type compressConfig struct {
+	length   int
+	mimes    map[string]struct{}
+	encoders map[string]func() any
+}
+
+func newCompressConfig(options []Option) *compressConfig {
+	c := &compressConfig{length: CompressionBufferLength}
+	applyOption(c, options)
+	return c
+}
+
+func initCompress(compresss map[string]func() any, config *compressConfig,
+) ([]string, []*sync.Pool) {
 	if compresss == nil {
 		compresss = DefaultCompressionEncoder
 	}
+	if config.encoders != nil {
+		encoders := make(map[string]func() any, len(compresss)+len(config.encoders))
+		for name, fn := range compresss {
+			encoders[name] = fn
+		}
+		for name, fn := range config.encoders {
+			encoders[name] = fn
+		}
+		compresss = encoders
+	}
 
 	names := make([]string, 0, len(compresss))
 	pools := make([]*sync.Pool, 0, len(compresss))
@@ -136,16 +167,16 @@ func initCompress(compresss map[string]func() any) ([]string, []*sync.Pool) {
 		names = append(names, name)
 	}
 	sort.Slice(names, func(i, j int) bool {
-		return getCompresssOrder(names[i]) < getCompresssOrder(names[j])
+		return getCompresssPriority(names[i]) < getCompresssPriority(names[j])
 	})
 
 	for _, name := range names {
-		pools = append(pools, newCompressPool(name, compresss[name]))
+		pools = append(pools, newCompressPool(name, compresss[name], config))
 	}
 	return names, pools
 }
 
-func getCompresssOrder(val string) int {
+func getCompresssPriority(val string) int {
 	for i := range DefaultCompressionOrder {
 		if val == DefaultCompressionOrder[i] {
 			return i
@@ -154,7 +185,74 @@ func getCompresssOrder(val string) int {
 	return len(DefaultCompressionOrder)
 }
 
-func newCompressPool(name string, fn func() any) *sync.Pool {
+// The getCompresssOrder function negotiates the compression by the weight of
+// [eudore.HeaderAcceptEncoding] and returns the index of names,
+// the same weight is selected by the order of names,
+// "*" matches the names that are not listed.
+//
+// Returns -1 if no compression is acceptable.
+func getCompresssOrder(encoding string, names []string) int {
+	index, quality, wildcard := -1, 0.0, 0.0
+	for item := encoding; item != ""; {
+		var val string
+		val, item, _ = strings.Cut(item, ",")
+		name, q := getCompresssQuality(val)
+		if name == "*" {
+			wildcard = q
+			continue
+		}
+		for i := range names {
+			if strings.EqualFold(name, names[i]) &&
+				(q > quality || q == quality && q > 0 && i < index) {
+				index, quality = i, q
+			}
+		}
+	}
+
+	if wildcard > 0 && wildcard >= quality {
+		for i := range names {
+			if (wildcard > quality || i < index) &&
+				!getCompresssListed(encoding, names[i]) {
+				return i
+			}
+		}
+	}
+	return index
+}
+
+// The getCompresssQuality function parses the name and q value of
+// an encoding, invalid q value is 0.
+func getCompresssQuality(val string) (string, float64) {
+	name, params, _ := strings.Cut(val, ";")
+	for params != "" {
+		var param string
+		param, params, _ = strings.Cut(params, ";")
+		k, v, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if k == "q" || k == "Q" {
+			q, err := strconv.ParseFloat(v, 64)
+			if err != nil || q < 0 {
+				q = 0
+			}
+			return strings.TrimSpace(name), q
+		}
+	}
+	return strings.TrimSpace(name), 1
+}
+
+func getCompresssListed(encoding, name string) bool {
+	for encoding != "" {
+		var val string
+		val, encoding, _ = strings.Cut(encoding, ",")
+		n, _ := getCompresssQuality(val)
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func newCompressPool(name string, fn func() any, config *compressConfig,
+) *sync.Pool {
 	_, ok := fn().(compressor)
 	if !ok {
 		panic(fmt.Errorf(ErrCompressInvalidEncoder, name))
@@ -164,7 +262,8 @@ func newCompressPool(name string, fn func() any) *sync.Pool {
 			return &responseWriterCompress{
 				name:   name,
 				Writer: fn().(compressor),
-				buffer: make([]byte, CompressionBufferLength),
+				buffer: make([]byte, config.length),
+				config: config,
 			}
 		},
 	}
@@ -179,6 +278,7 @@ type responseWriterCompress struct {
 	buffer []byte
 	code   int
 	name   string
+	config *compressConfig
 }
 
 type compressor interface {
@@ -214,7 +314,7 @@ func (w *responseWriterCompress) Write(data []byte) (int, error) {
 	case compressionStateDisable:
 		return w.ResponseWriter.Write(data)
 	default:
-		if len(data)+len(w.buffer) <= CompressionBufferLength {
+		if len(data)+len(w.buffer) <= w.config.length {
 			w.state = compressionStateBuffer
 			w.buffer = append(w.buffer, data...)
 			return len(data), nil
@@ -233,7 +333,7 @@ func (w *responseWriterCompress) WriteString(data string) (int, error) {
 	case compressionStateDisable:
 		return w.ResponseWriter.WriteString(data)
 	default:
-		if len(data)+len(w.buffer) <= CompressionBufferLength {
+		if len(data)+len(w.buffer) <= w.config.length {
 			w.state = compressionStateBuffer
 			w.buffer = append(w.buffer, data...)
 			return len(data), nil
@@ -282,8 +382,8 @@ func (w *responseWriterCompress) init() {
 		contenttype = contenttype[:pos]
 	}
 
-	_, ok := DefaultCompressionDisableMime[contenttype]
-	if ok || skipCompress(h) || h.Get(eudore.HeaderContentEncoding) != "" {
+	if !w.allowMime(contenttype) || skipCompress(h, w.config.length) ||
+		h.Get(eudore.HeaderContentEncoding) != "" {
 		w.state = compressionStateDisable
 	} else {
 		w.state = compressionStateEnable
@@ -297,14 +397,32 @@ func (w *responseWriterCompress) init() {
 	}
 }
 
-func skipCompress(h http.Header) bool {
+// The allowMime method checks [DefaultCompressionDisableMime] and the mime
+// allowlist, the allowlist supports wildcard subtype.
+func (w *responseWriterCompress) allowMime(mime string) bool {
+	_, ok := DefaultCompressionDisableMime[mime]
+	if ok {
+		return false
+	}
+	if w.config.mimes == nil {
+		return true
+	}
+	_, ok = w.config.mimes[mime]
+	if !ok {
+		major, _, _ := strings.Cut(mime, "/")
+		_, ok = w.config.mimes[major+"/*"]
+	}
+	return ok
+}
+
+func skipCompress(h http.Header, size int) bool {
 	length := h.Get(eudore.HeaderContentLength)
 	if length == "" {
 		return false
 	}
 
 	v, err := strconv.ParseInt(length, 10, 64)
-	if err != nil || v > CompressionBufferLength {
+	if err != nil || v > int64(size) {
 		h.Del(eudore.HeaderContentLength)
 		return h.Get(eudore.HeaderContentEncoding) != ""
 	}
@@ -342,3 +460,257 @@ func (w *responseWriterCompress) Status() int {
 	m := w.code >> 31
 	return (w.code + m) ^ m
 }
+
+// compressSequence defines a LZ77 match and the literals before it.
+type compressSequence struct {
+	literals int
+	length   int
+	distance int
+}
+
+// compressMatcher defines the LZ77 hash chain match finder
+// shared by brotli and zstd.
+//
+// The prev is a ring buffer indexed by position and window mask,
+// the position stored in head and prev is increased by 1.
+type compressMatcher struct {
+	head      []int32
+	prev      []int32
+	mask      int
+	maxDist   int
+	depth     int
+	lazy      bool
+	insertAll bool
+	hashed    int
+}
+
+const (
+	compressHashBits  = 15
+	compressMinMatch  = 4
+	compressLazyMatch = 64
+)
+
+// The newCompressMatcher function creates match finder,
+// effort is 0-9, the higher the effort, the longer the search.
+func newCompressMatcher(effort int, windowBits uint) *compressMatcher {
+	if effort < 0 {
+		effort = 0
+	} else if effort > 9 {
+		effort = 9
+	}
+	window := 1 << windowBits
+	return &compressMatcher{
+		head:      make([]int32, 1<<compressHashBits),
+		prev:      make([]int32, window),
+		mask:      window - 1,
+		maxDist:   window - 16,
+		depth:     [...]int{1, 2, 4, 8, 16, 32, 64, 128, 256, 1024}[effort],
+		lazy:      effort >= 2,
+		insertAll: effort >= 1,
+	}
+}
+
+func (m *compressMatcher) reset() {
+	for i := range m.head {
+		m.head[i] = 0
+	}
+	m.hashed = 0
+}
+
+// The slide method moves all positions forward by delta,
+// delta must be a multiple of the window size.
+func (m *compressMatcher) slide(delta int) {
+	for _, table := range [...][]int32{m.head, m.prev} {
+		for i, v := range table {
+			if int(v) > delta {
+				table[i] = v - int32(delta)
+			} else {
+				table[i] = 0
+			}
+		}
+	}
+	m.hashed -= delta
+}
+
+func compressHash(b []byte) uint32 {
+	return (binary.LittleEndian.Uint32(b) * 0x1e35a7bd) >> (32 - compressHashBits)
+}
+
+// The update method inserts all positions before pos.
+func (m *compressMatcher) update(buf []byte, pos int) {
+	for ; m.hashed < pos; m.hashed++ {
+		h := compressHash(buf[m.hashed:])
+		m.prev[m.hashed&m.mask] = m.head[h]
+		m.head[h] = int32(m.hashed + 1)
+	}
+}
+
+// The find method returns the longest match at pos before end.
+func (m *compressMatcher) find(buf []byte, pos, end int) (int, int) {
+	length, dist := 0, 0
+	limit := buf[pos:end]
+	cand := int(m.head[compressHash(buf[pos:])]) - 1
+	for i := 0; i < m.depth && cand >= 0; i++ {
+		d := pos - cand
+		if d <= 0 || d > m.maxDist {
+			break
+		}
+		if buf[cand+length] == limit[length] {
+			n := compressMatchLength(buf[cand:], limit)
+			if n > length {
+				length, dist = n, d
+				if n == len(limit) {
+					break
+				}
+			}
+		}
+		cand = int(m.prev[cand&m.mask]) - 1
+	}
+	if length < compressMinMatch {
+		return 0, 0
+	}
+	return length, dist
+}
+
+func compressMatchLength(a, b []byte) int {
+	n := 0
+	for n+8 <= len(b) {
+		x := binary.LittleEndian.Uint64(a[n:]) ^ binary.LittleEndian.Uint64(b[n:])
+		if x != 0 {
+			return n + bits.TrailingZeros64(x)>>3
+		}
+		n += 8
+	}
+	for n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// The parse method finds the sequences of buf[start:end],
+// the literals after the last sequence are not returned.
+func (m *compressMatcher) parse(buf []byte, start, end int,
+	seqs []compressSequence,
+) []compressSequence {
+	lit, pos := start, start
+	for pos+compressMinMatch <= end {
+		m.update(buf, pos)
+		length, dist := m.find(buf, pos, end)
+		if length == 0 {
+			pos++
+			continue
+		}
+		if m.lazy && length < compressLazyMatch && pos+1+compressMinMatch <= end {
+			m.update(buf, pos+1)
+			next, _ := m.find(buf, pos+1, end)
+			if next > length {
+				pos++
+				continue
+			}
+		}
+
+		seqs = append(seqs, compressSequence{pos - lit, length, dist})
+		pos += length
+		lit = pos
+		if !m.insertAll {
+			m.hashed = pos
+		}
+	}
+	return seqs
+}
+
+// compressBitWriter defines the LSB-first bit writer
+// shared by brotli and zstd.
+type compressBitWriter struct {
+	out   []byte
+	bits  uint64
+	nbits uint
+}
+
+// The writeBits method writes the low n bits of v, n must be less than 32.
+func (w *compressBitWriter) writeBits(v uint64, n uint) {
+	w.bits |= (v & (1<<n - 1)) << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.out = append(w.out, byte(w.bits))
+		w.bits >>= 8
+		w.nbits -= 8
+	}
+}
+
+// The align method pads zero bits to the byte boundary.
+func (w *compressBitWriter) align() {
+	if w.nbits > 0 {
+		w.out = append(w.out, byte(w.bits))
+		w.bits, w.nbits = 0, 0
+	}
+}
+
+// The compressHuffmanLengths function computes the huffman code lengths of
+// freqs limited to maxBits,
+// the minimum count is doubled until the depth is limited.
+//
+// If only one symbol is used, its length is 1.
+func compressHuffmanLengths(freqs []uint32, maxBits uint8, lengths []uint8) {
+	syms := make([]int, 0, len(freqs))
+	for i, f := range freqs {
+		lengths[i] = 0
+		if f > 0 {
+			syms = append(syms, i)
+		}
+	}
+	switch len(syms) {
+	case 0:
+		return
+	case 1:
+		lengths[syms[0]] = 1
+		return
+	}
+	sort.SliceStable(syms, func(i, j int) bool {
+		return freqs[syms[i]] < freqs[syms[j]]
+	})
+
+	n := len(syms)
+	counts := make([]uint32, 2*n-1)
+	parent := make([]int32, 2*n-1)
+	depths := make([]uint8, 2*n-1)
+	for limit := uint32(1); ; limit <<= 1 {
+		for i, s := range syms {
+			counts[i] = freqs[s]
+			if counts[i] < limit {
+				counts[i] = limit
+			}
+		}
+		// two queues merge of leaves and nodes
+		leaf, node := 0, n
+		for k := n; k < 2*n-1; k++ {
+			var pick [2]int
+			for j := range pick {
+				if leaf < n && (node >= k || counts[leaf] <= counts[node]) {
+					pick[j] = leaf
+					leaf++
+				} else {
+					pick[j] = node
+					node++
+				}
+			}
+			counts[k] = counts[pick[0]] + counts[pick[1]]
+			parent[pick[0]], parent[pick[1]] = int32(k), int32(k)
+		}
+
+		depths[2*n-2] = 0
+		maxDepth := uint8(0)
+		for k := 2*n - 3; k >= 0; k-- {
+			depths[k] = depths[parent[k]] + 1
+			if k < n && depths[k] > maxDepth {
+				maxDepth = depths[k]
+			}
+		}
+		if maxDepth <= maxBits {
+			for i, s := range syms {
+				lengths[s] = depths[i]
+			}
+			return
+		}
+	}
+}
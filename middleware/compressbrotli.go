@@ -0,0 +1,495 @@
+package middleware
+
+import (
+	"io"
+	"math/bits"
+	"sort"
+)
+
+// The NewCompressionWriterBrotli function creates a pure Go brotli
+// [compressor] constructor, refer RFC 7932.
+//
+// The quality is 0-11, the higher the quality, the longer the match search
+// and the larger the window, the default quality is 5.
+//
+// The meta-block uses a single block type for each category
+// and does not use the static dictionary.
+func NewCompressionWriterBrotli(quality int) func() any {
+	effort := quality - 2
+	windowBits := uint(18)
+	switch {
+	case quality >= 9:
+		windowBits = 20
+	case quality >= 6:
+		windowBits = 19
+	}
+	return func() any {
+		return &brotliWriter{
+			matcher:    newCompressMatcher(effort, windowBits),
+			windowBits: windowBits,
+			lastDist:   brotliInitDistance,
+		}
+	}
+}
+
+const (
+	brotliBlockSize         = 1 << 16
+	brotliInitDistance      = 4
+	brotliLiteralBits       = 8
+	brotliCommandBits       = 10
+	brotliDistanceBits      = 6
+	brotliHuffmanMaxBits    = 15
+	brotliCodeLengthMaxBits = 5
+	brotliRepeatPrevious    = 16
+	brotliRepeatZero        = 17
+)
+
+var (
+	brotliInsertBase = [24]uint32{
+		0, 1, 2, 3, 4, 5, 6, 8, 10, 14, 18, 26,
+		34, 50, 66, 98, 130, 194, 322, 578, 1090, 2114, 6210, 22594,
+	}
+	brotliInsertBits = [24]uint8{
+		0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3,
+		4, 4, 5, 5, 6, 7, 8, 9, 10, 12, 14, 24,
+	}
+	brotliCopyBase = [24]uint32{
+		2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 18,
+		22, 30, 38, 54, 70, 102, 134, 198, 326, 582, 1094, 2118,
+	}
+	brotliCopyBits = [24]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2,
+		3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 24,
+	}
+	// the insert and copy cells with explicit distance.
+	brotliCommandCell = [3][3]uint16{
+		{128, 192, 384},
+		{256, 320, 512},
+		{448, 576, 640},
+	}
+	brotliCodeLengthOrder = [18]uint8{
+		1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+	}
+	brotliCodeLengthCodes   = [6]uint8{0, 7, 3, 2, 1, 15}
+	brotliCodeLengthLengths = [6]uint8{2, 4, 3, 2, 2, 4}
+)
+
+// brotliWriter defines the streaming brotli writer,
+// buf saves the window history and the pending data after pos.
+type brotliWriter struct {
+	writer     io.Writer
+	matcher    *compressMatcher
+	windowBits uint
+	buf        []byte
+	pos        int
+	header     bool
+	lastDist   int
+	seqs       []compressSequence
+	commands   []brotliCommand
+	bw         compressBitWriter
+	err        error
+}
+
+// brotliCommand defines the insert and copy command of meta-block.
+type brotliCommand struct {
+	literals int
+	insert   int
+	copy     int
+	code     uint16
+	dcode    uint8
+	dbits    uint8
+	dextra   uint32
+	distance bool
+}
+
+// brotliHuffman defines the prefix code of an alphabet.
+type brotliHuffman struct {
+	lengths []uint8
+	codes   []uint16
+}
+
+func (w *brotliWriter) Reset(writer io.Writer) {
+	w.writer = writer
+	w.matcher.reset()
+	w.buf = w.buf[:0]
+	w.pos = 0
+	w.header = false
+	w.lastDist = brotliInitDistance
+	w.bw = compressBitWriter{out: w.bw.out[:0]}
+	w.err = nil
+}
+
+func (w *brotliWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := len(p)
+	for len(p) > 0 {
+		size := brotliBlockSize - (len(w.buf) - w.pos)
+		if size > len(p) {
+			size = len(p)
+		}
+		w.buf = append(w.buf, p[:size]...)
+		p = p[size:]
+		if len(w.buf)-w.pos == brotliBlockSize {
+			w.writeBlock(false, false)
+			if w.err != nil {
+				return n - len(p), w.err
+			}
+		}
+	}
+	return n, nil
+}
+
+// The Flush method writes the pending data as a meta-block and
+// an empty metadata block for byte alignment.
+func (w *brotliWriter) Flush() error {
+	if w.err == nil {
+		w.writeBlock(true, false)
+	}
+	return w.err
+}
+
+// The Close method writes the pending data and the last empty meta-block.
+func (w *brotliWriter) Close() error {
+	if w.err == nil {
+		w.writeBlock(false, true)
+	}
+	return w.err
+}
+
+func (w *brotliWriter) writeBlock(flush, last bool) {
+	bw := &w.bw
+	if !w.header {
+		w.header = true
+		// WBITS
+		bw.writeBits(uint64(w.windowBits-17)<<1|1, 4)
+	}
+	if len(w.buf) > w.pos {
+		w.writeMetaBlock(w.buf[w.pos:])
+		w.pos = len(w.buf)
+	}
+	switch {
+	case last:
+		// ISLAST and ISLASTEMPTY
+		bw.writeBits(3, 2)
+		bw.align()
+	case flush:
+		// ISLAST, MNIBBLES is 0, reserved and MSKIPBYTES is 0
+		bw.writeBits(6, 6)
+		bw.align()
+	}
+	_, w.err = w.writer.Write(bw.out)
+	bw.out = bw.out[:0]
+
+	// slide window
+	window := 1 << w.windowBits
+	if w.pos >= 2*window {
+		copy(w.buf, w.buf[window:])
+		w.buf = w.buf[:len(w.buf)-window]
+		w.pos -= window
+		w.matcher.slide(window)
+	}
+}
+
+// The writeMetaBlock method writes a compressed meta-block,
+// or uncompressed meta-block if the compression is invalid.
+func (w *brotliWriter) writeMetaBlock(data []byte) {
+	bw := &w.bw
+	nibbles := 4
+	for nibbles < 6 && len(data)-1 >= 1<<(4*nibbles) {
+		nibbles++
+	}
+	// ISLAST MNIBBLES MLEN-1
+	bw.writeBits(0, 1)
+	bw.writeBits(uint64(nibbles-4), 2)
+	bw.writeBits(uint64(len(data)-1), uint(nibbles*4))
+
+	size, nbits, value, dist := len(bw.out), bw.nbits, bw.bits, w.lastDist
+	// ISUNCOMPRESSED
+	bw.writeBits(0, 1)
+	w.appendCommands(w.pos, len(w.buf))
+	if len(bw.out)-size < len(data) {
+		return
+	}
+
+	bw.out, bw.nbits, bw.bits, w.lastDist = bw.out[:size], nbits, value, dist
+	bw.writeBits(1, 1)
+	bw.align()
+	bw.out = append(bw.out, data...)
+}
+
+// The appendCommands method appends the header and commands of
+// compressed meta-block.
+func (w *brotliWriter) appendCommands(start, end int) {
+	w.seqs = w.matcher.parse(w.buf, start, end, w.seqs[:0])
+	w.commands = w.commands[:0]
+	literals := make([]uint32, 1<<brotliLiteralBits)
+	commands := make([]uint32, 704)
+	distances := make([]uint32, 1<<brotliDistanceBits)
+	pos := start
+	for _, seq := range w.seqs {
+		cmd := brotliCommand{literals: pos, insert: seq.literals, copy: seq.length}
+		ins := brotliLengthCode(brotliInsertBase[:], seq.literals)
+		cp := brotliLengthCode(brotliCopyBase[:], seq.length)
+		switch {
+		case seq.distance == w.lastDist && ins < 8 && cp < 16:
+			// implicit distance code 0
+			cmd.code = uint16(cp>>3)<<6 | uint16(ins&7)<<3 | uint16(cp&7)
+		case seq.distance == w.lastDist:
+			cmd.distance = true
+		default:
+			d := uint32(seq.distance + 3)
+			nb := uint8(bits.Len32(d) - 2)
+			prefix := d >> nb & 1
+			cmd.distance = true
+			cmd.dcode = 16 + 2*(nb-1) + uint8(prefix)
+			cmd.dbits = nb
+			cmd.dextra = d - (2+prefix)<<nb
+			w.lastDist = seq.distance
+		}
+		if cmd.distance {
+			cmd.code = brotliCommandCell[ins>>3][cp>>3] |
+				uint16(ins&7)<<3 | uint16(cp&7)
+			distances[cmd.dcode]++
+		}
+		commands[cmd.code]++
+		for _, c := range w.buf[pos : pos+seq.literals] {
+			literals[c]++
+		}
+		w.commands = append(w.commands, cmd)
+		pos += seq.literals + seq.length
+	}
+	if pos < end {
+		// the last command ends after literals, copy length is ignored.
+		ins := brotliLengthCode(brotliInsertBase[:], end-pos)
+		cmd := brotliCommand{
+			literals: pos,
+			insert:   end - pos,
+			copy:     2,
+			code:     brotliCommandCell[ins>>3][0] | uint16(ins&7)<<3,
+		}
+		commands[cmd.code]++
+		for _, c := range w.buf[pos:end] {
+			literals[c]++
+		}
+		w.commands = append(w.commands, cmd)
+	}
+
+	bw := &w.bw
+	// NBLTYPESL NBLTYPESI NBLTYPESD NPOSTFIX NDIRECT
+	// context mode NTREESL NTREESD
+	bw.writeBits(0, 3)
+	bw.writeBits(0, 6)
+	bw.writeBits(0, 2)
+	bw.writeBits(0, 2)
+	literalCode := brotliWriteHuffman(bw, literals, brotliLiteralBits)
+	commandCode := brotliWriteHuffman(bw, commands, brotliCommandBits)
+	distanceCode := brotliWriteHuffman(bw, distances, brotliDistanceBits)
+
+	for _, cmd := range w.commands {
+		bw.writeBits(uint64(commandCode.codes[cmd.code]),
+			uint(commandCode.lengths[cmd.code]))
+		ins := brotliLengthCode(brotliInsertBase[:], cmd.insert)
+		cp := brotliLengthCode(brotliCopyBase[:], cmd.copy)
+		bw.writeBits(uint64(uint32(cmd.insert)-brotliInsertBase[ins]),
+			uint(brotliInsertBits[ins]))
+		bw.writeBits(uint64(uint32(cmd.copy)-brotliCopyBase[cp]),
+			uint(brotliCopyBits[cp]))
+		for _, c := range w.buf[cmd.literals : cmd.literals+cmd.insert] {
+			bw.writeBits(uint64(literalCode.codes[c]),
+				uint(literalCode.lengths[c]))
+		}
+		if cmd.distance {
+			bw.writeBits(uint64(distanceCode.codes[cmd.dcode]),
+				uint(distanceCode.lengths[cmd.dcode]))
+			bw.writeBits(uint64(cmd.dextra), uint(cmd.dbits))
+		}
+	}
+}
+
+// The brotliLengthCode function returns the insert or copy length code.
+func brotliLengthCode(base []uint32, n int) uint8 {
+	code := len(base) - 1
+	for base[code] > uint32(n) {
+		code--
+	}
+	return uint8(code)
+}
+
+// The brotliWriteHuffman function writes the prefix code of freqs,
+// uses simple prefix code if the symbols are less than 5.
+func brotliWriteHuffman(bw *compressBitWriter, freqs []uint32,
+	alphabetBits uint,
+) brotliHuffman {
+	code := brotliHuffman{
+		lengths: make([]uint8, len(freqs)),
+		codes:   make([]uint16, len(freqs)),
+	}
+	var syms []int
+	for i, f := range freqs {
+		if f > 0 {
+			syms = append(syms, i)
+		}
+	}
+	if len(syms) <= 1 {
+		// HSKIP is 1 and NSYM-1 is 0, the symbol uses 0 bits.
+		bw.writeBits(1, 4)
+		if len(syms) == 1 {
+			bw.writeBits(uint64(syms[0]), alphabetBits)
+		} else {
+			bw.writeBits(0, alphabetBits)
+		}
+		return code
+	}
+
+	compressHuffmanLengths(freqs, brotliHuffmanMaxBits, code.lengths)
+	brotliCanonicalCodes(code.lengths, code.codes)
+	if len(syms) <= 4 {
+		sort.SliceStable(syms, func(i, j int) bool {
+			return code.lengths[syms[i]] < code.lengths[syms[j]]
+		})
+		bw.writeBits(uint64(len(syms)-1)<<2|1, 4)
+		for _, s := range syms {
+			bw.writeBits(uint64(s), alphabetBits)
+		}
+		if len(syms) == 4 {
+			// tree-select
+			bw.writeBits(uint64(code.lengths[syms[0]]&1), 1)
+		}
+		return code
+	}
+
+	last := syms[len(syms)-1]
+	rle, extras := brotliEncodeLengths(code.lengths[:last+1])
+	var clFreqs [18]uint32
+	for _, s := range rle {
+		clFreqs[s]++
+	}
+	var clCode brotliHuffman
+	clCode.lengths = make([]uint8, 18)
+	clCode.codes = make([]uint16, 18)
+	compressHuffmanLengths(clFreqs[:], brotliCodeLengthMaxBits, clCode.lengths)
+	brotliCanonicalCodes(clCode.lengths, clCode.codes)
+
+	// only one code length code is used, all 18 code lengths are written
+	// and the symbol uses 0 bits.
+	num, end := 0, 0
+	for i, s := range brotliCodeLengthOrder {
+		if clCode.lengths[s] > 0 {
+			num++
+			end = i + 1
+		}
+	}
+	if num == 1 {
+		end = len(brotliCodeLengthOrder)
+	}
+	// HSKIP
+	bw.writeBits(0, 2)
+	for _, s := range brotliCodeLengthOrder[:end] {
+		l := clCode.lengths[s]
+		bw.writeBits(uint64(brotliCodeLengthCodes[l]),
+			uint(brotliCodeLengthLengths[l]))
+	}
+	if num == 1 {
+		for i := range clCode.lengths {
+			clCode.lengths[i] = 0
+		}
+	}
+	for i, s := range rle {
+		bw.writeBits(uint64(clCode.codes[s]), uint(clCode.lengths[s]))
+		switch s {
+		case brotliRepeatPrevious:
+			bw.writeBits(uint64(extras[i]), 2)
+		case brotliRepeatZero:
+			bw.writeBits(uint64(extras[i]), 3)
+		}
+	}
+	return code
+}
+
+// The brotliEncodeLengths function run-length encodes the code lengths
+// using repeat codes 16 and 17.
+func brotliEncodeLengths(lengths []uint8) ([]uint8, []uint8) {
+	var rle, extras []uint8
+	// The repeated codes are counted in base 4 or 8 when consecutive.
+	repeat := func(code uint8, shift uint, n int) {
+		start := len(rle)
+		n -= 3
+		for {
+			rle = append(rle, code)
+			extras = append(extras, uint8(n&(1<<shift-1)))
+			n >>= shift
+			if n == 0 {
+				break
+			}
+			n--
+		}
+		for i, j := start, len(rle)-1; i < j; i, j = i+1, j-1 {
+			extras[i], extras[j] = extras[j], extras[i]
+		}
+	}
+
+	prev := uint8(8)
+	for i := 0; i < len(lengths); {
+		v := lengths[i]
+		n := 1
+		for i+n < len(lengths) && lengths[i+n] == v {
+			n++
+		}
+		i += n
+		if v == 0 {
+			if n == 11 {
+				rle, extras = append(rle, 0), append(extras, 0)
+				n--
+			}
+			if n < 3 {
+				for ; n > 0; n-- {
+					rle, extras = append(rle, 0), append(extras, 0)
+				}
+			} else {
+				repeat(brotliRepeatZero, 3, n)
+			}
+			continue
+		}
+
+		if v != prev {
+			rle, extras = append(rle, v), append(extras, 0)
+			prev = v
+			n--
+		}
+		if n == 7 {
+			rle, extras = append(rle, v), append(extras, 0)
+			n--
+		}
+		if n < 3 {
+			for ; n > 0; n-- {
+				rle, extras = append(rle, v), append(extras, 0)
+			}
+		} else {
+			repeat(brotliRepeatPrevious, 2, n)
+		}
+	}
+	return rle, extras
+}
+
+// The brotliCanonicalCodes function assigns the canonical codes by lengths,
+// the codes are bit reversed for LSB-first writing.
+func brotliCanonicalCodes(lengths []uint8, codes []uint16) {
+	var counts, next [brotliHuffmanMaxBits + 1]uint16
+	for _, l := range lengths {
+		counts[l]++
+	}
+	counts[0] = 0
+	code := uint16(0)
+	for l := 1; l <= brotliHuffmanMaxBits; l++ {
+		code = (code + counts[l-1]) << 1
+		next[l] = code
+	}
+	for s, l := range lengths {
+		if l > 0 {
+			codes[s] = bits.Reverse16(next[l]) >> (16 - l)
+			next[l]++
+		}
+	}
+}
@@ -0,0 +1,821 @@
+package middleware
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"math/bits"
+)
+
+// The NewCompressionWriterZstandard function creates a pure Go zstd
+// [compressor] constructor, refer RFC 8878.
+//
+// The level is 1-22, the higher the level, the longer the match search and
+// the larger the window, the default level is 3.
+//
+// The literals use huffman coding, and the sequences use predefined or
+// per block FSE tables.
+func NewCompressionWriterZstandard(level int) func() any {
+	effort := (level - 1) / 2
+	windowBits := uint(18)
+	switch {
+	case effort >= 7:
+		windowBits = 20
+	case effort >= 4:
+		windowBits = 19
+	}
+	return func() any {
+		w := &zstdWriter{
+			matcher:    newCompressMatcher(effort, windowBits),
+			windowBits: windowBits,
+		}
+		w.checksum.reset()
+		return w
+	}
+}
+
+const (
+	zstdMagicNumber     = 0xFD2FB528
+	zstdBlockSize       = 1 << 17
+	zstdBlockRaw        = 0
+	zstdBlockCompressed = 2
+	zstdLiteralsRaw     = 0
+	zstdLiteralsRLE     = 1
+	zstdLiteralsHuff    = 2
+	zstdHuffmanMaxBits  = 11
+	zstdWeightsMaxLog   = 6
+	zstdModePredefined  = 0
+	zstdModeRLE         = 1
+	zstdModeCompressed  = 2
+)
+
+var (
+	zstdLiteralsLengthBase = [36]uint32{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 0x80, 0x100, 0x200, 0x400,
+		0x800, 0x1000, 0x2000, 0x4000, 0x8000, 0x10000,
+	}
+	zstdLiteralsLengthBits = [36]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16,
+	}
+	zstdMatchLengthBase = [53]uint32{
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 0x83, 0x103, 0x203,
+		0x403, 0x803, 0x1003, 0x2003, 0x4003, 0x8003, 0x10003,
+	}
+	zstdMatchLengthBits = [53]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16,
+	}
+	zstdLiteralsLengthTable = newFSETable([]int16{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}, 6)
+	zstdMatchLengthTable = newFSETable([]int16{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}, 6)
+	zstdOffsetTable = newFSETable([]int16{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}, 5)
+)
+
+// zstdWriter defines the streaming zstd frame writer,
+// buf saves the window history and the pending data after pos.
+type zstdWriter struct {
+	writer     io.Writer
+	matcher    *compressMatcher
+	windowBits uint
+	buf        []byte
+	pos        int
+	header     bool
+	checksum   compressXXHash64
+	seqs       []compressSequence
+	literals   []byte
+	out        []byte
+	err        error
+}
+
+func (w *zstdWriter) Reset(writer io.Writer) {
+	w.writer = writer
+	w.matcher.reset()
+	w.buf = w.buf[:0]
+	w.pos = 0
+	w.header = false
+	w.checksum.reset()
+	w.err = nil
+}
+
+func (w *zstdWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := len(p)
+	for len(p) > 0 {
+		size := zstdBlockSize - (len(w.buf) - w.pos)
+		if size > len(p) {
+			size = len(p)
+		}
+		w.buf = append(w.buf, p[:size]...)
+		p = p[size:]
+		if len(w.buf)-w.pos == zstdBlockSize {
+			w.writeBlock(false)
+			if w.err != nil {
+				return n - len(p), w.err
+			}
+		}
+	}
+	return n, nil
+}
+
+// The Flush method writes the pending data as a block.
+func (w *zstdWriter) Flush() error {
+	if w.err == nil && (len(w.buf) > w.pos || !w.header) {
+		w.writeBlock(false)
+	}
+	return w.err
+}
+
+// The Close method writes the last block and content checksum.
+func (w *zstdWriter) Close() error {
+	if w.err == nil {
+		w.writeBlock(true)
+	}
+	return w.err
+}
+
+func (w *zstdWriter) writeBlock(last bool) {
+	out := w.out[:0]
+	if !w.header {
+		w.header = true
+		out = binary.LittleEndian.AppendUint32(out, zstdMagicNumber)
+		// Content_Checksum_flag and Window_Descriptor
+		out = append(out, 0x04, byte(w.windowBits-10)<<3)
+	}
+
+	data := w.buf[w.pos:]
+	if len(data) > 0 || last {
+		start := len(out)
+		out = append(out, 0, 0, 0)
+		out = w.appendBlock(out, w.pos, len(w.buf))
+		typ, size := zstdBlockCompressed, len(out)-start-3
+		if size >= len(data) {
+			out = append(out[:start+3], data...)
+			typ, size = zstdBlockRaw, len(data)
+		}
+		header := uint32(typ<<1 | size<<3)
+		if last {
+			header |= 1
+		}
+		out[start], out[start+1], out[start+2] = byte(header), byte(header>>8), byte(header>>16)
+		w.checksum.Write(data)
+		w.pos = len(w.buf)
+	}
+	if last {
+		out = binary.LittleEndian.AppendUint32(out, uint32(w.checksum.Sum64()))
+	}
+	w.out = out
+	_, w.err = w.writer.Write(out)
+
+	// slide window
+	window := 1 << w.windowBits
+	if w.pos >= 2*window {
+		copy(w.buf, w.buf[window:])
+		w.buf = w.buf[:len(w.buf)-window]
+		w.pos -= window
+		w.matcher.slide(window)
+	}
+}
+
+// The appendBlock method appends the literals section and sequences section
+// of compressed block.
+func (w *zstdWriter) appendBlock(out []byte, start, end int) []byte {
+	w.seqs = w.matcher.parse(w.buf, start, end, w.seqs[:0])
+	w.literals = w.literals[:0]
+	pos := start
+	for _, seq := range w.seqs {
+		w.literals = append(w.literals, w.buf[pos:pos+seq.literals]...)
+		pos += seq.literals + seq.length
+	}
+	w.literals = append(w.literals, w.buf[pos:end]...)
+
+	out = zstdAppendLiterals(out, w.literals)
+	return zstdAppendSequences(out, w.seqs)
+}
+
+func zstdAppendLiteralsHeader(out []byte, typ, size int) []byte {
+	switch {
+	case size < 1<<5:
+		return append(out, byte(typ|size<<3))
+	case size < 1<<12:
+		v := typ | 1<<2 | size<<4
+		return append(out, byte(v), byte(v>>8))
+	default:
+		v := typ | 3<<2 | size<<4
+		return append(out, byte(v), byte(v>>8), byte(v>>16))
+	}
+}
+
+// The zstdAppendLiterals function appends the literals section,
+// use huffman coding if it is smaller than raw literals.
+func zstdAppendLiterals(out, lits []byte) []byte {
+	var freqs [256]uint32
+	for _, b := range lits {
+		freqs[b]++
+	}
+	if len(lits) > 1 && int(freqs[lits[0]]) == len(lits) {
+		out = zstdAppendLiteralsHeader(out, zstdLiteralsRLE, len(lits))
+		return append(out, lits[0])
+	}
+	if len(lits) >= 64 {
+		huff := zstdAppendHuffmanLiterals(out, lits, freqs[:])
+		if huff != nil && len(huff)-len(out) < len(lits) {
+			return huff
+		}
+	}
+	out = zstdAppendLiteralsHeader(out, zstdLiteralsRaw, len(lits))
+	return append(out, lits...)
+}
+
+// The zstdAppendHuffmanLiterals function appends huffman compressed literals,
+// return nil if the huffman tree cannot be described.
+func zstdAppendHuffmanLiterals(out, lits []byte, freqs []uint32) []byte {
+	var lengths [256]uint8
+	compressHuffmanLengths(freqs, zstdHuffmanMaxBits, lengths[:])
+	maxBits, lastSymbol := uint8(0), 0
+	for s, n := range lengths {
+		if n > 0 {
+			lastSymbol = s
+			if n > maxBits {
+				maxBits = n
+			}
+		}
+	}
+
+	// Prefix codes are assigned from the longest length.
+	var codes [256]uint16
+	var counts, next [zstdHuffmanMaxBits + 2]uint16
+	for _, n := range lengths {
+		counts[n]++
+	}
+	val := uint16(0)
+	for n := maxBits; n > 0; n-- {
+		next[n] = val
+		val = (val + counts[n]) >> 1
+	}
+	for s, n := range lengths {
+		if n > 0 {
+			codes[s] = next[n]
+			next[n]++
+		}
+	}
+
+	// Huffman_Tree_Description
+	weights := make([]byte, lastSymbol)
+	for s := range weights {
+		if lengths[s] > 0 {
+			weights[s] = maxBits + 1 - lengths[s]
+		}
+	}
+	tree := zstdCompressWeights(weights)
+	if tree == nil {
+		if lastSymbol > 128 {
+			return nil
+		}
+		tree = append(tree, byte(127+lastSymbol))
+		for i := 0; i < lastSymbol; i += 2 {
+			b := weights[i] << 4
+			if i+1 < lastSymbol {
+				b |= weights[i+1]
+			}
+			tree = append(tree, b)
+		}
+	}
+
+	var streams [][]byte
+	if len(lits) < 1<<10 {
+		streams = [][]byte{lits}
+	} else {
+		size := (len(lits) + 3) / 4
+		streams = [][]byte{lits[:size], lits[size : 2*size], lits[2*size : 3*size], lits[3*size:]}
+	}
+	data := tree
+	jump := len(data)
+	if len(streams) == 4 {
+		data = append(data, 0, 0, 0, 0, 0, 0)
+	}
+	for i, stream := range streams {
+		bw := compressBitWriter{out: data}
+		for j := len(stream) - 1; j >= 0; j-- {
+			bw.writeBits(uint64(codes[stream[j]]), uint(lengths[stream[j]]))
+		}
+		bw.writeBits(1, 1)
+		bw.align()
+		size := len(bw.out) - len(data)
+		if i < 3 && len(streams) == 4 {
+			if size >= 1<<16 {
+				return nil
+			}
+			binary.LittleEndian.PutUint16(bw.out[jump+i*2:], uint16(size))
+		}
+		data = bw.out
+	}
+
+	regen, comp := len(lits), len(data)
+	switch {
+	case len(streams) == 1:
+		v := zstdLiteralsHuff | regen<<4 | comp<<14
+		out = append(out, byte(v), byte(v>>8), byte(v>>16))
+	case regen < 1<<10 && comp < 1<<10:
+		v := zstdLiteralsHuff | 1<<2 | regen<<4 | comp<<14
+		out = append(out, byte(v), byte(v>>8), byte(v>>16))
+	case regen < 1<<14 && comp < 1<<14:
+		v := zstdLiteralsHuff | 2<<2 | regen<<4 | comp<<18
+		out = binary.LittleEndian.AppendUint32(out, uint32(v))
+	default:
+		v := uint64(zstdLiteralsHuff | 3<<2 | regen<<4 | comp<<22)
+		out = append(out, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32))
+	}
+	return append(out, data...)
+}
+
+// The zstdCompressWeights function compresses huffman weights using FSE,
+// return nil if it is not smaller than the direct representation.
+func zstdCompressWeights(weights []byte) []byte {
+	var counts [zstdHuffmanMaxBits + 1]int
+	maxCount, maxWeight := 0, 0
+	for _, w := range weights {
+		counts[w]++
+		if counts[w] > maxCount {
+			maxCount = counts[w]
+		}
+		if int(w) > maxWeight {
+			maxWeight = int(w)
+		}
+	}
+	if len(weights) < 2 || maxCount == 1 || maxCount == len(weights) {
+		return nil
+	}
+
+	tableLog := bits.Len(uint(len(weights)-1)) - 2
+	if tableLog > zstdWeightsMaxLog {
+		tableLog = zstdWeightsMaxLog
+	}
+	if minLog := bits.Len(uint(maxWeight)) + 1; tableLog < minLog {
+		tableLog = minLog
+	}
+	if tableLog < 5 {
+		tableLog = 5
+	}
+	norm := fseNormalizeCount(counts[:maxWeight+1], len(weights), uint(tableLog))
+	if norm == nil {
+		return nil
+	}
+
+	bw := compressBitWriter{out: []byte{0}}
+	fseWriteNormalizedCount(&bw, norm, uint(tableLog))
+	table := newFSETable(norm, uint(tableLog))
+
+	// two interleaved states
+	var state1, state2 uint32
+	ip := len(weights)
+	if ip&1 == 1 {
+		state1 = table.init(weights[ip-1])
+		state2 = table.init(weights[ip-2])
+		table.encode(&bw, &state1, weights[ip-3])
+		ip -= 3
+	} else {
+		state2 = table.init(weights[ip-1])
+		state1 = table.init(weights[ip-2])
+		ip -= 2
+	}
+	for ip > 0 {
+		table.encode(&bw, &state2, weights[ip-1])
+		table.encode(&bw, &state1, weights[ip-2])
+		ip -= 2
+	}
+	table.flush(&bw, state2)
+	table.flush(&bw, state1)
+	bw.writeBits(1, 1)
+	bw.align()
+
+	size := len(bw.out) - 1
+	if size >= 128 || size >= (len(weights)+1)/2 {
+		return nil
+	}
+	bw.out[0] = byte(size)
+	return bw.out
+}
+
+// The zstdAppendSequences function appends the sequences section using
+// predefined FSE tables.
+func zstdAppendSequences(out []byte, seqs []compressSequence) []byte {
+	n := len(seqs)
+	switch {
+	case n < 128:
+		out = append(out, byte(n))
+	case n < 0x7F00:
+		out = append(out, byte(n>>8+128), byte(n))
+	default:
+		out = append(out, 255, byte(n-0x7F00), byte((n-0x7F00)>>8))
+	}
+	if n == 0 {
+		return out
+	}
+	codes := make([][3]uint8, n)
+	for i, seq := range seqs {
+		codes[i] = [3]uint8{
+			zstdLiteralsLengthCode(seq.literals),
+			zstdMatchLengthCode(seq.length),
+			uint8(bits.Len(uint(seq.distance+3)) - 1),
+		}
+	}
+
+	// Literals_Lengths_Mode Offsets_Mode Match_Lengths_Mode
+	pos := len(out)
+	out = append(out, 0)
+	var tables [3]*fseTable
+	for i, c := range [3]int{0, 2, 1} {
+		var mode byte
+		tables[c], mode, out = zstdAppendTable(out, codes, c)
+		out[pos] |= mode << (6 - 2*i)
+	}
+	zstdLiteralsLengthTable, zstdMatchLengthTable, zstdOffsetTable := tables[0], tables[1], tables[2]
+
+	bw := compressBitWriter{out: out}
+	writeExtra := func(i int) {
+		ll, ml, of := codes[i][0], codes[i][1], codes[i][2]
+		bw.writeBits(uint64(uint32(seqs[i].literals)-zstdLiteralsLengthBase[ll]),
+			uint(zstdLiteralsLengthBits[ll]))
+		bw.writeBits(uint64(uint32(seqs[i].length)-zstdMatchLengthBase[ml]),
+			uint(zstdMatchLengthBits[ml]))
+		bw.writeBits(uint64(seqs[i].distance+3-1<<of), uint(of))
+	}
+
+	stateML := zstdMatchLengthTable.init(codes[n-1][1])
+	stateOF := zstdOffsetTable.init(codes[n-1][2])
+	stateLL := zstdLiteralsLengthTable.init(codes[n-1][0])
+	writeExtra(n - 1)
+	for i := n - 2; i >= 0; i-- {
+		zstdOffsetTable.encode(&bw, &stateOF, codes[i][2])
+		zstdMatchLengthTable.encode(&bw, &stateML, codes[i][1])
+		zstdLiteralsLengthTable.encode(&bw, &stateLL, codes[i][0])
+		writeExtra(i)
+	}
+	zstdMatchLengthTable.flush(&bw, stateML)
+	zstdOffsetTable.flush(&bw, stateOF)
+	zstdLiteralsLengthTable.flush(&bw, stateLL)
+	bw.writeBits(1, 1)
+	bw.align()
+	return bw.out
+}
+
+// The zstdAppendTable function selects the table mode of the codes by the
+// estimated size and appends the table description.
+func zstdAppendTable(out []byte, codes [][3]uint8, c int) (*fseTable, byte, []byte) {
+	predefined := [3]*fseTable{
+		zstdLiteralsLengthTable, zstdMatchLengthTable, zstdOffsetTable,
+	}[c]
+	counts := make([]int, len(predefined.norm))
+	maxSymbol := 0
+	for i := range codes {
+		s := int(codes[i][c])
+		counts[s]++
+		if s > maxSymbol {
+			maxSymbol = s
+		}
+	}
+	if counts[codes[0][c]] == len(codes) && len(codes) > 2 {
+		norm := make([]int16, maxSymbol+1)
+		norm[maxSymbol] = 1
+		return newFSETable(norm, 0), zstdModeRLE, append(out, byte(maxSymbol))
+	}
+	if len(codes) < 16 {
+		return predefined, zstdModePredefined, out
+	}
+
+	maxLog := [3]int{9, 9, 8}[c]
+	tableLog := bits.Len(uint(len(codes)-1)) - 2
+	if tableLog > maxLog {
+		tableLog = maxLog
+	}
+	if minLog := bits.Len(uint(maxSymbol)) + 1; tableLog < minLog {
+		tableLog = minLog
+	}
+	if tableLog < 5 {
+		tableLog = 5
+	}
+	norm := fseNormalizeCount(counts[:maxSymbol+1], len(codes), uint(tableLog))
+	if norm == nil {
+		return predefined, zstdModePredefined, out
+	}
+	bw := compressBitWriter{out: out}
+	fseWriteNormalizedCount(&bw, norm, uint(tableLog))
+	if float64((len(bw.out)-len(out))*8)+fseEstimateBits(counts, norm, uint(tableLog)) >=
+		fseEstimateBits(counts, predefined.norm, predefined.tableLog) {
+		return predefined, zstdModePredefined, out
+	}
+	return newFSETable(norm, uint(tableLog)), zstdModeCompressed, bw.out
+}
+
+func zstdLiteralsLengthCode(n int) uint8 {
+	if n < 16 {
+		return uint8(n)
+	}
+	code := len(zstdLiteralsLengthBase) - 1
+	for zstdLiteralsLengthBase[code] > uint32(n) {
+		code--
+	}
+	return uint8(code)
+}
+
+func zstdMatchLengthCode(n int) uint8 {
+	if n < 35 {
+		return uint8(n - 3)
+	}
+	code := len(zstdMatchLengthBase) - 1
+	for zstdMatchLengthBase[code] > uint32(n) {
+		code--
+	}
+	return uint8(code)
+}
+
+// fseTable defines the FSE compression table.
+type fseTable struct {
+	norm     []int16
+	tableLog uint
+	states   []uint16
+	symbols  []fseSymbol
+}
+
+type fseSymbol struct {
+	deltaNbBits    uint32
+	deltaFindState int32
+}
+
+// The newFSETable function creates FSE compression table from normalized
+// counts, the count -1 means less than 1 probability.
+func newFSETable(norm []int16, tableLog uint) *fseTable {
+	size := 1 << tableLog
+	high := size - 1
+	symbols := make([]uint8, size)
+	cumul := make([]int, len(norm)+1)
+	for s, count := range norm {
+		if count == -1 {
+			cumul[s+1] = cumul[s] + 1
+			symbols[high] = uint8(s)
+			high--
+		} else {
+			cumul[s+1] = cumul[s] + int(count)
+		}
+	}
+
+	// spread symbols
+	pos, step := 0, size>>1+size>>3+3
+	for s, count := range norm {
+		for i := 0; i < int(count); i++ {
+			symbols[pos] = uint8(s)
+			pos = (pos + step) & (size - 1)
+			for pos > high {
+				pos = (pos + step) & (size - 1)
+			}
+		}
+	}
+
+	t := &fseTable{
+		norm:     norm,
+		tableLog: tableLog,
+		states:   make([]uint16, size),
+		symbols:  make([]fseSymbol, len(norm)),
+	}
+	for u, s := range symbols {
+		t.states[cumul[s]] = uint16(size + u)
+		cumul[s]++
+	}
+	total := 0
+	for s, count := range norm {
+		switch count {
+		case 0:
+		case -1, 1:
+			t.symbols[s] = fseSymbol{uint32(tableLog<<16) - uint32(size), int32(total - 1)}
+			total++
+		default:
+			maxBits := tableLog - uint(bits.Len(uint(count-1))-1)
+			minState := uint32(count) << maxBits
+			t.symbols[s] = fseSymbol{uint32(maxBits<<16) - minState, int32(total - int(count))}
+			total += int(count)
+		}
+	}
+	return t
+}
+
+// The init method returns the initial state of the last symbol.
+func (t *fseTable) init(s uint8) uint32 {
+	sym := t.symbols[s]
+	nbBits := (sym.deltaNbBits + 1<<15) >> 16
+	state := nbBits<<16 - sym.deltaNbBits
+	return uint32(t.states[int32(state>>nbBits)+sym.deltaFindState])
+}
+
+func (t *fseTable) encode(bw *compressBitWriter, state *uint32, s uint8) {
+	sym := t.symbols[s]
+	nbBits := (*state + sym.deltaNbBits) >> 16
+	bw.writeBits(uint64(*state), uint(nbBits))
+	*state = uint32(t.states[int32(*state>>nbBits)+sym.deltaFindState])
+}
+
+func (t *fseTable) flush(bw *compressBitWriter, state uint32) {
+	bw.writeBits(uint64(state), t.tableLog)
+}
+
+// The fseEstimateBits function estimates the bits of encoding counts using
+// normalized counts.
+func fseEstimateBits(counts []int, norm []int16, tableLog uint) float64 {
+	size := 0.0
+	for s, count := range counts {
+		if count == 0 {
+			continue
+		}
+		if s >= len(norm) || norm[s] == 0 {
+			return math.Inf(1)
+		}
+		p := float64(norm[s])
+		if p < 0 {
+			p = -p
+		}
+		size += float64(count) * (float64(tableLog) - math.Log2(p))
+	}
+	return size
+}
+
+// The fseNormalizeCount function normalizes counts to sum 1<<tableLog,
+// each used symbol has at least 1.
+func fseNormalizeCount(counts []int, total int, tableLog uint) []int16 {
+	size := 1 << tableLog
+	norm := make([]int16, len(counts))
+	sum, largest := 0, 0
+	for s, count := range counts {
+		if count == 0 {
+			continue
+		}
+		v := count * size / total
+		if v == 0 {
+			v = 1
+		}
+		norm[s] = int16(v)
+		sum += v
+		if count > counts[largest] {
+			largest = s
+		}
+	}
+	norm[largest] += int16(size - sum)
+	if norm[largest] < 1 {
+		return nil
+	}
+	return norm
+}
+
+// The fseWriteNormalizedCount function writes FSE_Table_Description.
+func fseWriteNormalizedCount(bw *compressBitWriter, norm []int16, tableLog uint) {
+	bw.writeBits(uint64(tableLog-5), 4)
+	remaining := 1<<tableLog + 1
+	threshold := 1 << tableLog
+	nbBits := tableLog + 1
+	previous0 := false
+	for s := 0; s < len(norm) && remaining > 1; {
+		if previous0 {
+			start := s
+			for norm[s] == 0 {
+				s++
+			}
+			for s >= start+24 {
+				start += 24
+				bw.writeBits(0xffff, 16)
+			}
+			for s >= start+3 {
+				start += 3
+				bw.writeBits(3, 2)
+			}
+			bw.writeBits(uint64(s-start), 2)
+		}
+
+		count := int(norm[s])
+		s++
+		max := 2*threshold - 1 - remaining
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		count++
+		if count >= threshold {
+			count += max
+		}
+		if count < max {
+			bw.writeBits(uint64(count), nbBits-1)
+		} else {
+			bw.writeBits(uint64(count), nbBits)
+		}
+		previous0 = count == 1
+		for remaining < threshold {
+			nbBits--
+			threshold >>= 1
+		}
+	}
+	bw.align()
+}
+
+// compressXXHash64 defines the streaming XXH64 with seed 0,
+// used for zstd content checksum.
+type compressXXHash64 struct {
+	v     [4]uint64
+	total uint64
+	mem   [32]byte
+	size  int
+}
+
+const (
+	xxhPrime1 uint64 = 11400714785074694791
+	xxhPrime2 uint64 = 14029467366897019727
+	xxhPrime3 uint64 = 1609587929392839161
+	xxhPrime4 uint64 = 9650029242287828579
+	xxhPrime5 uint64 = 2870177450012600261
+)
+
+func (h *compressXXHash64) reset() {
+	prime1 := xxhPrime1
+	h.v = [4]uint64{prime1 + xxhPrime2, xxhPrime2, 0, -prime1}
+	h.total = 0
+	h.size = 0
+}
+
+func xxhRound(acc, input uint64) uint64 {
+	return bits.RotateLeft64(acc+input*xxhPrime2, 31) * xxhPrime1
+}
+
+func (h *compressXXHash64) Write(p []byte) {
+	h.total += uint64(len(p))
+	if h.size > 0 {
+		n := copy(h.mem[h.size:], p)
+		h.size += n
+		p = p[n:]
+		if h.size < 32 {
+			return
+		}
+		h.stripe(h.mem[:])
+		h.size = 0
+	}
+	for ; len(p) >= 32; p = p[32:] {
+		h.stripe(p)
+	}
+	h.size = copy(h.mem[:], p)
+}
+
+func (h *compressXXHash64) stripe(p []byte) {
+	for i := range h.v {
+		h.v[i] = xxhRound(h.v[i], binary.LittleEndian.Uint64(p[i*8:]))
+	}
+}
+
+func (h *compressXXHash64) Sum64() uint64 {
+	var sum uint64
+	if h.total >= 32 {
+		v := h.v
+		sum = bits.RotateLeft64(v[0], 1) + bits.RotateLeft64(v[1], 7) +
+			bits.RotateLeft64(v[2], 12) + bits.RotateLeft64(v[3], 18)
+		for i := range v {
+			sum = (sum^xxhRound(0, v[i]))*xxhPrime1 + xxhPrime4
+		}
+	} else {
+		sum = xxhPrime5
+	}
+	sum += h.total
+
+	p := h.mem[:h.size]
+	for ; len(p) >= 8; p = p[8:] {
+		sum ^= xxhRound(0, binary.LittleEndian.Uint64(p))
+		sum = bits.RotateLeft64(sum, 27)*xxhPrime1 + xxhPrime4
+	}
+	if len(p) >= 4 {
+		sum ^= uint64(binary.LittleEndian.Uint32(p)) * xxhPrime1
+		sum = bits.RotateLeft64(sum, 23)*xxhPrime2 + xxhPrime3
+		p = p[4:]
+	}
+	for _, b := range p {
+		sum ^= uint64(b) * xxhPrime5
+		sum = bits.RotateLeft64(sum, 11) * xxhPrime1
+	}
+	sum ^= sum >> 33
+	sum *= xxhPrime2
+	sum ^= sum >> 29
+	sum *= xxhPrime3
+	sum ^= sum >> 32
+	return sum
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"expvar"
+	"io"
 	"net/http"
 	"net/http/pprof"
+	"time"
 
 	"github.com/eudore/eudore"
 )
@@ -16,6 +18,7 @@ var (
 	_ eudore.ResponseWriter = (*responseWriterDump)(nil)
 	_ eudore.ResponseWriter = (*responseWriteFlush)(nil)
 	_ eudore.ResponseWriter = (*responseWriterRate)(nil)
+	_ eudore.ResponseWriter = (*responseWriterSession)(nil)
 	_ eudore.ResponseWriter = (*responseWriterTimeout)(nil)
 	_ eudore.ResponseWriter = (*responseWriterTiming)(nil)
 )
@@ -75,10 +78,21 @@ var (
 	}
 	// DefaultCompressionEncoder defines the default supported hybrid
 	// compression methods.
+	//
+	// The pure Go zstd and brotli are not default, use
+	// [NewOptionCompressionEncoder] to enable them.
 	DefaultCompressionEncoder = map[string]func() any{
 		CompressionNameGzip:    compressionWriterGzip,
 		CompressionNameDeflate: compressionWriterFlate,
 	}
+	// DefaultDecompressionDecoder global defines the default supported
+	// request body decompression methods.
+	DefaultDecompressionDecoder = map[string]func(io.Reader) (io.Reader, error){
+		CompressionNameZstandard: decompressionReaderZstandard,
+		CompressionNameBrotli:    decompressionReaderBrotli,
+		CompressionNameGzip:      decompressionReaderGzip,
+		CompressionNameDeflate:   decompressionReaderFlate,
+	}
 	// DefaultCompressionOrder global defines the priority order of available
 	// compression,
 	// compression methods outside the list have the lowest priority.
@@ -109,10 +123,12 @@ var (
 	DefaultPageCircuitBreaker = "503 Service Unavailable: breaker triggered {{value}}."
 	DefaultPageCORS           = ""
 	DefaultPageCSRF           = "403 Forbidden: invalid CSRF token {{value}}."
+	DefaultPageDecompress     = "415 Unsupported Media Type: unsupported Content-Encoding {{value}}."
 	DefaultPageDigestAuth     = "401 Unauthorized: {{value}}"
 	DefaultPageHealth         = "unhealthy: {{value}}"
 	DefaultPageRate           = "429 Too Many Requests: rate limit exceeded {{value}}."
 	DefaultPageReferer        = "403 Forbidden: invalid Referer header {{value}}."
+	DefaultPageSignatureAuth  = "401 Unauthorized: signature error {{value}}"
 	DefaultPageTimeout        = "503 Service Unavailable"
 	// DefaultPolicyConditions global defines the conditions that policy parsing allows.
 	//
@@ -147,6 +163,18 @@ var (
 	// DefaultRecoveryErrorFormat global defines the format of the recover data.
 	DefaultRecoveryErrorFormat = "%v"
 	DefaultRateRetryMin        = 3
+	// DefaultSessionMaxAge global defines the absolute expiration time of
+	// the session created by [NewSessionFunc].
+	DefaultSessionMaxAge = 24 * time.Hour
+	// DefaultSessionMaxIdle global defines the idle expiration time of
+	// the session created by [NewSessionFunc].
+	DefaultSessionMaxIdle = 30 * time.Minute
+	// DefaultSignatureComponents global defines the components that
+	// [NewSignatureAuthFunc] requires the signature to cover.
+	DefaultSignatureComponents = []string{"@method", "@target-uri"}
+	// DefaultSignatureMaxAge global defines the max age of the signature
+	// verified by [NewSignatureAuthFunc].
+	DefaultSignatureMaxAge = 5 * time.Minute
 	// DefaultUserAgentMapping defines the mapping to replace device codes
 	// with normalized names during User-Agent analysis.
 	DefaultUserAgentMapping = userAgentMapping
@@ -173,9 +201,26 @@ var (
 	ErrBearerTokenNotValid            = "bearer token not valid before %v"
 	ErrBearerTokenExpired             = "bearer token expired at %v" // #nosec G101
 	ErrBearerSignatureInvalid         = errors.New("bearer signature is invalid")
+	ErrBearerAlgorithmInvalid         = "bearer algorithm '%s' is invalid"
+	ErrBearerAudienceInvalid          = "bearer audience %v is invalid"
+	ErrBearerIssuerInvalid            = "bearer issuer '%s' is invalid"
+	ErrBearerJWKInvalid               = "bearer jwk '%s' key type '%s' is invalid"
+	ErrBearerKeyNotFound              = "bearer key id '%s' not found"
 	ErrCompressMissingEncoder         = "compress missing encoder function for compression '%s'"
 	ErrCompressInvalidEncoder         = "compress invalid encoder function for compression '%s'"
+	ErrDecompressDataInvalid          = "decompress %s data is invalid: %s"
 	ErrPolicyConditionsUnmarshalError = "policy conditions unmarshal json %s error: %v"
 	ErrPolicyConditionsParseError     = "policy conditions parse %s error: %v"
 	ErrPolicyConditionParseError      = "policy conditions %s parse %s error: %v"
+	ErrRateSlidingWindowInvalid       = "rate sliding window limit %d and window %s must be greater than 0"
+	ErrRateStoreConflict              = "rate store update key '%s' conflict after %d retries"
+	ErrSessionCookieHashKeyEmpty      = errors.New("session cookie hash key is empty")
+	ErrSessionCookieTooLarge          = "session cookie size %d exceeds 4096 bytes"
+	ErrSignatureComponentNotCovered   = "signature not covered component %s"
+	ErrSignatureDigestInvalid         = errors.New("signature content digest is invalid")
+	ErrSignatureExpired               = "signature expired, created or expires is '%s'"
+	ErrSignatureInvalid               = errors.New("signature is invalid")
+	ErrSignatureKeyInvalid            = "signature invalid key type %T"
+	ErrSignatureKeyNotFound           = "signature keyid '%s' not found"
+	ErrSignatureMissing               = errors.New("signature is missing")
 )
@@ -0,0 +1,957 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/bits"
+	"sync"
+)
+
+// The decompressionReaderBrotli function creates a pure Go brotli decoder,
+// refer RFC 7932.
+//
+// The static dictionary is decoded on the first dictionary reference.
+func decompressionReaderBrotli(r io.Reader) (io.Reader, error) {
+	reader := &brotliReader{dists: [4]int{4, 11, 15, 16}}
+	reader.br.reader = bufio.NewReader(r)
+	err := reader.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+const (
+	brotliReadSize          = 1 << 16
+	brotliPrefixRootBits    = 8
+	brotliBlockCountSymbols = 26
+	brotliCommandSymbols    = 704
+	brotliTransformNumber   = 121
+	brotliStateHeader       = 0
+	brotliStateUncompressed = 1
+	brotliStateCommand      = 2
+	brotliStateDone         = 3
+	brotliContextLSB6       = 0
+	brotliContextMSB6       = 1
+	brotliContextUTF8       = 2
+)
+
+var (
+	brotliBlockCountBase = [brotliBlockCountSymbols]uint32{
+		1, 5, 9, 13, 17, 25, 33, 41, 49, 65, 81, 97, 113,
+		145, 177, 209, 241, 305, 369, 497, 753, 1265, 2289, 4337, 8433, 16625,
+	}
+	brotliBlockCountBits = [brotliBlockCountSymbols]uint8{
+		2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5,
+		5, 5, 5, 6, 6, 7, 8, 9, 10, 11, 12, 13, 24,
+	}
+	// the insert and copy length code range start of command cells.
+	brotliCellInsert = [11]uint8{0, 0, 0, 0, 8, 8, 0, 16, 8, 16, 16}
+	brotliCellCopy   = [11]uint8{0, 8, 0, 8, 0, 8, 16, 0, 16, 8, 16}
+	// the last distance index and offset of distance code 0-15.
+	brotliDistanceIndex  = [16]uint8{0, 1, 2, 3, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1}
+	brotliDistanceOffset = [16]int8{0, 0, 0, 0, -1, 1, -2, 2, -3, 3, -1, 1, -2, 2, -3, 3}
+	// the bits of word number in the static dictionary by word length.
+	brotliDictionaryBits = [25]uint8{
+		0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10, 9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5,
+	}
+	brotliDictionaryOffsets [25]int
+	brotliDictionaryOnce    sync.Once
+	brotliDictionary        []byte
+	// the prefix, type and suffix of RFC 7932 Appendix B transforms,
+	// type 0-9 is omit last n, 10 is uppercase first,
+	// 11 is uppercase all, 12-20 is omit first n-11.
+	brotliTransforms = [brotliTransformNumber]struct {
+		prefix string
+		typ    uint8
+		suffix string
+	}{
+		{"", 0, ""}, {"", 0, " "}, {" ", 0, " "}, {"", 12, ""}, {"", 10, " "},
+		{"", 0, " the "}, {" ", 0, ""}, {"s ", 0, " "}, {"", 0, " of "},
+		{"", 10, ""}, {"", 0, " and "}, {"", 13, ""}, {"", 1, ""},
+		{", ", 0, " "}, {"", 0, ", "}, {" ", 10, " "}, {"", 0, " in "},
+		{"", 0, " to "}, {"e ", 0, " "}, {"", 0, "\""}, {"", 0, "."},
+		{"", 0, "\">"}, {"", 0, "\n"}, {"", 3, ""}, {"", 0, "]"},
+		{"", 0, " for "}, {"", 14, ""}, {"", 2, ""}, {"", 0, " a "},
+		{"", 0, " that "}, {" ", 10, ""}, {"", 0, ". "}, {".", 0, ""},
+		{" ", 0, ", "}, {"", 15, ""}, {"", 0, " with "}, {"", 0, "'"},
+		{"", 0, " from "}, {"", 0, " by "}, {"", 16, ""}, {"", 17, ""},
+		{" the ", 0, ""}, {"", 4, ""}, {"", 0, ". The "}, {"", 11, ""},
+		{"", 0, " on "}, {"", 0, " as "}, {"", 0, " is "}, {"", 7, ""},
+		{"", 1, "ing "}, {"", 0, "\n\t"}, {"", 0, ":"}, {" ", 0, ". "},
+		{"", 0, "ed "}, {"", 20, ""}, {"", 18, ""}, {"", 6, ""}, {"", 0, "("},
+		{"", 10, ", "}, {"", 8, ""}, {"", 0, " at "}, {"", 0, "ly "},
+		{" the ", 0, " of "}, {"", 5, ""}, {"", 9, ""}, {" ", 10, ", "},
+		{"", 10, "\""}, {".", 0, "("}, {"", 11, " "}, {"", 10, "\">"},
+		{"", 0, "=\""}, {" ", 0, "."}, {".com/", 0, ""},
+		{" the ", 0, " of the "}, {"", 10, "'"}, {"", 0, ". This "},
+		{"", 0, ","}, {".", 0, " "}, {"", 10, "("}, {"", 10, "."},
+		{"", 0, " not "}, {" ", 0, "=\""}, {"", 0, "er "}, {" ", 11, " "},
+		{"", 0, "al "}, {" ", 11, ""}, {"", 0, "='"}, {"", 11, "\""},
+		{"", 10, ". "}, {" ", 0, "("}, {"", 0, "ful "}, {" ", 10, ". "},
+		{"", 0, "ive "}, {"", 0, "less "}, {"", 11, "'"}, {"", 0, "est "},
+		{" ", 10, "."}, {"", 11, "\">"}, {" ", 0, "='"}, {"", 10, ","},
+		{"", 0, "ize "}, {"", 11, "."}, {"\u00a0", 0, ""}, {" ", 0, ","},
+		{"", 10, "=\""}, {"", 11, "=\""}, {"", 0, "ous "}, {"", 11, ", "},
+		{"", 10, "='"}, {" ", 10, ","}, {" ", 11, "=\""}, {" ", 11, ", "},
+		{"", 11, ","}, {"", 11, "("}, {"", 11, ". "}, {" ", 11, "."},
+		{"", 11, "='"}, {" ", 11, ". "}, {" ", 10, "=\""}, {" ", 11, "='"},
+		{" ", 10, "='"},
+	}
+	// the context lookup tables of RFC 7932 section 7.1.
+	brotliContextUTF8Last = [256]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 0, 4, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		8, 12, 16, 12, 12, 20, 12, 16, 24, 28, 12, 12, 32, 12, 36, 12,
+		44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 32, 32, 24, 40, 28, 12,
+		12, 48, 52, 52, 52, 48, 52, 52, 52, 48, 52, 52, 52, 52, 52, 48,
+		52, 52, 52, 52, 52, 48, 52, 52, 52, 52, 52, 24, 12, 28, 12, 12,
+		12, 56, 60, 60, 60, 56, 60, 60, 60, 56, 60, 60, 60, 60, 60, 56,
+		60, 60, 60, 60, 60, 56, 60, 60, 60, 60, 60, 24, 12, 28, 12, 0,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+		2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+		2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+		2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	}
+	brotliContextUTF8Prev = [256]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+		1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+		1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 1, 1, 1, 1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	}
+	brotliContextSigned = [256]uint8{
+		0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7,
+	}
+)
+
+func init() {
+	for i := 4; i < len(brotliDictionaryOffsets)-1; i++ {
+		brotliDictionaryOffsets[i+1] = brotliDictionaryOffsets[i] + i<<brotliDictionaryBits[i]
+	}
+}
+
+func brotliGetDictionary() []byte {
+	brotliDictionaryOnce.Do(func() {
+		data, _ := base64.StdEncoding.DecodeString(brotliDictionaryData)
+		brotliDictionary, _ = io.ReadAll(flate.NewReader(bytes.NewReader(data)))
+	})
+	return brotliDictionary
+}
+
+// brotliReader defines the streaming brotli reader,
+// buf saves the window history and the unread data after pos.
+type brotliReader struct {
+	br        brotliBitReader
+	buf       []byte
+	pos       int
+	window    int
+	total     int
+	state     int
+	last      bool
+	remain    int
+	blocks    [3]brotliBlock
+	npostfix  uint
+	ndirect   int
+	modes     []uint8
+	literal   []uint8
+	distance  []uint8
+	literals  []brotliPrefix
+	commands  []brotliPrefix
+	distances []brotliPrefix
+	lengths   [brotliCommandSymbols]uint8
+	dists     [4]int
+	// the pending insert literals and copy of the current command.
+	insert   int
+	length   int
+	implicit bool
+	needDist bool
+	copy     int
+	dist     int
+	err      error
+}
+
+// brotliBlock defines the block switch state of a category.
+type brotliBlock struct {
+	types     int
+	typ       int
+	prev      int
+	count     int
+	typeCode  brotliPrefix
+	countCode brotliPrefix
+}
+
+// brotliPrefix defines the prefix code decoding table,
+// the root table entry is symbol<<4 | length for codes not longer than
+// brotliPrefixRootBits, and symbols are sorted by length and value.
+type brotliPrefix struct {
+	single  bool
+	table   [1 << brotliPrefixRootBits]uint16
+	counts  [brotliHuffmanMaxBits + 1]uint16
+	symbols []uint16
+}
+
+// brotliBitReader defines the LSB-first bit reader,
+// the zero bits after the end of input is padded,
+// pad is the number of padding bits in val.
+type brotliBitReader struct {
+	reader *bufio.Reader
+	val    uint64
+	n      uint
+	pad    uint
+	err    error
+}
+
+func brotliError(reason string) error {
+	return fmt.Errorf(ErrDecompressDataInvalid, CompressionNameBrotli, reason)
+}
+
+func (r *brotliReader) Read(p []byte) (int, error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		// keep the window history
+		if len(r.buf) > 2*r.window+brotliReadSize {
+			r.buf = r.buf[:copy(r.buf, r.buf[len(r.buf)-r.window:])]
+			r.pos = len(r.buf)
+		}
+		switch r.state {
+		case brotliStateHeader:
+			r.err = r.readMetaBlock()
+		case brotliStateUncompressed:
+			r.err = r.readUncompressed()
+		case brotliStateCommand:
+			r.err = r.decodeCommands()
+		default:
+			r.err = io.EOF
+		}
+	}
+	n := copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+func (r *brotliReader) readHeader() error {
+	br := &r.br
+	wbits := 16
+	if br.read(1) == 1 {
+		wbits = 17 + br.read(3)
+		if wbits == 17 {
+			n := br.read(3)
+			switch n {
+			case 0:
+			case 1:
+				return brotliError("large window is not supported")
+			default:
+				wbits = 8 + n
+			}
+		}
+	}
+	r.window = 1<<wbits - 16
+	return br.check()
+}
+
+func (r *brotliReader) readMetaBlock() error {
+	br := &r.br
+	r.last = br.read(1) == 1
+	if r.last && br.read(1) == 1 {
+		return r.endMetaBlock()
+	}
+
+	nibbles := br.read(2) + 4
+	if nibbles == 7 {
+		if br.read(1) != 0 {
+			return brotliError("reserved bit is set")
+		}
+		nbytes, skip := br.read(2), 0
+		for i := 0; i < nbytes; i++ {
+			b := br.read(8)
+			if i > 0 && i == nbytes-1 && b == 0 {
+				return brotliError("invalid metadata length")
+			}
+			skip |= b << (8 * i)
+		}
+		if nbytes > 0 {
+			skip++
+		}
+		if !br.align() {
+			return brotliError("invalid padding bits")
+		}
+		for ; skip > 0 && br.check() == nil; skip-- {
+			br.read(8)
+		}
+		if r.last {
+			return r.endMetaBlock()
+		}
+		return br.check()
+	}
+
+	r.remain = 0
+	for i := 0; i < nibbles; i++ {
+		n := br.read(4)
+		if i > 3 && i == nibbles-1 && n == 0 {
+			return brotliError("invalid meta-block length")
+		}
+		r.remain |= n << (4 * i)
+	}
+	r.remain++
+	if !r.last && br.read(1) == 1 {
+		if !br.align() {
+			return brotliError("invalid padding bits")
+		}
+		r.state = brotliStateUncompressed
+		return br.check()
+	}
+
+	for i := range r.blocks {
+		err := r.readBlockSwitch(&r.blocks[i])
+		if err != nil {
+			return err
+		}
+	}
+	r.npostfix = uint(br.read(2))
+	r.ndirect = br.read(4) << r.npostfix
+	r.modes = r.modes[:0]
+	for i := 0; i < r.blocks[0].types; i++ {
+		r.modes = append(r.modes, uint8(br.read(2)))
+	}
+
+	var err error
+	trees := br.readVarUint8() + 1
+	r.literal, err = r.readContextMap(r.literal, trees, r.blocks[0].types<<6)
+	if err != nil {
+		return err
+	}
+	dtrees := br.readVarUint8() + 1
+	r.distance, err = r.readContextMap(r.distance, dtrees, r.blocks[2].types<<2)
+	if err != nil {
+		return err
+	}
+	r.literals, err = r.readPrefixes(r.literals, trees, 256)
+	if err != nil {
+		return err
+	}
+	r.commands, err = r.readPrefixes(r.commands, r.blocks[1].types, brotliCommandSymbols)
+	if err != nil {
+		return err
+	}
+	r.distances, err = r.readPrefixes(r.distances, dtrees, 16+r.ndirect+48<<r.npostfix)
+	if err != nil {
+		return err
+	}
+	r.state = brotliStateCommand
+	return br.check()
+}
+
+func (r *brotliReader) endMetaBlock() error {
+	r.state = brotliStateHeader
+	if r.last {
+		if !r.br.align() {
+			return brotliError("invalid padding bits")
+		}
+		r.state = brotliStateDone
+	}
+	return r.br.check()
+}
+
+func (r *brotliReader) readUncompressed() error {
+	n := r.remain
+	if n > brotliReadSize {
+		n = brotliReadSize
+	}
+	start := len(r.buf)
+	r.buf = decompressGrow(r.buf, n)
+	err := r.br.readBytes(r.buf[start:])
+	if err != nil {
+		return err
+	}
+	r.remain -= n
+	r.total += n
+	if r.remain == 0 {
+		r.state = brotliStateHeader
+	}
+	return nil
+}
+
+func (r *brotliReader) readBlockSwitch(b *brotliBlock) error {
+	b.types = r.br.readVarUint8() + 1
+	b.typ, b.prev, b.count = 0, 1, 1<<30
+	if b.types > 1 {
+		err := r.readPrefix(&b.typeCode, b.types+2)
+		if err != nil {
+			return err
+		}
+		err = r.readPrefix(&b.countCode, brotliBlockCountSymbols)
+		if err != nil {
+			return err
+		}
+		b.count = r.br.readBlockCount(&b.countCode)
+	}
+	return r.br.check()
+}
+
+func (r *brotliReader) switchBlock(b *brotliBlock) {
+	typ := r.br.decode(&b.typeCode)
+	switch typ {
+	case 0:
+		typ = b.prev
+	case 1:
+		typ = (b.typ + 1) % b.types
+	default:
+		typ -= 2
+	}
+	b.prev, b.typ = b.typ, typ
+	b.count = r.br.readBlockCount(&b.countCode)
+}
+
+func (r *brotliReader) readContextMap(cmap []uint8, trees, size int) ([]uint8, error) {
+	cmap = cmap[:0]
+	for i := 0; i < size; i++ {
+		cmap = append(cmap, 0)
+	}
+	if trees == 1 {
+		return cmap, nil
+	}
+
+	br := &r.br
+	rle := 0
+	if br.read(1) == 1 {
+		rle = br.read(4) + 1
+	}
+	var code brotliPrefix
+	err := r.readPrefix(&code, trees+rle)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < size && br.check() == nil; {
+		s := br.decode(&code)
+		switch {
+		case s == 0:
+			i++
+		case s <= rle:
+			i += 1<<s + br.read(uint(s))
+			if i > size {
+				return nil, brotliError("invalid context map")
+			}
+		default:
+			cmap[i] = uint8(s - rle)
+			i++
+		}
+	}
+	if br.read(1) == 1 {
+		var mtf [256]uint8
+		for i := range mtf {
+			mtf[i] = uint8(i)
+		}
+		for i, idx := range cmap {
+			v := mtf[idx]
+			cmap[i] = v
+			copy(mtf[1:idx+1], mtf[:idx])
+			mtf[0] = v
+		}
+	}
+	return cmap, br.check()
+}
+
+func (r *brotliReader) readPrefixes(codes []brotliPrefix, n, size int) ([]brotliPrefix, error) {
+	if cap(codes) < n {
+		codes = make([]brotliPrefix, n)
+	}
+	codes = codes[:n]
+	for i := range codes {
+		err := r.readPrefix(&codes[i], size)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+// The readPrefix method reads simple or complex prefix code,
+// refer RFC 7932 section 3.4 and 3.5.
+func (r *brotliReader) readPrefix(p *brotliPrefix, size int) error {
+	br := &r.br
+	lengths := r.lengths[:size]
+	for i := range lengths {
+		lengths[i] = 0
+	}
+
+	hskip := br.read(2)
+	if hskip == 1 {
+		var symbols [4]int
+		nsym := br.read(2) + 1
+		for i := 0; i < nsym; i++ {
+			symbols[i] = br.read(uint(bits.Len(uint(size - 1))))
+			if symbols[i] >= size || lengths[symbols[i]] != 0 {
+				return brotliError("invalid simple prefix code")
+			}
+			lengths[symbols[i]] = 1
+		}
+		switch nsym {
+		case 1:
+			p.single = true
+			p.symbols = append(p.symbols[:0], uint16(symbols[0]))
+			return br.check()
+		case 3:
+			lengths[symbols[1]], lengths[symbols[2]] = 2, 2
+		case 4:
+			if br.read(1) == 0 {
+				for _, s := range symbols {
+					lengths[s] = 2
+				}
+			} else {
+				lengths[symbols[1]], lengths[symbols[2]], lengths[symbols[3]] = 2, 3, 3
+			}
+		}
+		p.build(lengths)
+		return br.check()
+	}
+
+	var clens [len(brotliCodeLengthOrder)]uint8
+	space, num := 32, 0
+	for i := hskip; i < len(brotliCodeLengthOrder) && space > 0; i++ {
+		br.fill(brotliCodeLengthMaxBits - 1)
+		s := 0
+		for ; s < len(brotliCodeLengthCodes); s++ {
+			l := brotliCodeLengthLengths[s]
+			if uint8(br.val)&(1<<l-1) == brotliCodeLengthCodes[s] {
+				br.consume(uint(l))
+				break
+			}
+		}
+		clens[brotliCodeLengthOrder[i]] = uint8(s)
+		if s != 0 {
+			space -= 32 >> s
+			num++
+		}
+	}
+	if num != 1 && space != 0 {
+		return brotliError("invalid code length code lengths")
+	}
+	var code brotliPrefix
+	code.build(clens[:])
+
+	prev, repeat, repeatLen := 8, 0, 0
+	space = 1 << brotliHuffmanMaxBits
+	for s := 0; s < size && space > 0; {
+		if br.check() != nil {
+			return br.check()
+		}
+		c := br.decode(&code)
+		if c < brotliRepeatPrevious {
+			repeat = 0
+			lengths[s] = uint8(c)
+			s++
+			if c != 0 {
+				prev = c
+				space -= 1 << brotliHuffmanMaxBits >> c
+			}
+			continue
+		}
+
+		extra, length := uint(2), prev
+		if c == brotliRepeatZero {
+			extra, length = 3, 0
+		}
+		if repeatLen != length {
+			repeat, repeatLen = 0, length
+		}
+		old := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extra
+		}
+		repeat += br.read(extra) + 3
+		delta := repeat - old
+		if s+delta > size {
+			return brotliError("invalid code lengths")
+		}
+		for ; delta > 0; delta-- {
+			lengths[s] = uint8(length)
+			s++
+			if length != 0 {
+				space -= 1 << brotliHuffmanMaxBits >> length
+			}
+		}
+	}
+	if space != 0 {
+		return brotliError("invalid code lengths")
+	}
+	p.build(lengths)
+	return br.check()
+}
+
+// The build method builds canonical prefix code from code lengths,
+// a single non-zero length means the symbol uses zero bits.
+func (p *brotliPrefix) build(lengths []uint8) {
+	p.counts = [brotliHuffmanMaxBits + 1]uint16{}
+	for _, l := range lengths {
+		p.counts[l]++
+	}
+	p.counts[0] = 0
+	var offsets [brotliHuffmanMaxBits + 2]uint16
+	for l := 1; l <= brotliHuffmanMaxBits; l++ {
+		offsets[l+1] = offsets[l] + p.counts[l]
+	}
+	p.symbols = p.symbols[:0]
+	for i := offsets[brotliHuffmanMaxBits+1]; i > 0; i-- {
+		p.symbols = append(p.symbols, 0)
+	}
+	for s, l := range lengths {
+		if l != 0 {
+			p.symbols[offsets[l]] = uint16(s)
+			offsets[l]++
+		}
+	}
+	p.single = len(p.symbols) == 1
+	p.table = [1 << brotliPrefixRootBits]uint16{}
+
+	code, idx := 0, 0
+	for l := 1; l <= brotliPrefixRootBits; l++ {
+		for i := 0; i < int(p.counts[l]); i++ {
+			entry := p.symbols[idx]<<4 | uint16(l)
+			rev := int(bits.Reverse16(uint16(code)) >> (16 - l))
+			for j := rev; j < len(p.table); j += 1 << l {
+				p.table[j] = entry
+			}
+			code++
+			idx++
+		}
+		code <<= 1
+	}
+}
+
+func (r *brotliReader) decodeCommands() error {
+	br := &r.br
+	limit := len(r.buf) + brotliReadSize
+	for len(r.buf) < limit {
+		if err := br.check(); err != nil {
+			return err
+		}
+		switch {
+		case r.insert > 0:
+			if r.remain == 0 {
+				return brotliError("insert length exceeds meta-block length")
+			}
+			b := &r.blocks[0]
+			if b.count == 0 {
+				r.switchBlock(b)
+			}
+			b.count--
+			var p1, p2 byte
+			if n := len(r.buf); n > 1 {
+				p1, p2 = r.buf[n-1], r.buf[n-2]
+			} else if n == 1 {
+				p1 = r.buf[0]
+			}
+			var cid byte
+			switch r.modes[b.typ] {
+			case brotliContextLSB6:
+				cid = p1 & 0x3f
+			case brotliContextMSB6:
+				cid = p1 >> 2
+			case brotliContextUTF8:
+				cid = brotliContextUTF8Last[p1] | brotliContextUTF8Prev[p2]
+			default:
+				cid = brotliContextSigned[p1]<<3 | brotliContextSigned[p2]
+			}
+			code := &r.literals[r.literal[b.typ<<6|int(cid)]]
+			r.buf = append(r.buf, byte(br.decode(code)))
+			r.insert--
+			r.remain--
+			r.total++
+		case r.needDist:
+			if r.remain == 0 {
+				// the last command of meta-block ignores the copy length
+				r.needDist = false
+				return r.endMetaBlock()
+			}
+			err := r.readDistance()
+			if err != nil {
+				return err
+			}
+		case r.copy > 0:
+			n := r.copy
+			if n > limit-len(r.buf) {
+				n = limit - len(r.buf)
+			}
+			pos := len(r.buf) - r.dist
+			if r.dist >= n {
+				r.buf = append(r.buf, r.buf[pos:pos+n]...)
+			} else {
+				for i := 0; i < n; i++ {
+					r.buf = append(r.buf, r.buf[pos+i])
+				}
+			}
+			r.copy -= n
+			r.total += n
+		case r.remain == 0:
+			return r.endMetaBlock()
+		default:
+			r.readCommand()
+		}
+	}
+	return br.check()
+}
+
+func (r *brotliReader) readCommand() {
+	br := &r.br
+	b := &r.blocks[1]
+	if b.count == 0 {
+		r.switchBlock(b)
+	}
+	b.count--
+	code := br.decode(&r.commands[b.typ])
+	cell := code >> 6
+	ins := brotliCellInsert[cell] + uint8(code>>3&7)
+	cp := brotliCellCopy[cell] + uint8(code&7)
+	r.insert = int(brotliInsertBase[ins]) + br.read(uint(brotliInsertBits[ins]))
+	r.length = int(brotliCopyBase[cp]) + br.read(uint(brotliCopyBits[cp]))
+	r.implicit = cell < 2
+	r.needDist = true
+}
+
+func (r *brotliReader) readDistance() error {
+	br := &r.br
+	r.needDist = false
+	dcode := 0
+	if !r.implicit {
+		b := &r.blocks[2]
+		if b.count == 0 {
+			r.switchBlock(b)
+		}
+		b.count--
+		cid := r.length - 2
+		if cid > 3 {
+			cid = 3
+		}
+		dcode = br.decode(&r.distances[r.distance[b.typ<<2|cid]])
+	}
+
+	var dist int
+	switch {
+	case dcode < 16:
+		dist = r.dists[brotliDistanceIndex[dcode]] + int(brotliDistanceOffset[dcode])
+	case dcode < 16+r.ndirect:
+		dist = dcode - 15
+	default:
+		code := dcode - 16 - r.ndirect
+		nbits := uint(1 + code>>(r.npostfix+1))
+		offset := (2+code>>r.npostfix&1)<<nbits - 4
+		dist = (offset+br.read(nbits))<<r.npostfix + code&(1<<r.npostfix-1) +
+			r.ndirect + 1
+	}
+	if dist <= 0 {
+		return brotliError("invalid distance")
+	}
+
+	max := r.window
+	if r.total < max {
+		max = r.total
+	}
+	if dist > max {
+		return r.readDictionary(dist - max - 1)
+	}
+	if dcode != 0 {
+		r.dists = [4]int{dist, r.dists[0], r.dists[1], r.dists[2]}
+	}
+	if r.length > r.remain {
+		return brotliError("copy length exceeds meta-block length")
+	}
+	r.copy, r.dist = r.length, dist
+	r.remain -= r.length
+	return nil
+}
+
+// The readDictionary method appends the transformed static dictionary word,
+// refer RFC 7932 section 8.
+func (r *brotliReader) readDictionary(word int) error {
+	length := r.length
+	if length < 4 || length >= len(brotliDictionaryBits) {
+		return brotliError("invalid dictionary word length")
+	}
+	nbits := brotliDictionaryBits[length]
+	transform := word >> nbits
+	if transform >= brotliTransformNumber {
+		return brotliError("invalid dictionary transform")
+	}
+	offset := brotliDictionaryOffsets[length] + word&(1<<nbits-1)*length
+	src := brotliGetDictionary()[offset : offset+length]
+
+	t := &brotliTransforms[transform]
+	start := len(r.buf)
+	r.buf = append(r.buf, t.prefix...)
+	switch {
+	case t.typ < 10 && int(t.typ) < len(src):
+		src = src[:len(src)-int(t.typ)]
+	case t.typ > 11 && int(t.typ)-11 < len(src):
+		src = src[t.typ-11:]
+	case t.typ != 10 && t.typ != 11:
+		src = nil
+	}
+	pos := len(r.buf)
+	r.buf = append(r.buf, src...)
+	for word := r.buf[pos:]; len(word) > 0 && (t.typ == 10 || t.typ == 11); {
+		word = word[brotliToUpper(word):]
+		if t.typ == 10 {
+			break
+		}
+	}
+	r.buf = append(r.buf, t.suffix...)
+
+	n := len(r.buf) - start
+	if n > r.remain {
+		return brotliError("copy length exceeds meta-block length")
+	}
+	r.remain -= n
+	r.total += n
+	return nil
+}
+
+func brotliToUpper(p []byte) int {
+	if len(p) == 1 || p[0] < 0xc0 {
+		if p[0] >= 'a' && p[0] <= 'z' {
+			p[0] ^= 32
+		}
+		return 1
+	}
+	if p[0] < 0xe0 {
+		p[1] ^= 32
+		return 2
+	}
+	if len(p) == 2 {
+		return 2
+	}
+	p[2] ^= 5
+	return 3
+}
+
+func (br *brotliBitReader) fill(n uint) {
+	for br.n < n {
+		b, err := br.reader.ReadByte()
+		if err != nil {
+			if br.err == nil {
+				br.err = decompressReadError(err)
+			}
+			br.pad += 8
+		}
+		br.val |= uint64(b) << br.n
+		br.n += 8
+	}
+}
+
+func (br *brotliBitReader) consume(n uint) {
+	br.val >>= n
+	br.n -= n
+}
+
+// The check method returns the read error if the padding bits are read.
+func (br *brotliBitReader) check() error {
+	if br.n < br.pad {
+		return br.err
+	}
+	return nil
+}
+
+func (br *brotliBitReader) read(n uint) int {
+	br.fill(n)
+	v := int(br.val & (1<<n - 1))
+	br.consume(n)
+	return v
+}
+
+// The align method skips to the byte boundary,
+// returns whether the skipped bits are zero.
+func (br *brotliBitReader) align() bool {
+	return br.read(br.n%8) == 0
+}
+
+// The readBytes method reads bytes after the byte boundary.
+func (br *brotliBitReader) readBytes(p []byte) error {
+	for len(p) > 0 && br.n > 0 {
+		p[0] = byte(br.read(8))
+		p = p[1:]
+	}
+	if err := br.check(); err != nil {
+		return err
+	}
+	_, err := io.ReadFull(br.reader, p)
+	return decompressReadError(err)
+}
+
+func (br *brotliBitReader) readVarUint8() int {
+	if br.read(1) == 0 {
+		return 0
+	}
+	n := uint(br.read(3))
+	if n == 0 {
+		return 1
+	}
+	return 1<<n + br.read(n)
+}
+
+func (br *brotliBitReader) readBlockCount(p *brotliPrefix) int {
+	code := br.decode(p)
+	return int(brotliBlockCountBase[code]) + br.read(uint(brotliBlockCountBits[code]))
+}
+
+// The decode method decodes a symbol using the root table,
+// the longer codes are decoded bit by bit.
+func (br *brotliBitReader) decode(p *brotliPrefix) int {
+	if p.single {
+		return int(p.symbols[0])
+	}
+	br.fill(brotliPrefixRootBits)
+	entry := p.table[br.val&(1<<brotliPrefixRootBits-1)]
+	if entry != 0 {
+		br.consume(uint(entry & 0xf))
+		return int(entry >> 4)
+	}
+
+	code, first, index := 0, 0, 0
+	for l := 1; l <= brotliHuffmanMaxBits; l++ {
+		code |= br.read(1)
+		count := int(p.counts[l])
+		if code-first < count {
+			return int(p.symbols[index+code-first])
+		}
+		index += count
+		first = (first + count) << 1
+		code <<= 1
+	}
+	return 0
+}
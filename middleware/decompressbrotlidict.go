@@ -0,0 +1,1055 @@
+package middleware
+
+// brotliDictionaryData is the brotli static dictionary of RFC 7932
+// Appendix A, compressed by deflate and encoded by base64,
+// the decoded dictionary size is 122784 bytes and the SHA-256 is
+// 20e42eb1b511c21806d4d227d07e5dd06877d8ce7b3a817f378f313653f35c70.
+const brotliDictionaryData = `
+XP35d1RXti6IkueeqjrWK1fftzsj703DOah1CxI6A2M77by202XIk3VPVg6PFXvPiFhox1rba60t
+Kcj0GKIRiB7SYIwBY0yPQYDphEQzxuPe38VPVxrvF496igjpjXp/Q77xzbV2kPXuPYlBitjNauaa
+85vf/KaTdUr0mEplhVKquLKIR2KdUCKcsDU9plXasNJRLF1DZ6S25talcoQqhmhMmxFH465BwuhR
+MmWdNFI9ShVt6mWtR7JUNFI5SqlUVKM0q+k6WZlQXRt8N0m1qroa1UcljVWkSjJRpUQ0bCVP0xqJ
+xJGpk4hrwpCoGF13Jqe6MCOinFKeaVWT1VoiHKVCJYrGLI2SUjTuYmGprF0t09bllpK6SKgmVFIj
+Q2M14ZSo04dSjZRTXbVyG5WFxfMldTFCdSFVbsm8sib6h5pOE1KJHZOu9jGNWUMiGSNDVlaVEyNU
+E6NUFXWyRCoWaZoJVxujNM3S3NZJ5RWZ1jNh3FYtlatJm0rrqloniigZEw07RtZt1WVblyoRqdWp
+rmoj41puyabCOkeiLky9UdE6GZGqOibTlIR1Y8IkZbKuIg19Iqo0ovSYGBONnkxV63qUXE2oVIuk
+KkfJUlpR2lE9j2sVwliohtHxiIy10iqmVOuRmkwokZS8r+tk8pRq2jqxVYxLVdFxmpdTMWZTsrYm
+0orFHOZxbZtW1N/X96+0IhsLQ1tknYyIqZzmVNG5GSMaqYiYajqjqhilmsB8WzdWI5UJMzJCmcuE
+tbYmM6N1/f0tH32YpUJtaWSU4KJilEaIskoqqqlUI1anSUWOktN6xAhHTo+prXk9c7XcJsKMxMIk
+FZlShYSxTjRGZJq6mnAVkaYid5pGyfTEuu5EOmJrOhvVjhKirK4TzKhzuVFlbVRZqKRCaWq0pdyk
+q+2IVEanFGssRWdFlWydyFV1mvRszarSUX1UmEaFUudqpCypJDE6+ydJY7HOGv09faWhXjFsnc4o
+tZRKsk7nJhPxSE9VVjJhXWztP1aNaNRJqF9W3aCRCdmadqlwZIVMjBbJqDBRhSjdqmvKyHgk08ZV
+hHWv/HZjd0IiGeotD2damzLeu5HRb3s294xpndRz6way8cEPVEUboUbGZEJjQrkxkWIxJb/v+8Ng
+JvJ0TIySzQ39y9Wv/GJMSFcX1gpTt1VNtiqkSoWqZkImP+/ujlIdj+RKOqM1rjNSkaY+Jis0Xk9L
+FnuarBvoy8ZHpEqMHrNO67SilasLmVpRIeuEqYvMxtqQEVJVUj1WFuWGzYSyomFfy8YH38jGB4Vx
+tqK1MyTSMTkiayScdZQ5IzNtqr2pGKExEiNOp8l72tRjYV1FKFsWamSUTMPkym7N04YTdqQ/Gx+s
+apFWDY3ZVI9RUiWZbChZcvb1bHywZ6v9x9f6snFZiVZbrZUl4ZRW5PIybSOsBuUMUVIRsZPK6aqs
+uJow9f63svFY1Kkm07Ss02Sb1vVRLRMStmGkqlZkmmYkRqSSLtbWvZqND24V8YgTVVuWzhqdppRI
+N6JoTJEwQz/v7q4aPfbrzb/5OMld42PYF5FSQ+dRqp3NhFRbxbZtMWxTg2xF2trY2FiPkXbEibLN
+DI3292XjRloaeD0bfzvNKZGq+mpf39qySNOKNgkJo8ZkmpT1eE9FSJOKGOOFa5utuSJHcU1WVv88
+k/EIjcr0X64u/WJMmHqqTZJoslmepmv7+vrwlokRY7W8SjbTrpKrpJwbVTNUiSlNR6hhnYxHajo3
+qba2klPaP5CN21y6hET66ebNJZj8qqHGr97dUiJhSci6reKRZGLfysYHlRhtVI1MnMzsL9atWzcm
+jE1F0oiFsWsGoy+yWvaPNUpTJ9J0rKbr22rrm3/f+3JX1N/XVxNp2tPVtfHNbHwwy20trgnXl40P
+xobG/r53qLcmbO3N17PxSiqcEYaiX/4ycpSmsahnWjmNh6hLa+2IzBwpV5GK6iKlKjmbpdq91te3
+9uWul7tirG8i15PVsqGyGSYj47q2rprLpExpmpCNa0KauoBF0vVeWa/+8hdvDaR5PBKTcn19fYNO
+qkZVK6q5OqxEmpi8+t6n776rdEJKxiP/KJMNKVZhnqajOAOkSj7dvDkaI2EMpY0ykbKiTkk+QkpY
+EYuMxqStVfO0smXg1fU16axNtasKRyMyHimnuXE1avS/no2/8sqawTWDpeG6lTQmlS1Lk1htXJmc
+sEQjW/rfWq9NYrEB6iJN3+jLxivC1P/PiZO2rBv2931/6HllzWDpk99s3lImYUZkYtcMfvFFXZiG
+I5Ws/u2/XvN5LpJttfWtbiu3dXd3d2dGZ6+sGXw5lRW3pX/d+lEZk1BJI6GyG/508+ZM61QRnlOP
+bel/Y32itaFRkW7pf3N9Ss5WhEy1EWmm01TpURHr1FZJUfR/ThyzuuKMrpOTbMftUO3V4UznpiIS
+yqQaGXJmuC6VXPOnn6+uS0XbauuXy8LYGgnT17dmsC7Tkai7e1garSqGkkTakTFSzmqZZrmzvVtt
+b02njS0DA+s/2Pz2x1sG+taLRNQtkR2qDQxvtVq9sjZ6JdbKbRnoXx99unlzqnUmrBR1rdVQbzZs
+dZ5++MHH71a0cbEwbkv/a+uHav3Db/Vl4z/v7h5al40Pbul7bX1djtD61974ZyVjkiqu/RttRoyM
+8cxLr6xZM5jlhuqiSpkwwmlFZa2S9a+++c+f6cpnr/xhzWBfX9/abbX1bSfUSAN+gx5Ly7mtrX/9
+jX/+tRgVr/Zl411/+qLrX2169V+tf/W1f95Klcq7//snH8TC1kalFVWdVqzSY9tq61c+z3GGWmtl
+PFIn4epS9ZSlShJK05o0lMnYGlJu/atv/PP7W7Z80j3Q11/RTo/ptPLux+9E42U9vv711/757d+8
+828SGY8Mdn3RRePSrX/19X8eFcaWSbhXvlgzmEhy69atGxRK0RdfDPX+Xv6h50OhqiP1/+ePY9KQ
+0w0rksRaEqlIaXyw66UvKK5pJRX1aFPt63t9jdOqsZXGrBUqSalqjdaVvr6+NdFAX9+YVFQlYRJd
+tWWNPWEace6sa6TkqJ45qVXPeD2NdTxSFar6L1e/0vN6Xzb+Sa3nnbq0sUjZv1IJ2ZG6TMk0hMqV
+HE+kjdcMftGV5NbFqczW9HR1vdmXjXcP9PW980/v2Df/MDzkREbwgOU//MOaMVElyo3OajLVmbM1
+ndJ7G/83K6xU3QNvbElhz8nZ3376YVTO05FY65HBL17uev/dje/8vu8Pa0S5bLbmQq3uX/dWSrYG
+ozDUK4etVo1qDj86HslkRn/q7vp5X9/AGpXosd/3/2Hw938Y7PpQVyMrUvdy10svlYWqOiPrZeFq
+a/74clcf1sQXawZH9Pr/q0JkRTL8sl3f2xv9/g+DTrN/W1295o9df3y5K+rZal8Z6OvLklykZS1c
+z68/+dWawa4vPs+1WzPY1fXKmsGul7u+eHmgr/+1gb7+1wf6+t8Y6Ot/c6Cv/62Bvv51A338//sH
++gYGBvoGXh3oG3htoG/g9YG+gTcG+gbeHOgbeGugb2DdQN+rfQN9r/YP9L06MND36qsDfa++NtD3
+6usDfa++MdD3Kq736kBf/8BAX3//QF9/30Bf37qBvr63Bvr63hzo63tjoK/v9YG+vtcG+vpeHejr
+Gxjo6+sf6Ovr61+3bl3/unVv9a9b92b/unVv9K9b93r/unWv9a9b92r/unUD/evW9fevW9fXv+6t
+df3r3nqrf91bb/ave+uN/nVvvd6/7q3X+te99Wr/urcG+te91d+/7q2+/nVvrutf9+Zb/evefLN/
+3Ztv9K978/X+dW++1r/uzVf717050L/uzf7+dW/29a97Y13/ujfe6l/3xpv96954o3/dG6/3r3vj
+tf51b7zav+6Ngf51b/T3r3ujr3/d6+v6173+Vv+619/sX/f6G/3rXn+9f93rr/Wve/3V/nWvD/Sv
+e72/f93rff14l76B1/pfXfdaX19f37p169bFuq7rz85Zso7IYuUZ7XSiayKmWCRCPLuty5JU8uyG
+EPbZjVGZiFhYrZ3RFW201Sn+LuJcpIncqq1MdNUI5WSGiEIkVCaRVvXn+bOrZJ1WIhHOwBWIdSys
+KIutuB+WsxbVXGQ52VxpK5SjRMaU5hJHnaiLht6mlRB1bTJptS4bEaf4QKoTqW1NGzyXnH8wf2v+
+8fyt+Sfzj55PzN96PvF85/Md/LN780/nn8w/eb5jfmb+wfzj+Sfzd/lfT+bvz9+bfzL/eH7m+eT8
+mflb8/fm783ffr5//tHz/fN/fr7/+fb5J/M/Pt8//+P8k/kzuMb87fmz80/m5+Zn8K357+YvzJ+Z
+vzd/+vn+5zue75//ln/6aP7u/K35E/O35o/N35o/83zn/Lfzt5/vmL83//X8g/l78w/nHz/fOf9o
+/tH898/3P985f39x++K+hfOLuxcnFy4vTi48XNy5uGvh/OLUwu2Fm4v7Fi4vbl/cu7hncWpx1+Lk
+4q7FnQuX+e97Fq4s3Fm4vLhr4eLi7sU9fI19izsWbi7uWdy18MPi5MIV/I0/u2/hyuKuhcv8350L
+d/mqkwvnFycXdy9cW7iyuG9x1+LuhRv8jcnFyYW7i1MLdyvSWDcqE9KprNbcmDZpUqdEirEaov5U
+WyrDPzT4rcXpj8jeZqmIqZ5bGVckpYk2CZkMYe6oSHNKaZRShzC9rIVJajq3VDU6z4AYWMAF1joO
+4hLRGBOOjHXCeAOckEDkPkYmq2lFCvclY7SRKsudKOvcARewTrqUEFlw2O9SHYsUYIZNhakyvGA5
+NsfqgdscU0XHuY1TEgZBX1pGGAMXjYzgVSaAStRJCcQ7da2oIXGyAi2wDZ0rxKG4vCMT61SbqiFS
+FaOV+6WoZ4NjwsW1ijYxZTigEUjbMlWlEhVHZlRa6aS1OQlDwpYp1XDhaNxpJ1J4yDYVZUozI5XL
+DFlbzmXqOPS1GVFiXZ40nBEJwmuVWFKWcpWQAUqD4K5ujVBVEkmCD8s0BRSQAKdQogxUJhW2VpHj
+lOiKI6VdDU9FYzauUTySUlWkRo6SQURrP89lPGJrIqNaXheKxhm6kIwvSHI1aZKysDLOSMQwLVUa
+k4mrpboqFQc/Y0Y7AqBjc0vGJri0ddpQ2ZAYsTp3tVEtY7LSka1r5WpjNTKEl07GajKGL+YwnHnd
+1QzBqBgHMKWxKZXxCCI4A2zJxqmwNhUNgudhGrhHI+cwHcMU69y4hs5NVJYG6yrLM4THVmRZ2vgA
+81smqap5lpFR2hFWk2lgTC3CcUvjzog6phaGcARQiyJh4HBXhbJ5RiYT/F3jalhbCqiLwqIBdkTJ
+FixWPLj9FXZA2QiV5BZojq4LVRGpJUMiaYg8kRqzZcdqMiVgFb14wSQWlmwiZNqIazJFsCTc1jyp
+kqtpLAHprMJDl40WSayFdTHAOLwgARGxcSPGn6QIAIuFZR0ZwzR/nhOpTFIMt16mFSPqhLASO8/p
+VNaliwVWhxyVKdweIuUIYUOdsHrI6TyulTHORjdEKuwIJWPwYqxUWBY6HokwEhUhXY1nFN5VQ1cq
+ZGysM8JgJnVscZGWMdMeldOJMMBDxFZtnIE3JZSGi6VVrHM2MjLBgUObYTI+xKqriSxr6DjODWDM
+9RVDtvZ5Lh3GoG6r2DgK2yg3ZaEquCUWsK3xWOVODxqdO+pB/FXHJqlIJdJ/g5VjU5mQ05mMy0aP
+KZFqRQh7lc1S6QwQyk9xvURgKQsT1+D8EJA+C5jRJjovO2EbKna1vI4oXI/FNUmVBraB0qOUImQf
+tGRGKVdOpkAr7Sbsy82wXHC8G1sZafw8F6kbkzH1IQLfDLOZCUUpcBdrMBXElromKzDmHgS1KYnE
+ApKzAuCEq5EleK6w44of2ok0qwn80wFitQYvAwAXG0zWrUjJAuuF4TbY3ao61Ft7dRhTVoXT3VvP
+UweIxiSfYHNaJ1Ti9Agpq1OZrAYAWgYiAsQPV6lUnJFY3mnKiG+jEvA15bbUpI16e7u7h0WCAK3a
+yNy7sPKIVgffxWIFFlyKjbawjsoxlFvW46VhbOIkJTEKEEMJ4Lu2mpN1Q72114aNBjBMYrSBC6zl
+swMuTRIbnB2yqqwYE4YSoWLKasLSMBAZUp/9dvMvf/HqukH4uuOfYVGnwklFaqtuACvtEYgabF26
+GqC3CNgxnHcyUiVSKDFaGgYIoBj1AppoE9jTzEhtNuPsgZdpDYwCcGK7VWRsHlLrjBQpdooROHKG
+emsDw6KcWxIpGcCKRpS6e3t/FwuTWOBQFsCx/QSb2Jnc1XDmKYAT/2iFVK5OAEt0LtnCuQyhUdlI
+qhg9VhqukjLkTA4bq0fsP+E8fw+WoUeRw5R0DTnTiP7YBUQSiLmNscKyVEglkjx1n2OwnWHMsKwN
+gH8bi9wiTAUu4rAqSZoBhFSMZwPTs5uwsWOdp0kVFt3KhCwAJQChlGJSkjq2C0412FNpGd5Ocphm
+Ghe2YnLpAI+s/dOfOFDJSsNdmDzAEiUdk1BDZdM7XEm1NhaYXEKZq0U+A6BGbAwrj2wDg5eDIpVV
+lZBI7RieCk87mJt0Qwnwta3Dp/lIWxf19PQM9Yq6VtUyXhpJkEhpRYNIKySxMKaRGFFxhio4K6r0
+GfIgPXWsjYRS0UgMiXqGDAonCoZ6nRkG/GPx8JHIjIQBQqibkqJxETuABrVYJ2Rx4MbAmiNLVLfl
+VCiGhm20eqCvDyB68hl8CECfln13LKERPIHlzYh9ngAEGBzDcZIJY2n1msFS9Dbco1SqfHyrVmQz
+OU4pQJ7hNYOysrobRjYRozKpaWPpPbhWRkjkXcbJbsFRSXXCy1B9uCxMabjHmniDg2UQqdtQiuEd
+1kiZxsBrbPpcnkkn0gb8sKwucZ4K6xirtrAdPYYsIX1AKfBB2xtb24sMxCBSFNa6vFIpy3JKQPYt
+4L++EW1IfLFm8OUuoPr285xy2hD98YtBgCyDMVIqf0T8K5BsivG+0khbQ3orMtjPcFItkOVSQ9S0
+XgOwfCM8UGSr7FBvrX84oXJeBcDMUfoGxCz2izWr1wwC6BzMYFUAKVrkF2zf+Bt9fcgalBjHLePo
+cGKcbF3GRsN7S7u7h4d6AS5b62iUujF5WFI9X6wZ7Hqpjk0Sre5ft+69jf/bUK/RVTIVwPqbsGcG
+AJgiyWOBJA3ZWDvnyDo7gGdJjFQja6I//SlKgQwim5P+ou/VdYNRRZsIebtkDCMOAze4Vaz/f++w
+sq7VEJB7Q1naQNrD5vAA4J1lDufM27Cda6Kfb4gSQxa+RWaN1nWr1Qg16rosU2TUegBjRxl850qu
+VMMZgt2o95b6MRxjcMgzYUR985aNn27B2opkkpBaGw309QNO6IKD2zMqTe6hVGeEsmPaWIcEGFxr
+rTLsX+yZLt0dixhZCU61WJyUkbBSKKAufxSJLtMrmEyZbOjvw4EwiHRd1DPQU5dIopUAe6tYi7i2
+CT5X2eQxDeBJf/vph2v+AX/5E0BssohSa9ji/QN9pQhHrHNm+OUuHBAbEklVjR0fWQw4bGfXLyqV
+ymBp+ENdhYdXcoaEszUitwbocz/vhZQoUx5wTinBVOxEqiLGIJbw0pwdst1lPd6dINDq+mXqBoG8
+2vWvvfXPQ4lwotvkRqTIPEU4IJOyGOFQIbMbolJpMKtlpeEYo9aPjETZSKEAo2tYww16w78aeC+C
+NaqLRpmGZL0ayXq1NLw2qmy1+FtUwvh9tEVnbyNjV1I0ljbeEcqOxNuIfWWZwlfGJnl9uCI+Lw1v
+q3XHqr9vzWBXd39pzSC88A3IFlpn8rQBW2IBJg0Ov9w19HPriNIISY/awPDLPPvRVsQS2PERZqvn
+5a6XXu4aEykMmR2xOcxNglRHuTuVIw57qyorpQg5Wpvg0Yisk7b2ucxsruvS6rKhhGxCnNtxOtE2
+yykh4CKWrHt2zklSVBPWCe2MZjeeEq0SUjmNMo5i8GiiLm1d12mrNvVcJVp8nj+7AVTF2md3Ui0a
+eSIqhPSDTnAx5RBnapsIp612RlhewcjRalHTRqR5VRjAIoas8wCIdaQIBpwsMFU8n7CZeHbD4lmE
+FWmeMGiD6F1/nktS8BdtphNsWDh7ZJ6dG6UYbxxLs1Vbhm2EgWclqibPdA0wKi8DR6qqRV1Wdayt
+sAppfLhmhFcVQhpCak0yDmRrIpaiIkY10kdSp7JsKMuV0+WclIZ3bUTZyBT/FPAidV2YbdqKMoI4
+6+BjVHX87E5d4yX1VvwzI/NstoaPYAwaKlexqOdbyYyKVJtKjoNElo2u5tYJWc1FOopxiYXVtpo/
+uwG4KdFW17XF2tK5dZQAslJKxzUq5zYWFZE6QbnR1hKcURnXdJwbq+MUDqSwwqb07I7KUrFNA1hg
+bMqO4plFpht6K97SGeFwC6djQ8Jw+qhGdW1jGJEYXwOsZbVJSGHRqGfnDAnAxTqjxOiYTMyPKxBL
+plgb5tmsk2mcCqO3alMluMmUaUXGCVxGJJIQawgrqzlRCvsvq6mO8W5wsmxdJIYQHfsBVPLZbf25
+v4cVCIJiXQOoNyrFVspEOdXPruLMwZI3JJVOaKswFa0SHQslUpzIlBLCYJFbwXNYF0rbNFdkMcl2
+VKYYN5VoRNgGOCEvJIEn1WlKoyLDU+VKJnpU1LUF7GdFvayRtE3wOSng2/JeEDYvS2Ok3ipG5ag0
+COJ0XJOxEGn67MZWjVvJuCaI9xRMgRWpNDanFE+gbYURGyCMmG5+QYxjVSiqGo1ZFbUcqKOoCqxd
+h7MhJWlTYcs6BYT57LauiXIqUjzQs+08bzF4MFVhlHbCjuKKeKBzmGmR6BSrXWzVsCBXsTqx2bFP
+jNQ4lAQcZl3Bg+NEFDVdFQaWxabI6IrPc0rruSUNB5LBVIQVIoklpfz6ui4p0VWhhLGwJeQwrFT2
+AZtgo2YlOYoxZbHGM+eJsAlZAnyzVeNvApCs/SVi1cFE45SOdb2uFZye3INqtg6zY2zD4vH5uCgL
+pcgYYjc8NjpN8wyBbzXVZZHC9uT1ikwdGZXXy7A8cGsM2Tx1WV5OZWxjQ6TimtYW67wuUmcESELW
+5mStzk1MThhOshpGnJI8JXYlCFScuMbwhC0z6GioKjUwEEorVsdSpHjOUYp1mteVwdsnFY2ImwHD
+YZJALlIC86gi6jJtVAwg8FQgGBe5q2mDXezIEKNjeb1OhiNzg9GlhP80NJ4JlWQ6lXGDjwCHKB9b
+VCrnrYnNyFitUjkqVTXh4JKBLssgoc2V/DynMQ7XM9JZSth41YYSLjeYHxPXKrKaG/CMpKrGvJJ0
+pWIJyKcjAwBRj9m8XJfOkErIMMhq8yzVIqmRSF0NSI1OGOC1FoOeVnK+fk0keiyhsnDEmK39TXkr
+xY5hQevR3pRENae4Zpi3Vc9SUtrJmBgqBbqFAIKE1coQgg68qyL7eS4A5DnYHABsFgAkZhBvwZwW
+JKocmfcMR9lkpE6sA6RgKCPhPoTNUQk5gFLa1GG26/DgKc4NZcJaSpyuVlNiGNomhOwx1q2MY+kk
+WesQUzVwko0J50Q8gp+QqzDIXWPfEuym0rDOSFGSW6rkKe/kBseqePcE8x4bcpZirRJYp6rHIS2N
+x5TB7GMEZFVRwjiIpUqFYseQuEe4ra5UZEyj0uaCeR7ajGJl0qc8YtjmeR0jwzEtzuE4Jmvr2rq0
+UefJKEVw/gDKkKsywoM9hQVkRqlRpoo2ZBv1sk7rbGhsRhTX6hq7VSrm2wmslk0M3mmeZcZzbV0m
+SUrvIgMJczgGALMq4kad9zgpnVdrsTBE7GyT0UZWpcJzizROJe5FKcXwxRNd51xBwjgZXHtwXwSe
+X3M+lddPg0PEhGwsMngaFnYgN3EtIVhqw2sp1kYRwLgsd3Wqa9OQjEzyXiOG7u3HbFuSHPbBo4mc
+O7BgfVECl8IDS5RYmY6SqQtTBdqekqMy7x1AeZYY47S/YtsFTBaREZDVmMeqzCir0mNGZLHB7Hks
+jFQV61xUyDXiGqPYmTTSdfuHyGBv66D8VBVRQonJrZUiS0lYonE49ptjIzNXNoDHGA5EVGOqlEjs
+VABhWPZ4x25GEVyNtGnEGsCl4DWATAolAFco2cRjKOuZiJFEztNEpOAXprqqS1FZO6frGJPS8Jo/
+ArXJDFXkuOZ0wfu8zntAj1kd6zzDCoB/UTYYiFTkKq59ypbQ8Rshj4F97lxKCa//t3mnl0nkrsHQ
+MOxbVbvNbMEELL0RKa9oLRI8rT8ROCJ2mEFD+M1HbOH5FCKbA57fzGcBm3mLJ6Dkl6pss0GA+pY4
+EI9wVKtkCLBthPy45HzNhpKoi21aYbUYJZJRZk8gGlofbalRlEiR6irnpezb7/7qg4+jj2hc4lwW
+xtmY44EaW2bBIcUHfBdhLTn7bj2ThjazLaUKoJhEGoqdTwvUhcpFupn3RU9X128UgzqUIGQpDX9S
+k6nMGMyyNWYmyDr202/YShgee17EVjGeuBm/tAlVDRHg27QRraaeak+ZauBdaKyTVFerlCAPQMwC
+HOrlrBNDu84KayW/lbQ+/ZS8igD/T3DpEgG0NW4gA1Qnw9zXt43YJlMrYO15/QyXqaFV0s34P6xT
+5vg0TODPKHpPa0dweckIBkS6PoPJLjECy3bY2lLUO/xyVw9HY5qZqfgfmd+MV7RJ8HxkYM3kKO9c
+VWGW1TsejkU8WhJZhtOMxl1pmJOLFhzXEQsGMIdgMSUCp00qVEMr2lgx8BIxbHCCSblP+IQdKpuo
+d3gMh4vhLB3jMpT86U+AhfD+pWHpT7FcITdpRFYaBteIkpiUzcHVzWFDRKwVw/y2H8jgn/j4wRhq
+57MpJSA3lPBJOog3EXyWibSfcwz4SZ7BwYmpkmrh1jPwEHHGq2cjnwKcWLLWp/tgN5L3PbgPPND8
+dst73W+VOPUYvc2nQE/0O+lqSBRKzXixrQsFbpDRFem2/m85M1WwPhlrsWUYeFcRwDKrWlcx49ia
+QEmiP0prBKVWNNh7iWVCQA1LwzW2GBgMJPOwvzmFZDmfOsjDkDD4OmREzLsbc9jdDWZrJsAjtzQO
+tkhuSOSMhkX9nM3QZQd8Gn6aFQx1rY0+UDGAEkoavIOitGFkbDlx3AOLTglnhBqfYbH2vMceHYBr
+Sj7iEzO1FMnKJ+yzudyMUGPNIHNpObSusm/G6WX7DvvAX1Bq6Y9SWTLu7VRXh3orvMI5s9lTYX+Y
+cxR2iJFeJG6ivsyIal1UjExEg0Ngk+g0FYb9k4TzcRasGaleZzAKoFBpuKwRl/XAIVotRoXjGMBR
+8hm/Eq8Ey3tTcKZ4bYVPN/a+mItOhjMAq3l3mM3sB2Lt4spJozTc9fcwdltqmGZLSHNuJWOpAS77
+UC9SvZUGjWdknFRbc9PgFO6GTWxFGYiLBGdYPmM3DmlYkcAujVKm4xFynG/p2szRQfQOwOAYjonh
+zL9lRDvZCPg6MgiQGkknv1warmvDzwmDx9l0i7A5t8xHAEj5+z8MMg+hp5pLJ0xFKjBd8YTrkZ2l
+5DdwLiKkNUqRsMB/eJLtmEwtohjNo5RKqtgxeKubfCQmrEwbEaeEN/Pp09X1u5pMnWik2jA7YL0h
+q42rGHgnjkevtCb6h6jEMz5czrEjOI9rP+IzGicOPMmMXUDYZKSpSsOjTDvgGNhwLjOK4awgEnSA
+1hz79llGaqhXidHhEaGssLAbpWFOTdsNnB/mhHBU46v1+Q3f1fWBiiKmSXyiUzsiu3kQt2qTCPU2
+n/icG4y6eS0NsM1BjURpuI8h19+wletm81THujKWsG7BZ4z6eDtGTI6wHB2sIY7+4H1LA25cKYr4
+u5av3M2b31D13fFsPadUUTMhFYJ2qZjIYTmv8TKnvqOul7AHh0EOe2mo1yXDXfzbrrKoCSsAsci0
+KlKq6rqoNoTJ+B2tyeyIZMbM1NLMjdZXe9rTE0uPv2w/3L7yw7Glp+da228uzdxonnrcmjq6NHd9
+aWZiaeZac/J68/BMe/pc+8ju5enZ5plTzcM3l+Yutk4fbO471zpxf+XE3aWZa0uzs0uzl5tfbl/e
+c6354PbSo+1LM9+0zl5sn97ffHBx6fHp5e3H2nfmWj+ea53e2358tP3Dydbeidbpvcs3dy1Pz+K+
+T3fikX74rn3sSmvqwcqlr1bO3cMXJyZb+yZap2+unJhe+f7r9qmZ5uSdpZn9y48ft46cbt/9funx
+0/axK8uPby7N3W+evtKefbo0M7fy/dfL93a1vjq5fHn3yvdHm6e+bV442Lpzpbn7AO4+d7p17P7y
+icPN3ZPN6YetQ1eWDx5pzuxonp5t3Z9qbb/Zvjq7cuJu8/DR5szOpbmJpYdTzYuPm4f3t4+dbd2d
+a5560t67B7+9fbx5aUfr29Otvftbp2dbX91aOTHXOj3R+upW66uHzUeHmwdOLM1ebx0+svTkFB57
+9lDr1N3mhW+Wn55cmrnWvjPXnjvbPntxZfuXrZmZ1tTh5sOnzaP7mpP3l+a+ah4+unzuSnN6b3Py
+Svs6T8ejb5pHv15+emr53IH2jofNPXPtvVOtMzvbx+41bxxZmvmqffzA8vST5elzzcmDy3cfto6f
+XN7xY3Pfd83Ji3jsfVeW5r5amrvePL67+eX25sFvmtPftw/dap6+sjSzr3XvQfPSjqXHx5sPfmzP
+HW4fP7l8ZWL55qX23O72hSfNA7Ptk3PNx8dbp6+3fzi5cnpi+dL2pbkHre8etY7dbB3Y3jz1eOXk
+5MqXT1qHLjZPPW5O32/OzS5PP2md3t88vH/l68nlm3OtO8ebT/YvPT7YfjzdnjvcundoZWJva//V
+5acnW989aD7+srn3YHNqd/v2XOvQn5fPHWie+m5pZnZp9kLr6y9bpyeaRw6tnL2z9PDI0szB5Z2P
+Vya+WX66Z/ncgaWZb9qXj7b2TTQP31ze+Xh5+knzwp7mkamlx6ebFy43p++3LzxZevRN+7tj7av3
+l2a+XJr5pjl5ZWXnlZVzD9unppefnlzZc2D56detr282H000L+9v7Zxs7r7XPnZvedfR1ld7sK6+
+3N7ee6A5M93cd7X11Z7mga+wumaP4Po3LjVvXFp6+F3z9K3mmYnWvcPLl6da+44v39vVfHCxfWX/
+8qXtrds7WhOHlh5OtU7cb06cbO47u7zz8dLM3PKl7e1T0829B5fv7Vqevtk8e6g5s6P11cPWneOY
+08e3W/tPLW//unn+VuvrQ0tzc5id7Rebsw9ax2+1Dk63nxzCbr39ePnxpaW5/e25g0uPd7e+vokR
+u7e9fezs0uzl1t6JpbmLeJebZ1pfP2lfmMVCmj3VPHh86fHBpdnZ5tGvm1O7W98ewfOfetI6Mdma
+mWk+uNicfNC8cal97Gz7zlxz6kT78dHm4f3Nma+aN440b+5uf7d95fujWLeHLjen7uO7B2abZ2db
+p683J680Tz1uPz66NLNvZc9BvOP160uzB5pfnW5d/3756anm5MXmYQwydsHsqeWJncs3jzcPH8VS
+PPtwefrG0txFLMhjT5uzp1tTR5ZmZtuHbja/37ly6WRr5mbzyAEM45XbSw+PNA8fXZnYvjQz0Zz+
+BnN3+Cgm/cvtre/2NPfs5tsdWr58vrn7TvM0tiTbtP1Lcxebh/ct3z2/NDfXmvpqeWKyOf19a+rB
+0tz55XNX2pdvNqd2Ny/eWZqdbR2/hTc9NtE8Nt3cM9s+dKt9aa49+7Q5e6x97Epz6sTyuStLMweb
+Rw60H321/ORoe+7s8vS51tmLyze/xyq9tL11em9z8g6Mw7e7mk+mmhe+af35RuvYk/bcrvbcntax
+p+0bX7VP3W0duri883Fz36nWmYut2SOtkztWvvoSxnPqVnvnjZUTV5qTd1aOTS/NfNk8MLty5tvm
+zMzy/tvLN2+0Tz5pzl5qzhxonT6F9XDxTuvmseUnO9t4ht3Ll3dhZLATp7HgT1xp7Tq8fHl7+8cn
+zcdXWycutk7DOq388DWszZWJ1lcPV8583zw7u7LnaPPCrvaR3a0zO1dOHmk+vt3eu2fly/vL53in
+nN7bnNq9NPtDe+9VbI25w8tHLrYezDX3fYcnnL6//PRk+/LB5Ztn2A4/aJ25iE237xxOk6P7lqdv
+NR8fx+kzt3/57pWVPYfbxx5gKT6+3fzyYPPRcRwB+y42j+5b2XkFc/H0h5WJs80/X1m++T2m/tY3
+rTt4kfaxO8s3sUpb351rzh5bvnmxeWh38/CPzcPXWl/fXH56rHnq2+W7N5Ye3moeOdi+fIuNye7W
+1BFswJt3saeenGrOHmveuMr280ucL1f2N2cPL81MLE9db53e2fzyHK6GSbzfnN659PRMa9+F5YkD
+SzPHlub2NS9cbV870Tx8vrnvSmtie3vfffy592Fz6ofl6QtLM7PNp5Mr5+Zap+62vrrVvHUYN506
+2pzAKsVvr/156fGXzQOTrf3XV3acX3o4tTQzAUt46nFz9yTM0ZFLre8eYqcfu7d8eXfzxqWVk983
+Jx/g3Hy6p31lf/vY7aXZQ1ioew8298zyOB9cfrqndeN88/SV1teHWl+fbR2fXJrd35y8g/P3uz0r
+338N+7/v3PKTL5uTF5dmJtqn7jbPzrbPTbSOn4Qpm92NLTM3275xtD33Zevb0ziAjuxcmfgGi2r6
+XHPqz82bD5dmDy3vnW2dvokTefcB7N9jZ9unz7a/2YXv/rC/fWNve/Zy+/Zc89v97bnDK9cONKe/
+xR6fur/0+HTrzMXlPdda3+1p33jC+31/89DZ5tSp1onvcExM3odLMLEffsXMRHNqT+vAnubBE9gF
+J26snNrdPPU9n4m8ue5caZ76rnXjQnvycnvvntaD283Td5rHd2PJPXzafPAtr/OzKz983Z693Do9
+sfz0aHvuUfPIFDY77OGXy0+O8jmCswbHys1dS3MPli/vbT45gZ1y8BCOs9kbzekDrakjrV1n8avp
+vcvnJ5tPTjSnbq3smMYZMXW/OXtp5fxZeEEnH7d33V9+fB1eyr6LuNr0LXgyXz1snZtaPnegdewp
+Zu3WUZiRL7cvzZxevvi0dfhIc8/syuSfm7Mn2rvuN48cWpr7unX9SXvnjfaOh7Baew82pw/AwMIz
++Wp5+kn78fTK97ubNx/By5r5pnlgbuXk9+3T+/F/c4ebkzMY1VPfNR/eaR6+1Tyyq335YPP7b5v7
+vmudOdL66iSW5fffLj3c3zxyaHk7TtLW8anWt6exth/eXZ6ebX21Byv21GNs25t723unlmbmlp5O
+t449bB7esTRzqLX3y+bBW82nPyw9PAIr9/2t5cs74Jzc+BrO2I9P2he/XT70gAfqavvYlfbc5fbc
+jaXH3y3NXYf9fzjVunKu+ejw8s0b8Dd2Pm5eOLtyanL55KHWlweXHp9eOX+o9d0j7Md7D9qXj2J9
+Pv5yeceP7bM34LKeObc0d7/141z7qxPLT4/Aw5m9vDTzzdKjb5anp5s3Hy1PXWtOTrWPnYX/eeps
+8/BNbKvjh1fOHeC1vWvp8Wl4j7d2wmjf2QOPcc+15r4ry3fPLt8939x9emnmm+XppysnppvT37a+
+egz35vCJ5ekry9Oz2LMz15qHD65cmoKX9WgW5nfi8vKBnc1Td+Eh7z+59Ojoyokfl+b2tU5cxClz
++uDy5QkY9sM7mvu+a3+za/nmGezBHQ+Xnp5Zvrm9eerJ0sNLrdN7MRH39i/fPN6+DM+ttRc+Dw6y
+6W+Wd5xrX8S50/rqxsrpidbtHTiDnpxo7vu2def40sP9KyfuruzZg/c6cnHl2AS8kZt726emsd2m
+brd2TraO7oJ3cXovzt8Le5bPP4ZXc+NS6/Ts0qNvWsdvNW+cWD4/ufR0evnuDRj53adbZ47Cazrz
+PXbEl9vZHn67NItzvHXrbvPhndbhI7yb9jVndizf+n751vetk9MrE9/AqsxMLc3sW3r0HazB1A+t
+Gwdap35s7b/I58gpuM2TB7GDDl/DiXniPg7iO3uWr+xbfvKkOXVraXYWMzj9PdzIie3Nmanm7ePt
+C7PNpzsRldy927xxpH3/x/YPJ+H5nzuwvOdae9f99uzl5tQP7emJ5sHjrWMPV85cxXzN4oSFHd77
+ZfPRxNLc+fZ325tT362cvIDBn5pZ3rejfWp6Zc/B9o6HrevnWsdnmhe+ad46ipN06s/L93at7Dna
+2stPNXexeeQQPMDJK3zfe63TE0uP7zRPfdu+cax54So8jadnVr453T50a2nufOv8ROvsxda+4+2L
+V5q3DrdO3G/9+GdM9I3zzZmZ9rFvlvfcwfMc2Q2f8PFxnJWPJpoXDjan7q/sObr87cHmw5n2pcPw
++U9fbz7+HgfZnt3NC/CB4bKe+rY5das1caa1805r4hDOkYOHl6enl6fxavCfLz5emr2MEObA3vbk
+ZSzF85PNh0+XZk5jgT36YWXPnuW7NxC87N3fntvVPPVk5cz3rTNPm4d3LE/sbB172vpxDuHS1182
+L1yFy7fvWnPfKZjouevNg3vas5dxTM9ewnztvYo9dfxu69725XMHVia+g+XEGjuxcvZq89Tj5bsz
+uM6jCUzlqZmVE6dhjQ/daj6+jZPu0g7Y8yeHmtMPMcK3Drf2cZR05lzr+hOEijsnl6cxNa07x9nJ
+ud+8Pbn08FJz9sTy029bhy62f9jfPHhnaeZJa99FhAYPLjcvXlx+egRRzORFBLBnLvqQdml2/9Lc
+fayZY7dx6Bw81Nz3LQb/0lTz0lT79NnWjfNLMwdXjp1sTl7BKxyZah45sDT3oDnxqDn1A1721NH2
+mfPNyQdLj79EFDZ7rXnq+5WvcZzBou592to/2fz+25XjT3nHzcKLOLAdp/D0/fbc3aWn083Dt1oP
+nzQfXGoevrO849zS3OP2jWOtc1PN6W9XTk62j91benoOQdmR3e1jZ5tHHzenzy89+m5pbg4x+I3z
+zUeH23sfLu/9cWlmf+vMxebBwwgVD1+DxXj8ZXvX/ZU9B2HqJy9j+g7sgWF8egTL5sTF5tMTy3fO
+w2e7P7n8dE/z9Fm4yvdOtm6fbR27iQPo0BW4vj+cxPOfuYHzdO9lRGdT91cmvlt6uB9Oy6ErzYdP
+OZacbR0+vPz0VvPw180DX2HLz53Fft/Le+Hk1eWbT+BiHYDFht/4dA9uemgOZ9Deg61j9xEdPLzb
+nL3UOrOj+fRE+8mhpZnHrdMTOL5Pzq2c3N06fQ3vuO87DlIOLt893zp+Er7Q3qnmmRPLsz8sPf5y
+Zc/B5uzu5p7Z5rFpLDzeL4jHT59dvs0/3/lwaeY03N0d0+3p282DHKff3rG8/UDz8FfNJydwGj4+
+2D41s/T03Mq5e/CKp3av/Pls+/HRlXP32uem4VeferL0ZD8iozMT7cs3W99uXzl2cvnmzfapaXhB
+02eW755pnZ6F03L+0MrJq61DHK2fmGzd/HNz+rv23qsrJy/A3bp6fPmHr9pfPWmfmGwe3gfLcHsH
+DO8PJ3GS7nra/uFi6+ZhNik3mhf8jt7dunkYp8+Fq627F5s3TsCTPHyrvf18e+5ua9+F1unrwE9m
+rrUmLjenvwYcMTMDz//UDI7pqa/bxy/C9X38ZfPJ/ubMFIKjJ0/hSJ+6sbzjHOLQqafN2/tb3x2G
+OTr6NUb75qPlPXfb17ZjRW3/kh3R6wynfNk8MtU6dx4rdt+d5r4rzcOXmhe+wv6d3Nl8dBwhAByG
+r9vXrzdPneLT+dzytWutrw+tfHOkfXl7c/oMhuvyruUDO1v3p5bvPmxO3m4eOdB8+vXy9C2AVHfm
+mjOXMO9HDjZvnGiffARs5NFE69gTHKM42U/wSYGV1rxweWXPYUSOHMGt7DncnLy9NPsDh6hnmxfv
+wOGHtwkMCl7K3JetM2dXJr5Zenpu+f4k7NgkMIrm4y9xGD36un15ojVxubXrbGvq6dLs5ZXt+1pT
+P2IVHT4PbGf2QvPWbXgdT880t1/kCOJS8/CO1vFbsC3wqQCRNW/fxpLbOdnadba992Freh9W1Llv
+W0dON2/tb97+dmn2EAK0qQfLd79HrHT+1srZO83DgIOW734Pd+7MN7AbD39c2XmlNXUEJm7qavvU
+PkTQJ4+2po6s7HzcPDC5fH6SMbT7iATvnYIL9/Wh1v5TbHAOtI7fhU37+mb79KP2mVNLj04uPbrb
+vLK9dfoUPMmzZ5sHfwB08Ojr1s7vln/4qnl+D4zh5O3lvTcBgk1OAqK5fql5ZGrl5K6lufvtvQ/h
+vcwcBPyy/3brqz3L93YBefjuIaJLxCBPgBh8/6g5eX3p0YnWraOtE4eBg+37DpNy+AQm+sal5pGb
+zX1XViZ2wMAeOts6c3HlxDTM4OnrAAafHFo5f7Y993R574/tK/CC2nNTS7OHEHTsvbr05BQwliOn
+2/uPNPedWtlzeGnmIozerZnlp6ebu0/ilU/vXTl5pP3Dt0uze5s3Hy7fPrd892H7GMfvFx+35840
+bz5sHtyOwPbpzuWnp9o7Hi7fhJsE0OnR1819T/HJW0db188v3zvcfHh/aeYYwMwdD7G5jhzl0+EW
+DPWxKwC+vnrcfPw97nJvV+u7R9g1391d+eZw+8wErNOFywADv77ZunkcK+TkLGz1ga+wy6YPYOMc
+3908e6g9dxbhz55ZHPp7v1yZ+K597HvgG5d2MEJ1BdHf0zPt4yebp75r3/0O9uH0fvjeO67ALzr1
+BGjkrlN89JxtHj6/NPeAXSbE+DjBp/e2Dv25deNS6/belT0H2Mqdas7NIrC9Orty6avm5BQijq92
+rVy9De96Zv/KGSAbzd0Hl29iv7R2HV56uHfl6zvNyb3L+28Dj3q0Y2l2urn71NLs/vahqzjjnlxY
+enQCPuSto82Dd5qHbyIev3Ibp/bkg+a+K+1jc62dd+C93EHQ2rx1GL7oxGWgeRM7Adydu44z+vKO
+9un9rZnJ1sVvWke+bV8/CpT4u+0rc/DKViZ2YNnfPru8/3D79Fl4pzNz7elzK1/fb03fW74y19y9
+r/10bvnm0daZnc2pq83t38AjevRo6eEhBH3TNzFlDy6290+0JvcvPTy7PD3TPnQTm/rsw+bkHMb8
+6T14I98C7G1fvIKRvHy+eeBp88jBOBcq0aRGpTB1kRiZMEXSSCVjqZ2keqYzbT7PKc5JOSbdJZoJ
+s4oJmzbWyhnBxFmldL1siPmzKiODIiyhyAimlNpY5olIOM+uRa4+z4mvYxP+CbPmTWYoltpS9dms
+Yh6pHdXgcjCx1FpCbrMmyqhdrnIiDZRcy9RCPHaqUSwlM098tMyttSKt5krE2hjSvrgzE8ZJI4yR
+ZVEX5tkNUeMnJyTAkcjAc4p6WQZGIninWmXCikSnz24oEpngDDOTcJk7ytTcsgAF1zAXVluMrdPM
+7dF1EDbTUWEk/9wZzaNkmYBrmaSrWBVAMJPXIg/lSDBHNCHUc+sKGSeY6WmZD6OlE6kUTM7EOz6b
+BYsi85XbZJntg1kzmbaS6ZmGlPVMSMP8T5tIRUbXcxNLkenEPDuHam2nE4kHzXIqp0wqNhj3nNLM
+6Ezq2EgrbSzJOMxUjiuQAckfzxyTMcJweRURiFE64xQn3isRfAWhKxg9J/FR6kbiHCMjLOfKLXPV
+NDhL/vrSGEm2qpk3aJl1bZ/NKhnrWKBmhGnU1ohtz+6ohMoSb5qD46ZTSrQj9ewGXvbZrGV2no51
+LJXQRiKji5ERsSTldPzsXCK3Me/TYEZuCK72Epj9bVjhMtXVnJ8ZS948uzounU6f3cloG1ZVIkaf
+3UhI06h0AuxSiRyhM9qCykqGWbC28uxcLNNYlGmbYEYw01cTQWr02Q29VWTP7igmw9oyrxBmTlum
+0RrsF6EqPNqxrmPGU2HJcrku3lQm4NOUhcaOEAnvSksJnic2JBI9f3d+7vnh53u4Sn52/tb87PwM
+qt6fH+Sf/Ph8O9fK35l/Mv/0+cT8DFfaz87fe753/h4q2ufvzX/DP7k9f2/+AdfUz/lK+/k/P98e
+KvLvzt/A1fiKj57vmb83/wjV+s93zM/hT1Txzz/gb92avzN/t/j9/DE82/xZ3Be/e77dV/Bzhf2j
++Vm+3h2+/o+4Oj/bo/lz81fmj8w/mr/1/PD8Zf8W/N0f+bf35h893zn/PT/zXf4Z7vqI35ff+vnO
++T/zT3bM33s+yW+Na0NjAJ+8O393/vHzw/N/9ncMz4Za/z8XTzj/6Pn2cAV8ZuL5zvnbHcWAmecT
+/Pl789/y/8dY7+a73+LPeB0DKAs8YeWAJ/MP+Tm9fsGT59uf75y/+9OF4z9d3PPThYc/XZziv0/8
+dGGGf3L8pwtP+Cf7f7pw7KcLt366cI3/vMK/PcwfOP7Thac/XbjB39qDL+Int366cIG/9ZD/3M1f
+fMifuYm/4M8n+Aw+fPGnC0+2Cuxp/uRp/B++NfnThes/XbjEfz/x04Up/tUT/vthvsg03/HKTxfu
+hWviqS4Wf078dOFrfpLTxXUm+HZ8Hfz9Hn/yCv/kSnHNWz9d2MtvMV2MwDR/cv9PF37kn8zw1ab5
+W5f4J1/yt3bzD6/zB27wT67zZb/BF/GrK/yZEzy2E3y1iYWHi7sW9y5cxJ+LexZuLVxe+H5heuH2
+wsOFmws3Fy4XP1/cs3CPdR1mF/ct3GYlh93QZ1i4zBoMuxceLtxeuL9wCyoPi3sWd0OxYXFqcXJx
+B67Bd4Fyw26+4k5WbLi2uHdxB9QdFqcWbixcXbiM+y5uX9yx8GDh4cKVhZu4z+LuhYt4qsWd0JhY
+uLxwY+Emnpa1JfaxksTDhfuLOxf3LW5fuLJwY+EHviuebc/iFLQpFi4vXA9vsmfh8sIdaFEsPFzc
+vXCTn+kO3+v2wj1+U6hK3IKWxMJlfBeaEgs/hCe+xm9yeeHG4j7WqLi3cHvh7sLthRsLN1ipYgpP
+tHCZnxbfwH1u472K7y5uXzi/cGVxamF64fLCvYXz4ee7cTd+FrzJ1OIUlDB4XHcvTGO0+W/X+JqT
+C3cXLi+c47fbx5/Bp+9hbnh8bi/ugDoGRgljzPNyk5/jPH/+xsLVxZ3QzQDbYHH34r6F6wtXeLz2
+LFzDnCxcX9yzcHXhzuLuhauL+xauLU4u7uS3mF34ceHq4u7F7Qt3MOP8PLtYpQMj7J9ux+Iunk88
+yUO+5nleI1DtOIc54eeYDHO3E4oei7t4zV1buM1PdaUz6nd4ZHbyvR7yqri5cAPPxXfFODzkq+3h
+6+/Bmy88WLi98ADPwNwwrg0CRzKhishTV5N1S2nFM7DZrSPlwMnUxjGZkJI6WRZZyJmpVhG2JrUa
+8hx7ppyYhoj5L55Nn1incXb7SgBrcqW4+lnj61AjAJOXucmorkEd5xilLLponIxTyhVLLyhCVfsI
+vBuhGklDibovy7dM6BsVcSMzKOGob/ZvZHDQxy6RXBthiKuTDb8vjVHZSkc1iWcLdQA20JVxF9Bd
+yVipFRgyUivQWRWlnnffIxKuecTjOkrGiNnO7FvGLjM6yWNHnhpa0QaUT5SzxUL54onE5vy67DTr
+VIDBO0pxbljHggRop3AehWkwS5mlOwXqPVm4zaAwQZhGXaDMnTwt0fIQwCFQ8BUyo6tG1K2OJbmG
+r8fgsXbwykHSSpyR1SoZX3VhU823pZRrd+GsKbjuCuQtOPEUuzoJTJwvCbFQ6OTShNTlhnwtydqt
+OjdKwEPk6cxNRcQUCk1oPJPMy+LairJIhYrpXbyprW3y68zVDChXnzBdOtKZ9LIPyonYiVFm3yFA
+EKbB5UZV2pwJcMOq8GdNI6E4xYAQYUnVpcX8QfcjFQbiCdJBZ9PmhqpcUJdifUiylviT1hOdMEqs
+UcH1rlJJJ0XqqxysL8qwnp9tUegPhqS0We6IhEklV4dgaSSyCs88kzGGZ6PXXKkLYyRGXoD0m/qZ
+9mUyPiIS6aiMsSJ99USvp8Vb5nWSrRDzxlJp+f1y66Iy+coO+09+teY2x4xRJq1OCOteqioI/rzH
+RqXOLSKbVDTwlFx0wGt+qDdPh1/uAuk1w8iwLgnz+aQyxEOHnSKqJPyrJGRHnM6YgU9JxmUGKvf3
+f8e/eyy4rPt3fquBSZsbrE9FsTOU5DElG1VitEwSQnxjDVUxVZHnuXlSrzXEq2Fj7nRduCo5fncu
+bbF4FKmqn/gpjgXvX18gY/EvzBW8+phSGRMmmMfaYtUJlWzo5zu9741AtCFSNBZt8vPgS5uSzVgh
+Iv3Y2x7YEOxqXccmGRMG+2GTTlkXUuu0LIynUtsycS0Jc8EpeYdyZ1HSo7DmcSUyXmcHYjJujEJx
+f9qw/v0SafHSm/32HSORYXmO12RZOhT8/ry7e5M3HqyBQ5Z5ppTonFmgoe4lFMes9kYryxEDEtvp
+DSVPuY9AOGdhDl4hH3AsiTnJMkqglSHThi9ssd390BTs4kIEU9/kjZVIRvFGtia4HCMjDEhiBC5W
+9oaTOfyU/AqrXzUMpYLJ6gjyhqWK0zyhsRr5JYVKkGSzn7FYOJHq6kZ/BviaIi/wZF2uJL7P1oYa
+VqKWyWxCdWBV1zTiqMav/A7PBJ9Va70J4iXlqEKEl8ZmHMXatSAmcu2VSNkApY2eri4UC5RzFynt
+ElJWusbbRmI/bILVtQRprEhXnJHl3NEHhrWoSxFXYPsyDmsoJsmizKhW+NCb9FrOBY9SRfiUqFSE
+NHZTTZiUrBGJjEVaNp6eLRUfBfzp6vpUqOqGkq+eiEKREpj+ipLMUF3mqAIZEVXaWCcjY/FuwrXx
+f/Dv/pE/tRVREjnNzM4NJRymKY2DNY1V4LjyoEyppFFixS8y3b78zpfTWWgyRA6fTiJdeU8aquhx
+HG7CkJV1mQrjjVVSF+Oyntdr3sgZkckkbcQpTwBulug61clUKfF8b8vaVpRkUivytdU51F6VsK5R
+02OR09Fm74cYVCjmBFui641PvVtRNr5+DTYZBs5UKW3Egpd3jx+Cjd4reTfBiRxZqmIXf6rLZFzk
+yznsJyKW3jQb1IZl0RjLF4Huun6MIsh+b+RTMlRp2M98tR4klyQlImYDUceSGiUW3aBkfcRUXued
+oTJOXevKpKgiHXjRUlU3ewMPZdJ6Xs/I1ERm65rtiyV+B2mj3FKCdzeWGClRLjIam5mPOlWFP0Cj
+lBld1w7bJGHl0jphRMedwXgHJS6mCa8nhtUSkaaRq7EeV11k5I/kMdHAkEcbc9Sx+iozu8k7X76q
+zLIInEhZNM1yaYhUVVbAeLkrg6a1cs5waWSsUe3n2HczlHG5m/XVlNaXcdq6VtJpE73yCjbemGQn
+kcazlGtYReYo+ZU/5r2DRaIscSyR4pI4X6xm1/RwkQ9XHMehDikSiqEdj4UlntQf+Rocw6JrlMRa
+WZ3Su0zyVzTOp30ostzkXT/JmFmqKMf5YHOexs98SQRKOrDOQIguDcOIp3AL+WI4CKSqdo9ReSSU
+kVHy69w6GeM8ylghLnaybrfUdF3YqK63wbvBNEqWZXGSGL6BNc0dzN16XlFra7lKDCW/SRv1TMaf
+eSPpC1kti1NREteMVjIOVZS+nNFmRjuKGcN1bDx4Rnx9LIuMpQ3+D7G2P/aQF6nBwYfL+EPRr/ye
+MW2g6wTBHonSWjZdXJ0DN8U6EknueKpQHYpqRO8yOv59lfdaimIEbSl52/vzqSzjvl9ELMzziXcn
+aZxLk2oygUzNe6k2MhG+PNFyJR0l72Lv4MSp4LS3ZODwVAxRouubid+l24/Se96VRh2ZrkDEBPJB
+LF2E41qh5hJyWav71vRCSnorq2vDXPgwYrOjrEYKBQSkIl3moILlmV7u+ogrzyKMmEyoFLHojS9Z
+tj1dXe9pE3V1fSRUI/K1WZa5/JRk3upVJLtkEMmIdAWAKcsbourC6oxHCYJJsUzHpFNk7Va/ln5F
+2lQpepvSqszrXppo2I1J9sw0CjMaUHeH/JwwFWEo8mUURijY46xmhIVrq3B3X5PFzmUqDFSLX+6K
+NvngTlaVNpSk2tpIV3D3SFi+uxTWcWnhELbF8JB17Eiw5/Byl6/Xs/5ZyA/Iejizka7E3jPu9+UH
+LC/WFVk/Y2WvmeULTqMx4rK8vj6v16YVFqGuVGDBWPmDDBZf2ojKUqe62khlBQOpTYQJNBKRReWX
+Rnye60G0a9CKalBeU9XVfvG+o/NqKiyK97A+pYlTghQQ7rDRm5J/kuSUqI9SDWcFmiJEwsamYZ1I
++WiNNvzOGw9WCaNERHyc+mKVZEhwYW9FG5JVFW1M08hIPuNq9E4RrjppKEG5yygZX7Y8WBYwLNYS
+n6CxKEsExcLCR8EZHgnElIg+q+RwsV8LlcPl4EoTLnjDMeHLNHwZSPQe17pE8GawfRsZlhtOCxEj
+VqxL1kVUkaz4oMn5grAEC0hp1+utQNlX23Rz3LI+1bGP+4WTcQ/XT5WGY61GybhRqVPiaA9rnmt+
+SsMY5Fy697jZQIK6J+wLnAPCsj5eaVjYCOPsy2SToV6bCTWMthhRmbuMRLoiIrZadQSoptHLlVM9
+Fe8CJDnhODNCoj429j47Sx5wLIPoSzo+7VXOJunXrKEesam0Dq6ftDW0K4DXZbArneF2J9YblGis
+pvG4WKS5IVg+AeREYrR46GQc6t19QbfdzJoFEWT8MZu6jumEp1STMc4/MtYXLQ6VG5jM6GPvon7i
+wQ5f8mp1jmOVDFmc5ZvHKJG2xppuaeMTnExCWR0hg4XIJkeUjKDUepUE1AzjdLJclWzRQiVyukzs
+z9cF1zUhYreU+CLC1d2+EIvDiNIwoh4ns80+dPZKB3ZLbkakrbFwKpkeltBczX5BV1fZr0GWrnPk
+y1uD8sOGT2VcEybhavK0kaWC7ZpyRhYlRF1c6rT+F3nKG4iTZpYbKbAFa1gZszw8uw4U5wEPsSym
+Gjn9jge5/LB2rY9YCRA3dWSczo20dQ6oZMzwFCVwQATKh2v9wy93eZWDnn8cr6fRKPe14VhN1LHp
+LGGShDT4DKkEMvJreoRzZrWv2LM1zScedKAqlQqH1dvon6TCO/oyURtBKq3U+4nHkBKyGeAfyT6f
+847gJyylEdUoTSKpfq0tZTW+szMkfKw2xNXowyJiNzjRZNUr3FLErI3e9QhPRYxKeGXYMNq8nyMc
+amyUxmsc8KtYHSFy+QizQqmv9bOfBGBpbSRUEkW+bjDAP8546IoqrjTc5SUw7K+4k0600cc5+G5d
+8DnnlTGc0Q2ZkOAzgE0zPm9hvaSNpIV2XLQhioVxWiv4itaZTV6t5KPcprJuf+fDK6miOvumjN7h
+tBap/YDrRNeydmba6EWc+5nQueOeAqxFsHpjzjKL2KJOxh7dWvuB4h1nJYfj71MkUqt9KbjdyMnY
+RtdLL0Fx7B2hJKVR2cdqXAxXGkZptyXv92yjjWUjaqK+2ktH/NFvoCznS6/hY2y1r3rsemfjlo2/
+j/4efmKNFMdFCCpcLlIuXAcKJ6RKG9FnrPf3CpuZNPWlnFZWVsNt+MDx4/4STWgGo9Iw69CWtVGR
+VL/xGCd5Rxctcdj2qJiMqrL3E2nFcr/sVlQaMSxfbH1E25DORnpMCWuxj1Dl5yjhjaqqmDA9xi1A
+NpR6S5GhdEMpQcm2zvwdXCIFVoCvkLVxmuMpoE4pkw0ijRG8rxlkS+GLBcXwENv5UT7+GTscFelG
+j74KhJK5ER5D4pQ2JTJNFWDl8GJ8zFgG/yoNNkSsvlKR2NpOqti9431FhKyRVF08p5EXXOF6TKmq
+H7LkQfQ2TlhdwWnI8KJC4xgcg65GIxptoRwXJ7/iCyNLkZey2TDmrSlO+U9ldbCL388IyW+4MbV6
+bRSbHGcBS1yXhoFjCkMsuGnjig+yERjkRaBpfVVwZNcyRMG+4oao62UWvXSaRQY+9RH7Jx4Xfs/7
+Gp8AKCATpCv+SRosrSDIsMnDWp94b9TXwg9mcBWgdSEAeX7I0WrkdUzsRuVqWjX8+R596qGdd31U
+7sMPG/tVXjViVDrvigFA4ain2wvRfOz9Qa4FfbkrCg/jBOSEvCSHRZclOCcsFmTZ8pUbOG9Zt9uM
+RFJVBJDohlAMIeJ3NZEIb8/QMCrSla6uzbpO0SusgvyKV92wI9QY0ybhsz9NUaIe6Qor1q4ZBDpJ
+Y5FX64lYK5sSbaK6NsRSCoPhaQcZe91Q8nXe1hfARjUySKVs9qjKe8RF26N8CBPrIdmaJYZTHClc
+QMSsmYDjPHK6wuXp9p18BFbRywSt5YBYujFv8mrC1LVqiIhLjEvrSzXnMtxZ2sirTnSx7FDa8Fo0
+JvaBAzY6rs5+ndrs0wmJcLhRqhsidY2Kjy1g5MeEJR9X2RxAE23mKDKq+XX9KUucKNYPsG5jCjhF
+8GHDqAfCK/Z9S8M97IYmiWY/q+IHBPqrka4ADxGu9o4/8TZ56Nhr3tg1/rDJyAQ3rWK85ow3XcDk
+PvCBZkVWHJESNkIzth6W7uzhtgQqqXg5eV22cW7IUCXleu2qUDLeEH0kXK2Hg2xV9cd8lHkBEBGx
+rrVWZIw2DRIGRoDxr6jMYYtBZ7MI0TOO+aiG+dMVQ+zrG1YlCSJHUFBwfDo51gNOdZ5UDKAWXYk+
+YrCiH8ku4D0qQuj/toeHU7I4TPHSBNF+3rcwT7Y0zIX6lKDNSnf3sNd0iFhohJIPPe79PgtCRdzx
+qYHGQamrNRhtEqkXzGDWEFwMUSZAGPwE1YowdTL2ba7KXpNIG+fWGq+99CtDVeTOtHJRrLPcWFaY
+y0gYC3HgKM+Mh/Qgs4kpxtlIiRUc5/gVyQIAucHJleg454GsESkvpg9lmkruEajIi14MvyeUE7aB
+057dqa25oeS3Pu2Bx2VIQdvckM8pRgwGxJSzcxF95ONpX8/fRQgmlCsTY2sj1Nik4cOOYxHhtK/L
++Bd9/P+w8AxwqYTSRuSFxiI+bXVFK4p4BFNtog2sL0zGq3bYT7xT6sgY+JGO71vJGZwe5Z5LVa/F
+FMWGY3xwo2rkVbwp6LxY7DECSscnCqZKxNgfVDbCS591o0sM8AKhsFl8ZX1Uowje2JqeUZGuXsOg
+0ZpBFg72Gw+ntrc2yKbiODISunxe8shCfnXNYJdHZo1UEYzdJva2rVefit7OOdAMGkZbfFSwSWS8
+vjNKU0qqOknI2i2iivW5kTfVehxbYwKHFExgJhzCanSgjKTy0ge//FBiSNUYRdgSm3w+7td5IqSt
++3gF0ArOKs5MRFFNWDxLrjiAY9XwV9by4pNKaXw67eITEnK00CdBtIGoMLgJ2jAU4eWtvGqYfZuM
+Eob1f4yzmFKYZr8G0TwzkqomTFnn5j0PM21F9to0evks7+nx4TFcE5VYmGX4jh4gQn/NSCv+j/Oc
+xFLE6RsTZYJl0mD2lOYOZbzqjFaNekIpBwQ1bRyZPi8LAfdAqKDjUer9+2jo57/fxEFatEEzOBIh
+drUu4tZuSeS9X4QttnO82MgHFx8J52o0xsnB2Hr1M7StjCrapGIMXyBhGR8OGClbtyjyUnFfMGq3
+2gsJ1qSq6LIeH/NHnX+xJJZObiP1QZRo9YozHhzvifiAHhvrKa0Z7CpzQFxlxXe9HuMWSdUTMXDm
+4SL7pz/98YvBMckugK7YhvLDgzypZU1SAsKJxZ6JKm0xImdDGlkvYOcDlaFY1zfH2mz0KLgPc3t8
+NOg2+WiQF2Qp+p1Ma5TWbV7OTdlWSeVSUVmytm4PtwRY7cUQI687aFknRleUriOTvslnsi3F8FFw
+98hp7gaQNro9xNPtRRaHelM53NXVEzG8KBWUvGqJtAD63w2YOOt8RaNS4KkhB3tbp2OUVoQhk6fs
+PRsG42K/G+EUWK1MnsIQ1rDp9YhXT1y7wS+i1UE4jXFW6zSLvEOrORPVj1i5JPrXhHxTI6hBQbwf
+F2MsPXmbW7xaVu8f6oVTB9+GTZYla2GFa7KOVYMwotyIvOpkT12yOXQ6cmjriNdy2vb4Po8Jgw8Z
+KXglfn2tl7ygGx/6TK+IeCu8T6bMe9TIEbKR10PsCXKPLP4XUt+ZTfVY2ogYSTORt58RMM6ul16S
+LpLKcb9MSiLjfSLOjUeRcNzEIhPSsKUdoUi6f+09/43KaSW112uMgjxgOCe8JKF1nFxPSmvQSG01
+gwEppSRgUbyqWnjotZ941kB39zB6DEbAxDeUIn+eScVgwJBPY3hlm38aHvo/em1sAAEhI+2ddZsK
+NDQZ84cw91gR9ucboldylQAO5ba1xqWNyGvBrN9oRFkKBStMCRvF3DSgLoa35Sxgt1cMXCstezlG
+Wmw8HJmGkk0pRk7FmhFdUeXz1oO3rIoPMxNykf7EI5FAi3uTjzC9eJUd9mErjwRQP0v1cuol9SJm
+VlDio4KezK4vRf8Y+c4xrtyIrK4jzqmTibzeomWRfUo2CYUkSA1DXWO3gr0EYe2GEg4in5NyUqRv
+i3Ij1crnx4KZYbgBOVjHKbiNlt1Xr0RnsfukwdbVjqxIrY6kel9jWtRAQC7jGN6FFwmNqlpgy7zn
+3bQ13EVvdQZr5mzkMVmpol/nacM6KA/Bw0WCE7BrXcD+ZJU85UiYEmkjTLF55R9ekZnzHnHkNdRw
+2OBxvM6eTbCrdIVz3iIIgUZDfknB2eIkJD65GpJtXS+9hOUSSWxanVDSE3FejRuYsEQnReVGr9+/
+5Sp7PqkYw4R/4HtecLMRSsrePRjIxqNXs/Gt3M2kh7siRcIrqPbUiT0Wv567NzAmN4j1iaOJBWN7
+/DUjPiKjDV67dS1iHXSm88KAiSlAMqNEakWdImG9ZmnCAoLGa/N3RTiEtSGvXspkc2JoH+wPaSPA
+HhhyHFc+fzjm3YqxGqOwyHVllJTNMPp9eiwn8hniDXxI6UqcI7YnaRk/EzxF0cakLg3DveOUDHr1
+2+gjz1H6BOt6bQRLYqSGL2XJodUGTKUaFQklG0rc9sRm7LjwO1gn+E3YyLL3y8aYux5EkUQwb8nJ
+Os5NDsRyZ4WTttJwOqqwiOCYgu3RHKR5oTTLi08BMFMur8NtcjV/FacTyaD2e0jO2ppH5lZ78Uzg
+kVmkK57nEWEx4FBANIGUq8HyjgPNIBNxR0yuG4FaJOxmJ1RKDa89aXv9MbrJaOGkiLzeI1ow4MUc
+h4NsICjhFk6RWPNHVzN6rEbsYKU+1CMHSkj6Hv+/kt/TJW7xICIUeeiGhScVSQSMlkj5ZLCpewuW
+l7kFgyHsEY6Ec0veTRtm8iMlFeIOux/7lIFUXg5Y82D8TtdJvWI/9mlVFpkUitMJiAP4dCw32Gnz
+cr0NeFbcHpdPPK/L+Ue4/WSs11CNtniCXA+PJEWsFLaa+zaVhnNo6JeGo80AlAFajPIRYKpkIp0R
+ZhquL6kklWQjqXxWLop8OgKQibTR6tI7v/lok/aEQ97nFW18mBP9a0/b8JqCFoGKtuQ0jt8x70IP
+ejfNJcFQsw1RdTnOmIehWFqME2ccePW8wqeoiLy+b/I2KFzWjWrnpcJYK8zHxQNVxmKyTZ5C0hN0
+qL3sK5MVakjAIu9YETGng2K+Cp9tyFREnsIF7oBFqFDD+mN+Fl5g9ZpBz4XbUPY4HwIpQ2LDhgiw
+ypBfrl0sN4pcFqdqeTMG8dEe9tG1wsFOSdTjMUTksBDZ5A4D6QViI69VGImI+4xpbyO96F8P7CAv
+MWZobfJovfVKwHhoWa1hkSoL9wDBjDf3kfRLGOtEUmJ9/k9ELMqItVuTliUMQT1hN82nrT4re/eg
+NMytHj7wzrqXAF8rIs7h4OvC0Mes/xdxBxAy6Pquc8eXFoUu7phm3Hy1FzH2XgWbmkhE3X4DfsK6
+lzy07FYYTJWPkj7z+tdrmWfBtFOpk7XA3H3+1uNqmL+Ek+uB45kSdwBMGxs9EQW2fEw0RryvwUfw
+y13YTZREAtQrrVReJyPSTZ7gyGC/tPwsUmnDjgRQm0hi26+NpIoN9yvzEnndntdsfxMjVYZmDQjS
+/A6IUriMUr3jUQcCqAePxdaQVzNagAWldephJi2SDdIxZ8UQc/g+CtRSPlCCuKH1i67LB2k9EqEj
+RRt86OXPzc9EVNU6iQyNpKIuPBVqLTzqmrSf+QXKzcLQfwQjsNaz7WxseGDKvPHq3ENOpF4HPvI5
+vgj7jI8JC9/YayhHfp1Z9iZMw4uk28gniT7x/CWyDNt5DnlS4a6HSKxjd3AaXiqw9KxkhV8gZh4S
+t0QIErBlEPn4xDTmVKYpd9iLhBfvjLzidw8ujRPsQ4EnwylYzt37sgoOE0fX5QbSaz7qSTTZRLJw
+dJlpTA0OfVLhzzHLWpfOYo9VsTyAvipDldwWutLRht9uxnc2+zSZl8W0nKOV1uePemAtIl3xmfa1
+KVk41jZnvUjeTmnqnadeb1c++8zrsr4vzKgwSS/3XPI2jn1KoKtGc+TGnaLShh1p2NiI3yoJB40T
+mi93bXSpUE5wCjv3fbUaa7OcLRNz30rDQQWTuy1GQitGw0XEA8sK9mRqfud4IxBRain6Y5fnH1nl
+WQpreTPi9AI6ikjtd1TGqkT6XvCaeJ+ihC0RkxaCruQf/aIFjw6YR4W5qRgQ6azVKZ6z7u9uSNEY
+uDjOaOm4yZZ1/izFA9QBXGPstfmtBdNlCKcTHGsjVCxtt2eJ1TyyJ4zDkyH65B6MhASCjTUcXuE4
+lsGpjXiJsxhWenJZxR8Jb+eViki1l+ccYvC9RhWD+debfMog1sHPYrwu9pk7DEgiGorMqM6tV8eP
+vkATLWTYIlyIO+qIFKkzlTa8Hex1OcFhTzUnwjfrVNeZ/4s0YHc0JKKa8e0WSu/8Jvr4N1sifmla
+y3MiojFhIq+ZD5je+ROWQ8mXu/xh31Pzu4OfEFkwifRMCZuzNKzLqaxSwlvU6RKOh4rkg93p6F2Q
+taLMq7lLxZE8bHNNWuFqKTn6Nbp5kfk3oqb1zyO4U5REoCAw/Rd2OrKepSciboT5j56M6uslwnEd
+lSX3ruQDdkMpEQ1+I8dx9adcbrHWK0BHXkA78nyUMe4DuzZiIFlEmcestDfw3X4CBz31yIupR14V
+2fMraCzzZzE3aIpY9jxyWkTMp1iNf9QoAAuDPvdNalujTt4/w1Cy/f+jTwvhKUXWEBHvKyA7jKoD
+s+/iRms4AxlL/z/yvr5XNwnGDCPBTIeXXvJsVd6ZNhJDmzd9+sEnWz71BBavtt7LpHM9/nbjg2R1
+aZwLQO3aMZ/mlIrPsUhWotVj0svyR95BtdFvFTIVMLE1wXLklLDnHJUbroZQS1V8osMHtkmvF+L3
+7bnUqE7ho0gVIcsn/IYt+/T9Bz4Nz4hxVSNhXyfrt1HkeWRDHrMQg5CFHur1MuiW+VXCec/BlwAY
+irwGOO/v3pqIOGbPPLv2fZxHG4CQYZwrZACn/JNgyt+G3/9hcHUl99rktneY4XAROxsJ+4EfF94P
+Qz/3CSAbeUrI2yS3MrPKifTZd4mn7XNFNuWU2xFhRFWQTKtkR0nZEeGBN4FQS2wlVD8YqZ1vAlNH
+aXCss2fnqtyNkuqZIZY8rwsdu7wMX5QpwrgElj9/QPheDlpxuVKoiBfIfT67aqtGxFJY/zvha6i0
+L8e3MVcQJ553IdSz2ToZLeKcTIIqYStRKE31stG+ityXxGvfSYosbeWYiH3img715lxVlGhRZYF6
+UlisNtNcpcXdGclaGvV0VTynfjaLVsyazw5tfWmT9kVCvoUTV50YkQiBtk5SU70sTFXXucZd+4px
+Sx4M4rZaZCtUNmQQfdKz29oxTQctoxJZ1Vg7IuF5iKVmaEAK4cG4jAyqp6q5MIkw/LbCBm0B/EsS
+h/loSCStdMK6ZzdcnupYKx2TQdW5SjSDAVt1hc8VwdVW2vrfCWbIaesr3G392blUVIUlK5/dUUyb
+clwvKxLhi/J9ywOrq8LEUC+IY3yS4lwkkFfgUm8uirA406EHgFjHyKBMYOth3lGlf0PUsW6U8EPO
+ogx1bXVFAs90EC4gtjjPZhW3CNPcEKyuLQ+rYEUA5vuDa8Hdsuu+bTNiMWTrkUzHv+HkC5NApVvI
+qgplSDYWjjOANO5BF+5RbEiFEruk6GRD477shhF56QggKqwkpspxZj/JrfNOpIzJhldOQyGd3dbN
+bIf1oezGWl1B7Q354gVHsUgJD4iLsn3zJSM2VOsk8F/xRSbu8vtKrtsC/gTgMdOW17MBboCKMU7Z
+iRTBPbxPTzoiE2q5bCItF9AhltexTgW4+yqmULtjRThOQy2d5WICOBqqweVKomyZXck1emQZlYYH
+XBdVsU2qUNcgY2Yj4znxodww5x+Y4VDIJSMABzcD6QcQ0ZyusikMRTlJmbsHaQOYB5FGmDdG2gDk
+2VCd9GGYZ4/uxGTI17c5411v2FtwJOo6YeJMUVjgD+y0wYl1VNBwd1eDbSFwGFQw3KOoZiGGb5kV
+yp1psF7IJUZneLbMcJ6JfDI2RptIPi64Wk5wBykufwrVnVZR1SvRByqaUCJtWFlUiiU+hVIaztAa
+RVjfPYbrTzG7IuXoTY6SMFXuHIh4H24Rd2k13OKyjvkH1dRKreD1Yx0wF184KqobAQKkMnbIImI9
+8nuRdSE/12D6lpDK9zagZIOvAwG2uRq4hs+hIKrwvRGwnnMs/YRZDg0hTWyAlTJURUkAXJJE1zGy
+caiesoFontS0ZdYECDt4/ljDLFsSWYZzoBaKOn1vmOEhkYicYTJfMFCKgpVOvREl2xuKEAMG32Ca
+eiVPmWUNcgDr8lOCdWNk7DxTTKRxVXaXpeoNBWfFPvTLDfuPvDMSntsK5tPJmMbJMEDEfDc0HU3w
+ktgOvslbHPPQfBT2CQgDvMG4x4mqBoBvOLDxk/VRUXjquB07GPzYQJsL++G7Ahgg65hn6wwni3zt
+MyWWYUQmorIdRV2tMCR8oW7c4HbxWrFFxie5MWmFgl3l9clYRY2MhLvEyLHf/zrFRPvqsATrdlQw
+RuS0KkWeIR0T0rncGN+XClnfkafoapI2RJpK7Fefa6SEu3BnqJVLU3JShfWRcGd0ZnR5O/0vPb2p
+J7CQ4uEhZ4aHXBJzFy5rfakXoaEzQ238tCjxDqxbbl44SuZ9XSd4ZqGW2oYCbQswEyilp0xgHusa
+9ryoGwcgIFVOPjlWGoY3hlDIl75K8TtfNxNFoVTxw2A3YtYYih0f1ty1w9s1RD06LyKH4a5Q42vZ
+P482RFwrhdZ+ypc7+c4EUngMPg3seEpC0brl885XBLK9+LSw/75OJgll2xZ5HdiDsjSuhvyKw7+q
+nmslRRqo3DY2ktO/4ZxpcEKnTuYT76ilTBhBwTn7b3530Sglvj9K73Co87ZcXtIVRYGyZkP3ukZR
++RYY5Inv1YX971moIIfCzosEqL6jTIywpffrphQh/PIMPIbrhzkElqPELdv5/2AxLVUx6UqbwHLr
+/dCXbkS+j1RpuBT5/kvRar/vqkYkuF9AZbv4/DYx4ErRsFL48wOTINlODoY6O7jcSZ76Yl3sa7BC
+wNIQDsWdcSg29r1D1ke/8KVKllUKGJOiVPr3yVNksoZC5UjgHNg49WXDHCdJVR1KJa9rLmuqyJj7
+dnPhHYCrmIY8sjfs1x/wRU8L9SwHWgMAF+deIEpHdd/60MWIMC2lgUe2nvef1CYwBUqp3MYrLMCI
+0ZoQNoZiq8T3zenq4s0mqsTdj+tC+W5BvcNBRcAG2jzDCbBJOCdR7RMQxQbOYpz3W/OEKx5DCXYP
+0tC4ThQ2sK9xpyQoQZQQNonhl7t49AwWsnZcnsHnJ3ogCT6neiIPiyaSySqRL32Iqdu7f92lyFeu
+BmIUFxHC/AXqXggSfPUj9pkhHy6yncmVC1XJOubCfK4x0qqaNtZHr3AO8BVPBnqF37boCgc7y1w0
+SkLtRuTP1UrjPRETznwGgXVuiyqewIK2oXxWMauBCf6jvD5+h4JmUS8Ku8xnQR3D24G4EfQDAFTw
+zq6kNA6/clPwk1MxZng9+ecd9n0nucMVz3f0VyWz2E9DvZwlGg5yFraGcxkJdl97HXEfL5wAvj7R
+9gZQoDc4wF7OAnbHuLwaCpM2lEq8DkdFOiaNj99836WEO4JJsj7rWYqCHU1gXa0v+2O7+MsapanM
+BlEt/45wVApROmIH9Kn2fLZSVIr8CvLMFpRFfp6T7/rIdg/TBeg26DxYDK/S0gZaY9dLQVMkCHFY
+C/c1EQ1ASrBvKFdlH5hzD9yxES9sfRUK74NMW5FuKHn6zruBJBP8ROu7Z5YiMqE6PAkYZ6OeOV23
+2nDVaGLI16eE82UoS8MB44m9NoiI9KTkyR+Rx5c2hOVhvfkoDfcEArK3e5Qg2ceVcHlaxRHVE9JQ
+4fmTUMIeheJZ+66vf7Rjfh3a3wQ7Xwokeb/duK+lEU6boHhiRfCDuEZSquo7wW/iAFVq1RP5YvcG
+MxrSSqApRXUZ12RVqKDREXHFUFki0kcc1+BsKfaH8fYQ2TCApt6KoUqR3z8K3GMbiMJRqCOj7rfe
+en1dd38JlROIJUPwbqJCq4M7N67vCxkEhxgJazkIgtjuUIOEn2EcA9cn4jDuxXlvBYPiMg75llKw
+bz3hvEhCqbKtptpaYRpdXRuZoIAeSLBjDB4NuYSpEB2+ZmkY6BLOpSAwY7npvrS1rVoJgGhMypau
+EUjsPXEqFddy1oSEHQ3VitYXS3a95It3sC682k5A4wed9syMoV7mUQ/rkRR4gAjFb8DjuKq7L5Sa
+BgUE+2lYt0H7IolWj9VkXIt6Iv9+8ENgx9ifelFsbYLEShTWUxQKLEpBsCIK+iqWrPcPB/2ER4Oh
+OKtgQ3i7JtK1Yf0VDPoeXzpRqosql9wMB3ivog3Fwjqw3iS4Y7XcAAZPOKCx9Eu43o4Ga8IyLY+b
+1wrnu0Ty83thlEAmLdg0ET+WSHQofSkBxyhTmg6FdHioJRwaCkmUzyAZk9k/BekQG8QjimUZhcrO
+iNtuK0r8666Phsoen0xDOVQUDG4UquZD/bId8lhqVOgsBCUWWxfG1gIpC36Vj+/TxpogRuHEOJdW
+oAwEvw/P3RU0JyysfP7smvW9mbfoKASqfn293CWc00ZRg+pZTVhpOarWxlaEihtgaGqTJq+A3ZQm
+qNQI07WhkMcJ8XY9Gx8E6bs7CnXZQfTHhvdJRoX3111N1zPuguzxAaR5uKjKl48XZrKP78Pr27Oj
+h0JB5aYC30lJmIoc7wobJRxv0VAw+EEmw3J+DLR5LwcTRcGxCu5NxCXmuSFO/0DaIKjBFLWInAnD
+OvK6P9Wi2t3TOiiJgoxSFA31JnJ02PtByOP6/nvYNOwf+eJSj0vgUf2ytMFu9fiunR9wb1KrTQNB
+QfF7Uq4U9JLWcJZE2iioelgep7IeD9IDWDdVJRSCXm2MHgvcoA2wdPARtwpkfC1xfhA+oI8vh6ui
+7m/oy5YjZEuw38J6ipBUZ/YrrBDjStn6/mw86gkfCNUqNnCNB1KxrQHLqoCzlcnw+HL9C5uBEns7
+peEuT9Qa6g3hZvj9+oHeAPQF7bAoxK2Rz2yXoiB4E9LRw0O9gUYWRGS6VvtWcGtW+2Zwa2pmVDg7
+ImXwG42uP/te/dud7tkjM/LsMkEBDspuDJE+u6qYJSaFDSB+6N0tbEDCrRIx+1Een5Q6zKMOaH+x
+L0RVlyUZpQOMbwPAbUcFwnkpYIdwfgKyyZ9dtYn3t/H9Biuk+mHQ2bNZhOVaaaud0cG/kCLYCeCm
+qVZeS1Uo4FEAo50QIb4w5LVoA16GB3FSVIuEgmV/69kdjjNkXZq6ZETeivqzq3B8Rxkt0YbFwxKW
+K0UAqX3BqjChCFNnKFgzglPl5tlVy0mLmCyFDR6SDZa/l4gkfnbHJLostgkjqnqbKBIjnoDLXUQ/
+z8kr/OD5RPp5LlMyIWXizbEUNuC+3FkTnwv/FiFpYMP4CdaOlaNa4B/KEWsaJ9rgH3iekIdAHC8x
+niFZYW3OzBPwOjRgdoFBVFIAl8T9QorD1lgxNtHctZ87YEv+fYWMEvg5I/Dwa4p4A+MvrC8wIBuy
+N7YsrAvziDEUPg4TJuAx2qM0+DciS7/+jHB667M7o6QYj3ZGOu2eXY3hYMRabc2V09xT/tkN4dNR
+wgiL1Imwhrwciq9OFaasU3LPbiiLEAHjw0vIhe9pGzI7wF011jOLcziRJMSlp7AJ+IvIzLM747Ku
+gfTwuHF5EZrCY5eRjbVX57Xk0x48HyrROvPjz3kEqU3wd7XTrKUrqiLl6xHiprI0ARcU7NfwvmKC
+rBB+mdrYPLsBIDJ5dicVhoI+hhQho6IyXE0nWlFMFtQCDD7iBfjLz25rjE8ZSAyUnZRI+Zc4D4Wf
+f8RdGEduZm6qwpdna3bOsLoY7yRDYT5CJueGGJWjWC+CZcC2CSsSgmPKeDdX//lEE9gpz27EMvVK
+2cTbt5oLK0bxtUS4Z1cRAHXUfe2zc8AFt3F+5tltgfyIVjIOCSiPtxGfBNjENuQXrS+ZFElsnl1N
+pNP+PQTWP1aq9iW/FHJd2obxEUF999b8vefboWSL/z7f8fxARwf49vP9+HdQE340f9x/rqPcCz1e
+qOmyzvDzyfC5h3xNaAv768z4+0D5lj8frvd8R/g9dHfx2cP8NDPzj/2/8VlW7H3CerwPn+94viuo
+ED/2msasCvxkfib89yHu9HwCzzz/hO/Pz8V6vlAYPsTPPhXuPwN14KCafDdoAHvV3ltB5/cWP/tt
+vifrDj+fxN+fH4aiL/7Fb70DisvPD/GT4/3m5p+E79/j791+fjg83735H/1nnu8Jz8/PgefDT57v
+CPfZ/3w3j9N+zAY/BfSD7z4/4H/P18F47vfX5/fc/3wqjNcjnp+Z+afzt4Ji805WQb41/6NXK56/
+xePs1Zr5OvMP8K/nh1iv+A7miVWP74TxexieegerSz+efxK+/yS8763wX6yeCR7RmeeH/Lrgnx3A
+uuF15JWiWbP5+dT8DOvA7vJ6sAvXoM66OMm6sF4x9ir//BZ+Ar3YxT1Qtl3c59VtF7736rrwAKDl
+ymqx9xa3B83XFyq0+Oa+xZ3Qf124Cw3WhatBS9drzl7mb09BZ3bh/OLOhR8Wbi9Ms+Ku/98NVo/F
+VXaxRu8UtFoX7i/MLjxYuOavGxSAd/JvcH9c9SYrAO9aeADNXv7+zoW7rC4LreBJaOwGJdrL0NXF
+cy3cXrgEjV486eK+hQesLAu9WFbT9c+Pp164FlR8by/cWJwM77sdCraLO1ibd5Kv92NQ5b0KhdzF
+yYWbQbF2H48o1HC9xvFVKMniu6y2i29ex3gs7lo4zxrFe4OC8GW+942FH6BFuzi1cH/hysLdcI87
+C9f52rt55Kf52zdZe/ey171d3LdwtdDhZXXe+14zmed0u79/0Oq9tnB+cfvCfb4uRm964XKYlyvh
++S7737M6MXSMWaeX9Xcx5pPeK1y46dV1/Vz45+c53b04GfSbr/HMTLL+8DT+5udl1apVq1atWrXq
+Z6t+tupnq3626m9W/c2qv1n1N6v+dtXfrvrbVX+7atXP/uZf/O1/8B/+R//Rf/gf/O2/+Jufrfq7
+l7r+H//xy//Jf/qf/icv/8f/j66X/u4/+8//i//yv/qv/5v/9r/9b/7r/+q//C/+8//sv/vv/4f/
+8X/6n/+X//V//V/+5//pf/wf/vv/7i9/+ctfVq1atWrVqlWr/vKXv/zlZ6tWrfqbVatW/c2qVat+
+tmrVqp+tWrXqX6xateovf1n1s1WrVv1s1aq//GXVz1atWvV3q/5u1d+t+rtVq1b9bNXfrPoXq/52
+1X+w6j9c9R8VuJZHWY3ESe0z5L59gseK63VUADXEqJApUBYWV4Lj/s6Wd3rHWYOJKWVSVVGmiXpP
+shoCnS+qs8l4zAuXzst8jJMownqA1yJmTz8EzEO9HrEfZmkOOPGlKFTrWOlyn5bhpCUoejrAWh62
+kGnDx3NeszcFJkRBhIISwRWCOrdFjjkq/mK9rrAka53JOdEvqoY4g18qMlGZRoAGZzXJfYxb5JCD
+spowDVQvMVLYoU/QOAgdUPgFps2ZNB+5vNzF7Gx8XzjnhbfelprzRo0vCuJlkX6zzGlDAL0xBJa2
+YFLYBIGs0bm1glVi3IvkaFAaION5VkI55i2A0i9VJWVENqhhDfV6rU9IF3rsMW34pKY29TKJ3Emk
+f/ETXMpTp7ZRUtwiYck6hbox5SRz6lwtr5eVkOnHIZkW9VR0nNvVawbhv/Pr1GXVz5dQcGriQqKv
+NNzlFVNY1NVyNQ2k+RB+jpJnsiH8N6LOKhCODNxg0/B4ouwg5dCdFNYCm/Y5amnrrFWDzxB3WSdD
+G1MaZ+heZB55SwoiimXediysC+polEDAN5Wc3PIw+zBXsOL3nlxMyvUWQCPKezj5ENi2G0rlYpY5
+czcmLWVcN0PKgcr57LKQ9n2dpo0xrZG/Ez7iDkQcO9TrBX66uJoBt3+HYo69oyywGJJNou6LaXSW
+aVzYvh14PgD+KjkuONwVIPYitCz0jihJNLGsWMSoEMreOcyr+K72gQtMwBwylKv6TEVXQXmwXGWD
+yQ35BZF68VuMZsEDCOUWpd5Ayqfk3WJbZcJY+kC51dYFmcdchXrSoV7/yF0+TamV/Vg7Yu4gceKV
+lAtKepS4MR01SBjri2Kc5wpi7VCQOi4Ncw25Eo6KPGfyNjOWuSra+BS944Rg5HzMAJAYG8CgtrB4
+G/sugqkyudpQIEsOF4nyhlQ2Z4ZuUY866AvChWn8isJKQCjE6d9Ym0wbzrvXufDKFsSoHqmYS8Dy
+Z54DtKmwz4G8lNuCZcNyLtI5ooLvZLkwAtOpPKGcTJmqXmiTV42X3eVS+TJVjE/XNIIkXNqQiusJ
+KCoSxIOeKRAoSj695uWy04ajuMa6KfgCr3LJGauoTF6HU8XEHFFAtKz1hFd1lFJW04qQ27Gxzqgg
+B1lmNTlRpTUF3gxJPr6yT8ixnlRdx0jFcdE/L79x582FzUNWMKDrlBR0G9s5A8K9RGqFTHhFSReh
+uqNc6JQP9VaMrvOViyx1UtCrbJE/77K59Rn3kMGI+mwmDU6utMiFd9VlbDQiNZ8lT9NGUNcAnoyq
+WVEnL2EmRykAXltZrY1qOk2CDDNsryc5JTD6bLGDqBar1/oJYwIEXtCLUyOl71AvFIpWchz9vw5g
+YcRMe3yroNclIq3ipK3VC4KTNQT8XGrlueGKODeSG0/WwjYosi8+je75MKEkjYl9PvO0uqCKeGqa
+dxi8eP4aRiXjWlSwRZLuIi/6HpUNVA2jIoEbFZlEUAS9sasYwbluHL4EJ8DFOgVSsaHkV2YsUhZa
+4PM0EOYi7w9FuhJE3Cl5H4Pxr/FHkTUuWDZRnQkK0taZvoPhReKZF1uBTL4UIHZKiuO4R5uQDWFD
+FAlDrqYtoYqi4CkGYMj6OhWJIN2nKjkX6VmQWACjsGmJV/uVoAQEQLYnCLSsDlJ2Io01MhdSswYA
+Sy52OE/4BMNPRRYuodHgcHQXJpIU5ihyWqRhbQSKGzgKw150qkiaR0FblpKPAxwcFafVUAXkBnhW
+HwQGYBRpVU5zs6HEWwawo6FYZl4LxLIWWfSRNtyNa60oa+9mBHXfUF9YFwlRXXv3wktKq7ihhDGe
+5ZeMwiKSzcYD8T8OhjFJpNlQSp0pBYYd2YKy2hMV5MjQ5UKbgi1mQ7qPks2UOX5DkSSckFpdrJEo
+QPTEpS2shRScUlWlVJTZvG8uzDu0i6TLuWmWY3W3QtUtCdQuY39NJrd4PegecKGKN1/Q1grp1FAT
+F5MF4Vko7khQls4Iw1SQKnw16Hdz9iMbHwxCjjWC+L2uBLpoborsGxUJvqRMVaG8bkMwTcjdSfBA
+uQsBnsdXIteEeifQehrB9nJjBUNg+gaN9bThKWnMefLcLOsLu1CdH1i8PYU5HaowGy9ymnd3rhIL
++XHLwlIskC/J8srEERQVrBlm5YyRLwdkhxwen/DEUj+EUa6CBqDN9AgLbZSiIF9XJLvIy7OwpnSd
+WHmq4C01yrCcSHWIKGif8+xgVcICKDc81PuxNmNUlUIFdhFPU5LHgVeL6MSsLpgU8Oc15itoAIvU
+Z2oxF5/7uMcWx01P6JaQNoroxogyV+rVCAwKP8s1UlzL6GspIVfvBTtRwVz2NB3hOyvI2OOnka5s
+fHEEMFOIkmIl2I3S4NyNyde5oS7Tq1KMEgrOMcAUxBqkY62XkNnCCeZFY62TacomLskNoTcFq0Ox
+dUdZlpeYECoq+Le2rlOK85Qsc/Br0rIvyp64cF6oNmHyeU3rhMvlWG+BgkFjafRMm6A2Cj4CFxBx
+1Zan8PoKMiiAxCLMMtsWXEcV7qXna0pVfbc4l1lADQuf2/tZv0TrHER84snlImWRdMw/bGYMDy0z
+0mpFBi6N50d4aSvQZX7++02sAVkaDiyMzYFcFgWVhQ0lSzivI13piT5gDSXk2vyg+YIHhxSspxL2
+eA6UJ0VJ9r6DKHba6C4CVO+zsfPvgjCOVtVuT7OpBCcbuUIMQpXcJq4m5agf8zXU6xOLw1wCVQ/F
+ztJLcnqP2nqlAXYdwQVmV43tvXKscoj0nqclSRr7nQzOW6wz/6Zej8HmFm/Lah//RIq25ZSK1V7A
+IUVFlC929uk7xHWejyljFB7kmG4wJXjZ/E6OyAyr1XsXpDqSag3vdYwJTJOKcdIXeehAIIN/6LWa
+heMEGp8yrI5kdD2sVWFCtTv7dSzNm/iaK/hjFPQCh4uU6Afsj+EVtnjZUy52ZSMVCpTqlou5cGC8
+B6tjpBem4NMcX2Q7BcieHwyj4iVysWoxp1sKt8eXN0jr2Ahj9SgxKqvC6YJBVRqGp8Rft6zmzpLA
+Kb8zeWVwCP0X5zqeOCHhan4puprvfYK1EVRVIO/uk+CR1/WEnIawvuhUREEDPIUdsyyXxNCMrvwm
+LNooSB9RwklAJ30fpzoOjiTPUt7nTF+x7JaEbiish8Ga9jhMsWv+WHAxfSk1q/RV/b1CSyeyISHp
+CPXwTKhhrbmEDKslQ3uSfP8Z/nqRJMdJ7kQ9U0QJ215Myq8My/JWBUdJlqjOv8IqpyQSqBrEjGnF
+EriYOHzrd4jRQgU4+6Kej7ylxv65KTpFgYGEwmRQ5QOF1nodtkhXNhW+X/FeSRCtJuxFYsn+oYLZ
+GJhtL3eFMC4mcNkoHAFMShgWXKjvSEVR0eIh8EzBA6mwj9RdUNiD5CIllsWcalgcePGo7F1THJpe
+cxiW0Veb6wrT9m0k7G+LSCFEQFqJKlegOWIdAGxRVCj6QxJGHRcPWulrCwJIaTjci6njHtYjL/oo
+Ut/Qgm0C2AZekxFRpdOcB4YPu6lmsGqFKioHotTXnUtFwkpsZp0V795V0BQ8hoYVVcB0WOqowlWM
+MzEKwRITo2S8bnokFVQYR4RQFntamEgUDQcoYXkzkNxFQZ0ZqkhKE0vhCevQ0XOGyTddXUG6OGjp
+Oe3LcgBehboJO1xUEBWecKB2+1ZRecZz4XBg4k0BwcDvFoG4l0r3wuxIA8lfP+Ds7gaVqk3CyHKZ
+MATBbhRVInZMwh+wUhVIRVFKu5619MrAN7w0urQuCNhH/UO9HhQcDp5W2vAatliTsPw4tgsCd1RU
+tHGVMT9qgbtEXqsqcjohkfKo2gIayi2ZjVVPkPawj1eQz2qZsEUtiaqKKlhGRRHG2gqNeYiJHQaR
+Wl3wlIYowbtE5Qb2Dvew84IF8BjZYRghFAMkVFfk/RYY/ALtWet1jiJd2cwtvvw5CNCcEi+UAQ+4
+OMQL8rQd6vUzMOyCghPKoP2GKAi4toiJki2FvwpRPTaVxaRs8GoWoBTj6K/rjmQqJVjPLGpaVBpE
+IgooUYFRNzqk3JhNptKugIjsGCWK69sxcayeFRWlfsV0D3CNPa4johddyDzOFqJjbbyMFNgxEDcU
+1kVFugFqJkzR6vJ+nUuGvb8RaRVYjcNdmaQ4eBdQPkVdeQGCOVJYPkTeGcDBVpzZBUlqaCgoNwz7
++mxXo4Knowqi9XBx2vSxwAWb91Cjt5aBb229A8cCm3DIWS7Xd++C1QoFlWsZj/YyjZ46HRUY7Pog
+e502NvHxKRTPjpffRXU7xgjLj49a/Irdb08R9ArsXhkDOjy2M90p2Q4NHj1LfBgSxKZr9FFuKYfz
+n+pcWimUWF3gYnWJodNOZEUZpojCjL2D5ACu6OWj+fQMLSl8wxY5SlBV/hOOIy5G9w5Vbjn+L0Xs
+bmwosZeH0Qh8VtmpKLJB/CHWBeYZFcVBAn+JfKkRlIDyNBFcDY8BjwLlMmiaSsebFdy4qCDfF/si
+8mo0jpSnxwdeI7u7jIXD7vICwBO+XADsG4JQZk9C3q+mgnUeeTABVkIlEffCGeoNFSdhUP0KdzVD
+xDV4eAZdCRYJ423e3/LRh0MixK6N9UG7e1MN4GCwkHyYsBIZMnhFOBB1F+zVcNBvKPUW9XsoueHS
+GlGcTcyNE8pxMTQnKVKUbdG/O5enklw+Kv/dnX97gP7dOSeV+LcHw19+erjrp5kTP8183zpxsXX6
++vK1bz1hr3njhGfsNW+c+P8eP/3/uXfj//rq7tLM7NLcxNLDqeXpc+3pE+hVf/3Y8vQtfPHKoeUf
+vmoenGidPtjcd6558srS7J+Xtx9rPz7amnraPH1rafby0tPbSw+PrOy80twz29471b7wpHXoz8vn
+DrQunV7ZeWX5yOPWd4+Wn55amplo3bjYOnZzae7r5vSZlWsHlm9ub3+3vXn5WPPo10uPvmkdv9U6
+ON2c/bJ1f2r57sPlicnmvm+bk9eb0xeWp68sT882D8y2Htxunr7TnNnR+vON1rEnzZNXmgdOLM1e
+b87sQKP9G5fax84u39/XOnNx+f6+9vS59pHduPjTc63tN9uPjxZ0QiuMe3YjzlMN1w0LWZSFiSnV
+ipu4M5kocMCSDknQZuhBj2gEvhbytmKsCGqs5Np2R+Vns8jpcvW5Zwtxp3b8JUQ3AGM9ezDQT2Wi
+C+fNjBZBVodJCOIVSH0sk8JkKKVH/U/CufyCztjhRVoZAC7tK6OZehfnTL/ztc7PbghZf3YO/kXB
+khWmoFcZzrkII7VA1gA3dZQ+u1rRCuG2tIGT5UlkvpjW34J5clip51JppQ3159oWBCobEDMpyHGq
+QthQs61tIGUVXWtFomNhnp2Lna8rkfRiDGVgkiUiwSjzqGLj8KMWbxFY3kxXYxqfSEPdCysvmBhL
+watWIkoXqagLFYuqVtuenUtpWwEg64xSXi2ioPHZ4JZhCRiDb4CJd44zGaDkJsLXCPtFkonwrYIt
+aAuaZDHy2hZNtXdwS+ynoeU2mo7v4MbYvin4DP95kX97j391iz+2p+hE7puUb//pwqnwK3z+Ov95
+Cb8KP5kpupI/5A8fLq4zwY/hb3qJH+NK8fULicxSnejYhhbgeKRbP13cwf3Lr/BnpvnvF/jvP/DX
+b3Fz9Bn+4YXikX4s2pb7u+8ubnGr6Bp+v/jV1E8XvuKf/Mi38490vLiXb1J+HVe+OBEeFX/5ht/i
+AN/Rj9iPRYfyr7jB+d6iT/kl/r8Lvs86X8EPwv2i3/kkf/cE3/RGcZcTRbt3P5K3/qpfu5+4vUWT
+eJ64MM47frqwPbQ5x6/OhtHGC+7nRuzHi0GYLO51omjcfoFHo9Nt/WwxUDv4w/55jhQ3nS5GzD/5
+k2LSf/zpwr6iS/03xb2OFEPkL+hbwj/lH/rR/pHHyq+H68VE+9fxa/VJMaoTPAjX+fGu8NP6r+8p
+Hmx30R3/SrGe/Vr1s7OXn/ZG0cb+VvEWp8NSCeM8Hd4Lv73Gf54uXvBicS9u0h+m8njxkN8V//Qf
+3s6vvCP047/w8K9Wy4Xi8S7xM/u/XwsPjGc+wK9wv3ieS8XSulnc1D/qTZ5cvlF45omg3yHBUAE+
+TL7Wif2Vl7s2FTyWaKsYFb5ksUNg4c5sXs1iKCu4OZxY45Zr8IF874YhUfzWK0rChIW+NAN9/bjy
+Zn/lggRky4ZEEpu8XoY3aQkVXDXNsvROpD5QxVU2iVRWtFFSBL0NyUzoAP187CEtyUeA8r5yQLOl
+LgAvqZXHR8VfvflQvaizKEWdJHbRMdF/Q3hSSegyEQmbQ2g+euW3G7tNp1q+w0KyDglCaYtn4UD5
+d4I1iJ1+Ab71RB0nUXIFAb4BUKFskNHw+V3W0fTRtuwo724o/aLg3LPOW3DkgquA96Ux69siB80Q
+jBUStzwuoZVsTWZbOjP4iTCoMfQMsEwwYp2nxVz2cGD8m8rqEkLllLkkIX3N4xySsNZ34ENc+Gkn
+q/hZhxPG6X/rtE6UDiWxQ4xg81VIwHVlPRNX1zbjaFYrZg9tKA39FcDJwQUGsyZtD7veISUmtdqk
+VYjpOLxC/Oswad3s4XcS49ZQlvtyziJl4t9IZvCkU13Vvup6dXEOr2XdTBF2jyk6iDvnHYi3Ozsg
+dJhECkfxBEhLq7lRC+SrOxmoUqGyUooCi8HJuOPS93bYKl1F73F+smoOgCrOxgdDqJnVZKqtzmqN
+IrEbF3l3L2cHMg3XyIeEYJJ1qB8QCvNKrwWcSIwTlOFsNGqNTHOTWNvjs9Gr1wyyShdD2Mwa8WuS
+oNGJ932PR4jHtOCglVjQMgsqMEEfI9bG5PyzDrqLMUW2xPlcqBdTTGQ95BMjrTZ5IlVRw0hJwhLe
+7CLFcZ75adUI2CpczoUaSfw2MzqwzEI9uPQKCQZXdx91rFQ1EHNkXOpUMnEXOW5r1cmx9yZUzH7c
+WWtZrqSt4SqUsvaDryIO88HdbIW3EUUcz4aE1yRXpfITdELFpNZf7DwointWSaAfxF6tk5eBjSml
+MueCf9Wxk11d73CUGrlOntAiNPfxH9IxXJ/kApbjULdbD3uQkQh+j6FORsDDSsCMPqaEDHdEK1ND
++zkvtJIoCVWB0rrAJPKKJWEveEUAUqUOO+DlrkIXZTAKHB2u6eS2xag6ZW1XQ6LeIT8VuBa0ljiD
+z08auGgvd0UdykfBx4gij6Dxu5WD7i6r0nm4iEU/efwKzQJSn3D1fE6WLYhP1IMrMSpB2+FGb6we
+xbwQzoCKlIEdVHKOR1anMol+wXAI4xlBtSFyGmk7TysKrMRtlHjCI4AJVB9zY0tboGxpw3DWDa+H
+5/aU09pAsTaGRMFTXd0BtVlVFOsIJxi3j4cOUSlopychzWwazHTjPWg6lrryYv+Od7Q5PB2ikqdh
+jshY1KORUjKvM72Ec6t4Po9PKN1tKCPhBoP+B4Bdr/glRdrhDYUu4FgeuSrQiYLQFzc60JyNNVPO
+MEnS+m7KBvvXOyZhjbM6Rxp7UJq1GXmfp1SVIZGNuWS5+74Ot7ggPKUNmaa5zx5WoHjM8C6vPjb9
+n3hrJlW1v/PdzAKPxsnJzCzOofhyUbyRKHPzC2SeNfcCiLSCjCvxnukQjm2RZ0gbwx0SocYXucU2
+wDfDoyvrzJlVzupK5BsRrBW+eUSkKx92PIXfqoKHCRzUJxu7OtRn6cFHV6NNukiMMhmAWz184huf
+MueOZYGQ5SzYCNHmAoVf61UeuB7W03KkVi93bgK/xxM4nZdijXQF60s5ImND9j4QyUxUwFysq5f8
+fScbXlCXY5KFfEDqQV/4NMXuHurt+CWvyGhD1DcYyWiooOPGzGzy5JcO+dd6NBj7rVA7ShtOe51e
+ZmX77v7W6u4g/vtFV2GxQv05fBVfUb2NNYTI2wOQ2PnVhsOekax5ESDJj0gorrpdW+wF8hwErkEH
+4OwbZ3hOBqDELR0v0uZhW4AjNUKs5caER5yEBRbKuGQUMNihTirTUw6w1rhHHPtXABpq5BWOQsrb
+evQWxjWo7gnT8IktPEtoAQx12E4uqXPG2197y4/PFfy1wvNgdSMYGub8YBRZhOalF2sSgBKP6XAn
+AOlw/i3DtpwkKTI8ZN/p2LAOmd76PGXOfWN8+SjGJArZBexzLzXd4RK/xIACs2CCewrbSUWf0hdM
+pqD1zbPDVYrY3h1at0/ji9TqDts5CmwYV6MP83Gqo1NUtVAaEakwFPmF5TNLyLiVvDNcWjMYiBRj
+wrPRuJM7l1DUpdtQ8rSJd4Ea82/hCxSWM20EChqiDp2FUzfv2INOZYX6zV+dLoWN7Qo01Ze7gp/I
+Wr/M7JXb2KXx+yhQ5o0Uvl01Bn9jZ4VVQLpg29nVSY4GRghD/rH2Pnrt1cKK+pPOMqsoDRGapznh
+b/whtuOri/WyusMKtr4RBKxCJw9jh1iTFi60P4KQJ2H1FHxS+8gGK9FnQfB736mJT79wC5xWTCxj
+72Y0kM5D/z9Yb5Y/xn1DSco2SoJcOCwNxJfZU4RlVRrZDF5/vrd/QRKKWE2GxWBBWfdX7vBcBwrl
+CV2xY4Fw1ROiJ6ESxUkD+BtjBtlcFZUbHTKN7dBC+2F3EiPGRFpJtXDrU6o4aaPc987r8M9f0Pqt
+76SNE+mdjnddJuu8p5p1YkT4Ap5kwP6V4oLyoCJJSacgIvKkMewyWDhP6kNEKpBDEUFXLaWkU5hi
+WY1SK68G6k/75JNOLJ7KYuVs9pED1Lv8D5TuhpVnh8l2uIkYC/JNGD5EFbNHCJCM5zlKfCNGTpcz
+Q95TNDxfK9nY2bWdDG/9Q0/m1KbRobsOdIpW0H04+DmINIln0I892vIVfjnuZsly632mJZaJVJSQ
+SH2noc0d/z4o+amYIG0WTt2gjlFCwjfyeQIIMIektGczkafQMn1obeiTxisxkDu6Mu5WCTaH9+GQ
+ee7kw3tyVWjIdRzL6EVxlYgAphsu0gh08BLoQmiA5XeAYs9XqkKRo5O9jDzTHryQTgFD5Dul8nHg
+VS3UhhKzm0RUobE6CeU5Tn79YPw8Cxlslg4zYngjutJza7aosyS8jcDWLDQxiRWOJet1dFCILo/5
+I0/v2f+4x7vcMTmiarVD/LaGewjBgnzSwWQ2dbyggkJcGu5c+eXeF5QC34kp0pXurdYmI6+sWTMY
+uq5wbPBC3Rb7EzF7+AnuURBTbIeDHAmm6JOTcYdbaeuM32FNfdLBgqSLQpPOTzpYgVcSZEyBuIV3
+5HRoscBklTHuk1ijDrG4w2enJIj+9PX1QRRNR1VRZ466jyADZwD+OIuthNXuCVaJFcp2WzKyotUI
+NdiGDBZ9V9e/T4V/6gtFuAVwUYwS+Z6wHAeZODQ1LzytUeqQOqIOZysK+pTwjLzSI/ATS0VEyplV
+GAmw+zxpqVDYeeklHnugIoV/pXMb9O8kWS9Jp+tk0GDCy+AlOYVOd75lM2YVHpnX8qNKRRtnA03M
+t+MK7eUip7cURUprkc7y/vOnQa1TKM8YMHVK3u14BSMy1T7O6+zLovKPkTHy3zCIXKukdG5fVGQi
+FpKJZM9I2UyigMZroFpmMSWB1SfwzAl7bgV3OOwAXDzuxBCo3QGOtKFURPTwRjLWovQxLEc+ovD+
+R8k3dk6ZIuc1dtOGj5QA9YScnDANHskYT8CxKc8Re5G2RuTqQuV+n/Gx6A/mTvi5ulNOtemv4tAo
+sBx4hbGpQpMGx2GbUFGQbCpoF2mjs33/sUN77Qp9iUWVcDZ6ZLHQBopEKSrKDD6UlDtSQrmuDvPO
+E8axQsM0MxUjQfNcT4Qk/5Zs5flvqDj1fGSnI6HYgy+IIq/YoLnLkaap+/jXV1LkmVaegVv1Zbhb
+PUutw0JnHWwfp/R0WGRve0YEz74jA0NgO1WV3JCU6zLJvjh/i4q0ntg3cQZ1U0W+L5h36LvHwpr0
+/sBmJvHjzYM/JIXqrM713DdUg5cXuqFgv35MY9G/AS1OFG6JlZ2qz6RzruZF3WjBzYlFCiIiH7Ci
+QMBHidl/iSzqcFn1bZMnQFW0KapaYzIkksZmpp8EbjG3USr2hy14JEw39CUdcFn8adXxFNjvdIgR
+UdngeVxro0KNydda4NzvUGVhR4LoZNDwxvyCG8LHe28HhahSgbdzgsbHYJR6T4c6BcARKsi8hYOc
+Y0esTFbVBqczJq7ydOncseurK4qq2pPE4R/EwhCZsFex48EU7vTK8/GMtzm5QTxfKIbq0L3FMyq9
+tRgrqk2iTlH12iJTo6qFxm7aYHodc79xD9/2q1OhbTsktegdb3fBMPakwv6+PqZO881DC7OCE8MK
+xaFjNgyyjsKtO1UNTL15Dz5XLXCGheooqZeGpS1cmALFEWmIo5K8HhrJYdNHhWXrHi52f9ShN3dq
+bHuEkaKb617Ym0uFIhf4RWPCJp0S+dS30ouE8+rQsfSxpF+J8Jid9s0GfLGj58d7FnM4BCPtyZl8
+MnWYqZ0IfJQ8dw4+poW1ZTl4P8toshlaIUfwqdA1BWtAZ6T4yhVDnu1JiUh0FliQnons9z4vVKE8
+kRrnDOdiGADseDe+cSK3sPO4FJ6q3GB+LCaTHQrsA24+zm1KfcEUfls2BXU94KxC+fXirWgccDhu
+3GP9VcKa9IqvnmyG1a5NBESTw9tE57biGz37fR573mxf1E91/G/w7Q4m09sBOHCSIFjiADJkJHo7
+qgJoNuP9QtfBCjLpnC3nplrr+OgvF7qxqyteXxMNHUO/WUb42Fd5uSvScSw82lT2TPxIeo30UBLk
+uxukjTC5QMUZcsMcbSps+9oXBScowcbTsfI3uzq57UgQRIG3aiMx3DnVOvXpQwE0q2iDeffUtR7f
++q/U2wul85AjxUAzzbFQe08bEjFYLELVo2fQJ1FBNFSd7EOmC+XDf//9vz/676/8+5P//tridlZ5
+u/P/OpCQFQZhJeJ98h022dIwPcciUguMJlY1E4nms4d/m5dl+Jz3vWRQQ2MmzQtmkO1YVvK9L0Ty
+V6wuVHFy+Eu2Q8yyhgqVMka3+B4FhVdq7rrCdytqzaTGeR6eRZcD+apTdk0d8pZlBBJfsD4fDwtd
++C+dnh2spuUzxpw1z7TCeeSV9/1b8jPHUoyKNBUJshhl6BQi9Bae/eXpVr73htQdPpotdOjIdkhL
+NlT+0TZRiCpQMS6xZBbYszt1nN+FuAQZ7nFSzWmbxyIlp90DbSxkmzEGHfgc+myJH5eKIf4g3jHI
+KhA3ScXDEOsV3WclotteXer5jvAz/O1HaDDNP3p+mFWUZvmne1gTCbpTt+efQLNo/t78k+d7/lqX
+ilWRZvm/0LF6yjpTcx01qTsv9Ki8khPf5UGhnfR8R+e30MCaYW2lGVZtus8aWPyk+Nz82aBdNTv/
+YP5uUEoKClFe2+r54fkHrIe1079lR0fqYVCcwt/wfBM8Bo/mH4envxu0px52FLImOxpQt8JoQC/r
+kVf8wif4CfGt8MzPD3RUnnC3nc/3sMLU0/C+d5/vgW4X62iFvwV9J6+Xhe9BlerFffH0T3jU/TN7
+RbFHrFp1j0dyJoxz+O78vec78ZPnOzszMsfaX15D6m7nPe493/F/my3M8QxG118vfG7mr678sHjr
+5zuK30LNy+tTPd85f441qmaD4hi0sB7Nf9O5xyzP6J3wvE8wpkHRCvPxOMx+oUo2M/+omMvnB3je
+eAw64/eE73uP9cO+Yf0sjMmF4r7QM8OzzN/rqIFB2yusnOc7WCXs8PM9YS4PP98d1MN2PJ/g/2Ff
+3OI1CWWxyc71bs2f6YwGPwu/SbEibj3fxbpmB3iObuFaYZYn+G2Lz/l1Nst/D6pezyfnH/lV69XN
+eNxn/N+eHwpr4xGvozu8dqFBxs+Hnxdac8938tw+xtt01v0jfm6/do88n3g+OT8z/yNUtBauLdxc
+3LtwESdFUAXz//UaYg+hCuXVpbzuF6twTS5cx2+hQgWlrcVdQQ+rUBkrvnt/cWrhIutVbfc/W9yx
+cCd842HQ47oB5TJWkNrFJ9Y1/G3h2uKOxb2s1gUFKq8ndpe1p6CsxWpWC9cWHixOLe5buF2ohC1O
+4j1wlcUdCw+gqoXreS2zhdmFqwvX8K3Fffj54i7WtfLvCs2w6c5o7AvPdzt8bsfCvYV7eAd+jsmO
+phqufYM//0Ohx1a8G79NGDdWF/OqZTv5tw9wJSip8bthhG7y++D5oNK1L6iA3YMi2sI1VggLY7A4
+tbiDtbd2L9zke00GhS587h6P5OTC+YXbeKPF7YUK3OJu/zZeSYwV265A26wzC/jcHdYSm4SSGVTH
+eOxxx7382/NQRwufw92u+5EIM31v4Ua4Gz9NUDYLYx806S535vg8VgZU4wqVs3CVaZ5LXn8Ll8L7
+7vCziCdYuLlwZXFyccfC5YUHGGGsxIU7fNXdCw8Wd4QV5sfcr2Qoq0Ej7lrQPsNc3gxKeQ+wYuzb
+jS2iClWk1b7VCGcI+1+QFnqqshJ+6s9lJr4VXa49Fy4NQXfpRRasUzEG59+LfElV9fwpvhipUWk0
+s1NCjo1pMYBkM4Mgk3lF9UTY2mCnqhxlESHVONTr0+AcSQIzC+nukPwvWGvkGGRmZL/GTbJfZKPX
+d2S6SsN9Lwg+5EUIEDkEgo8n4lgXskKbgjhPaIcT4u6NAdFkWlNBDWEd4SxEkeRCv+TVuUlXB/pF
+HW/B6RDrOT3dkFrmIIRTMlFC3uWXWq15gWS9yLPbnl9/8qs/scbWnzpekiOfvBuCmPaLTM/g0Its
+SS1XiSEOu7q63i/KJn2/GOlTbSTMeiSVB2PdYTL6/suRz7yXKYVbv6HkRTX40QHL/DMJeOUdoRJK
+spp2QeSoUJAB7JC6QZtnoDb6xM8oGdv4mKujcAFb6DLJUaqLcV+/t6Fkx6Tb5j/xzovp7qhMpVDv
+D6Iy0VBv0bJp2NUY4StLkxjqqF15/hqvM8xUkWcMCJYkC8KI9T99kYG1XS91Ip0XTJGkqHjjTlFe
+iUySLXTqZKHTCzpB8saLJfcCAx9ksJerklHwXLQv4THzOGkR9VdwRQ8bRmXaWKlyPsJ5aDgEcx8J
+V0NnZG1W29wwFZFZMcpHbGXybbKCIAZugow1MSGHZykZekEtYmTFlzxKbhnKEJpUoxxoRrqypqfq
+l/jqNeyGc6SVvkM2k6yeAN3uzph5kJkHdVwU+VbfXSn8FMCH32QvwAUPR3CQW3QE4fLdgpZjyx2s
+MkJn+bDJ3nmxhwrdqrQR9bwgAHmiqWdodniANqQgOeUdWGE8UKoReRwo6eBMkW+8xB9INNMDkHL5
+iNtERMgbDb0guL6wO1Hm22TjEkWFDBuQqBBzE4YhDjwPI0keqCqCOXSMC7gaKvGQlOd/vNAbTBvc
+W5BkVblG94tkH8TXw8T6Nq28OjD6aUopamN1jLHBSmQypWRswLMRmeT0KSkhrWWzHXV4ToEShuXp
+e0mEBD5TCMIcJ+SLN5mSYk28odQ79ILeGFIchUhLWKkhUkVmfai3k+Ar2ifCPsg0DeshFAeyHBwO
+qjjkDkK+gCLfpJrBwB5/oH2sExJVI33/O9r4wu50Olga65NgPFkfeVQvYuNd1M1Gq19QsDG+Ieke
+RHb6+/r+lVQdzYuhF5QHLvPELG8orenpCAl19rxWv1FFhioqGvZFuiKCKpEkWzaev+PLDf8qseCR
+M9Z6SoX5/yNT2A9eHOMFLZ3Xr4/YuS1nxJadWT6djPcLw9X/Av2PfKscvsKawaHesNg7giCUeOW3
+yBKpQCAVNkJ6ngvzhQcwmBVeGn7B02fwNbRvwhcCv+HnHbwF3f2jcHYWbfCANIZcCuO59aAPkbzI
+C0SBQOez+VYHyHqo9loxLbDV0hNATCFZUG68qD54UQ8KExQVyf8xLzDo8bhsuMsjbCL+PJfhYOUb
+8TNk3LGI93zBP5Fa/ZFba4BLtr44ACKpPH1ZVr3MRajVSNgogNEDVksCs8a6Nx0iflFzxguow1zv
+6WjipY0P/sqYu6jQfAyFcw3GTrkiXyhnQ1syfvu4plPBJHTfzC4wuj0Uw9RRU+TOsN+0YWw+G+8k
+d9lseqxRRB1FG8GSO+z7FM08gIV2WJFRQkDGvEcVuoV6hYwwJL4nnI25EZYTnewZecIz63WKDu5Z
+JOg3lFASG1kusy+6jXqCUCX0FmIU20831+F6doU3/ty1Qqqo4OtYzTflR/cpA5QcF106oZjDrFwu
+oS96/jBlh5ul8zh4BVIM9Qebf1P0SWLxG21UJNWLdFbk1UVYTSQ07Kozhz7qCKhxxTIvy/UdqcPV
+WHA8JMoVrPHCEngJod6ttmi0IW1UFESzWhh5ztILXFi6KA7OyIujritg01hcL3cVTWiGAi14iz83
+M+ntTo2iIjFXtM2EfEzBUOBmcX51eYdIefqhFyvkl/NsLv6p05EVDZ6Ed184ZSLqEJEKjVQcdeFn
+MdmgxYGvvXBZbdE1Tqrq+77RF3ZTkY3n1JiXBJVpwyuieLprIbeTNoq+YK7GR4QTzKusBPuO9Rvo
+e9I1ar7UIWIjWVDCbNG5tSazonlPpBVvf++U2Rq2ji9xF16qtCZchZNk/OOipxi2NJMUOStVekEz
+Zk4BDKhdzxJ6/muuZrz0X41esLejII8b6dxpPvE538saB8Wjc8dXr4brSavI7gZJNamqNe9NylHC
+Uf5BaEhXKvy7oV6pOjneF2RJXjCh2KKr66/8yY5k6ouzZ8jXLrw7ypQ136qrRlEpcjgU3YbSZ7Ew
+LN+mc8dt2XSlk8iOOcuHzzvJv9n04nQqamOkqvpEFS/Pj/KOE4lDSqoqhvjFYTkAix4+ELxX/Ksu
+xwshjzK5MfLiGUWjNyyNjtB02glpRyl6QQItEgTMtg1n1ij1vrPlneh/hypAEGvFitC8bVTc8Kw7
+1gqMdCVQxLB/kxf++ouEflQEQbmhno7aQMTCE/xoduML5wk2KvT/KShWfIqwKnWVlGO76fUp1r4I
+fYpSF6mqn/zfEIFQnGcLNVAZxDL8jUW5jLPBp6WrBSJgOzmi6EU0HhXdUAs/1fJm4Py8dxcLsjcf
+X8UZ3F808Y505f0XRrcG+2pCdVpULBShgm6RCZ4CGzGeAL5Z9IJj/ldEZ+s3JOtyxEJ5Ia8yvajP
+s07/6qMtvgfcxhfeNhzo4K2824EM1nIEyA5lp+8v7oVlWOaMsF8lKByrsVhLjX2xohorBK/kWYsf
+sXvLi5ZpJ2GgOqwZ5YX92B1+waxkumHI0NvYEPkj6IMX/mTBJoT9JRsz48JZYahI+HXi7tG/quWz
+0Yu6UrZ9/nT1K4pzqh3JG/bvWeiC/rr+KGK6jY/R+IUChajms+Aw/AapXamEckWSOhZpudGZ46Lg
+DDu8po3rTmHEOnZLDAdPF5vAp6+lc8EL8l48zjmRMpVE+opA8mrELB4gXMHxwJJ74XCuHertnKzk
+43MOqzsW5tUPXsQXLypELccA3Zyn64k6yEjYol7KteO1+VImPr6KntqRVExI9g7cX1U62rxc1OHw
+LfzWC60wMd8dYXSUEgeRPZEW9XBovyvTNHDpC5Fn70xXjGYegJWOOqWTcGwK1pNHOCLBHENrZZbJ
+jjJ/ip6qRQfnMte88pOxoBCvjDFfT8ADUCcnQtfiLUVNiEhfUOftlhehUUGiY4WeUJEqY5l0VsmL
++k5bFB9JVSXRiRYN1bGwMLGhh3ImqvSCEvqPHW6cVB3dfUoCD1KkVvv/8trpVP0xjY21LSJd+Yhz
+yOyjFHLCf8UFk1pJGzQYKRl6Uc5YqA5yOVyn6LQor9tGSdE7O5JKBF1M5jMmUWAaMWnN7xNLBJOq
+ucEhghOet09YoUIqKRQGPEgTMZrk/T7e0tgGWjkdsYsWOe0Vh/jpYlaSZeHNQihVpo0POhVBa19Q
+EK1jOSMO3GxeLpyQDi4n0g6oKdJOjjhteO0kUyVeRmVDYsSvSQ7YhHWdbhORiMbrqbLrdXUDmul3
+isj8fwA8burUGgYRcR42sBQrwjeYwAgwgab6gsXdVTThgE0VNgoxCg8nS9CKgtXB+rd1CmbQo2p8
+4rwo0ouE92Bg6RnD8+PLbrPlg0uoqKgZeQFVDL4o5V/rA3Xm8DgdjRBlfo6LSmcLAxhxD3TrV/L/
+j693e47jOPMFn9kR/B9y2usgGMZFtM9unCEaPUFRks05tqSwqOPZmHPWkejOBlKqrsJUVgPssR3B
+iynqYlozcUzb66MZSRYo0rQuvAgiKIpkxHr31QG8gW9ShEde/xcb3y3zy+72PogCGtVVWXn9Lr/v
+91tvhkX7JDvxvVEjOpmmqTDgCi0Ky4zBhSVFyDOMinE4DeQybbFlx+SbiqIsmhI4EbHGmvsBWf19
+MMRxKyrk/9RGnQNPX7OG0BoINCJ2LrME6C2CPBTjp1NO4nAqf+EhdMV4vpsMzidSTQU8lmkQGboP
+YySVDOzoMn2O6I9nQMdQowpsnwGEXMoRjpdV6ZaRIrCDDqjpuaJAWlcCiZLdB2urAk8AZla1xQSD
+aFDg4U6wMS4ZhwkjognQJQjVrFBxPtalmVMS32+wxpQLVCN3bbvLi9GUVUPvhrF0P6Cz1dYONoUB
+VRRZM8QKPQxNHSK3+3AL+pdPsoENskGLWi5sr7gu0ODjGh1c85WJtjeFIrDPtsj7hUOijkBI9Gko
+MVG70HAkktdxAL8b8EuB/CBkMUSWwFSRYXrpzBLaPFgo4j4fVXEagwSNVckoLPZ+O6itQBUciUHj
+uEBDXdlE5jcHwvy+8Ku1Hw2xQp6OOs8MqWZ1XDrX5z01FcBi/GHTYoGOrZ2gT9GApHcVSWzYxIKx
+sr2uu4ojLsKY4RPvKeqbrEV/qKlJVRkmLcWwjC951Bf6dhxZ/aFwkg7IZt0RKhYr93spZUY+Ga3j
+wI0pxoQhJ7h25MZnrQhkd8ctiNQ/yAuA8UPgLWPtq/J7sAKeg5MoGKnIFl1XswqQW8yb1NWQJi0q
+HItnSSeknGR08mJ8wJrImw39y22IPKO+4eIC5D9H3jw0hWCq8zQQ9COGsaBBavFiy6LiDh0+jFoU
+QLepBs8nr5k0O3i1RKz7lq2pPjg0q2PqGobtM/yUmbkYRbxRe+Ril3p/Vu9oqPgGH4Vk4EWKqi3i
+0qPCwVNNNEbEFzO+FN8UYX5wmXlx8QXhSMjQmOsu2kZVOUiEAPAdPsejDAfA7c16VaJlw6rt8LQo
+NoKZRjoEqwFhWtnQ4thKUx0LjXna1vAYdlGNLRP7GWSVuQbU0A5G8w7ZUym9w7c36z6IgrBpKpgP
+3LFsFMK1tt9/hljGnOz2634D1yXHMjkgSJlUflNHuGOkMKTUty9HYZn0s3GWYJfiFmUiZneddloq
+PpSNCUazkAhQ5KKBSctuwhNtaAn6ryBmEQvjLEd/cIQYDkqxV4og+1K4qpt+t2TLyRP1NJIXVwNr
+IosPeoCYfYmZptWm7MN5Th0lCGrjsdyG2yvpYYx4k/UGG/SoLtUs4RDZd6taImWxjBIO4QDL0lRb
+5dMpdCJK5LAYUqgnMczMs/ZBzQuHLSz4kVNFMRZP+Sw8cnwJOMyXacsCC4+Fi6PMzpEAk6lwSH2M
+Bi1u4ZaFr2EskoNntkiJh0N/o4I8FJPw8YkzCNlDuYQDooY8ubpywh46xHVX8KkkQVzjOBAGo17b
+vic+Ple7l2Q3imyZ5ThxOcyLEMuo6EP6UsaiMqIZYk0cAjbrQlYFEeCE5JCiqykEX/guLSpT+IGz
+PbL6PMpwu7L5ARhYjMjG4ukUEYhhXDhxiE4VmYOD7OCwJnjcwA6XKUdHLfzIoVKcuxyvgslKYROD
+VMAEwCA9ih4a0DXJhFFFWje5Se1u/DExVs2Lso2BSWtF+qhJTiYmOGKxqdinWOPiTsL2H0O2q+Mt
+F33IF5LFn/id5rlKwyAuWrieApOww6dEwI/7GRXg41/QdKe5w8VnMEGl6gP6jEWgvAsRJgKnPx1J
+rg62gfmNKxYX2RmMb8WX92jksF0Cy4mtFaKnwfUGg8A0BWzk+7KpUp8uwQVEEzlmDxXOtSFy52LW
+i3AQkfKW0+q1D5KgZ6J7sIiJZ4JNSyEzW8QV60Lj6pLlUNj8klwEXNAj45SWZA8GNjhXcvfhBQhN
+LiL1LFRyUfxs3Qdb4x4J1/JbgOkznEwlx13uB9Sc0ymiReoMaDy9kHx/4bj5pvkxxqMMBmDEBMJk
+yIYEKIhmBrdBqQtB1ygWXIgqENlnIDlSUKwY3BckoaDqnxIp8HHB9H1ATnZ+k7IykphjRpZACQ4K
+ovqSdktQtndY9jC0ay4ssVkHTjs7TD0XAoVDAimaCKfJPBqy5NLgtRSkgB9tSepv0l5fcvie4lwD
+KXIAYBR/iiXFlMwbphA+7+Dg+yeauXmkp+8bWK1p215kHoigIy6+PKQgQk2q2mGyblgMITpMMeHl
+S5waFFFmJT5sut+UnCWvQuwSOlfgx0OKKMwGg9Qwrs+2BokFoXWB+ha4T7rS2ACrhaPT8Ma8I4GN
+WNHOQb1hVmEuxsNy8SRXaPhSzBlSxbEF04Ng+IYiLvC02mHAxyZ5LQrNk1KcHZXoEuC2ssn7Dr0W
+rslYmUSeJdtcMVuyVTHjBiZOyFs1FvWxeP7NY48yjUaJxh7LM/BevciFvDAnIsyxN4608jY0lQG6
+HkqDYbqX8kMxaIbrouDlFHlIKI8eGtNUhQ3NKSKnkZzaug8onEG7PDytcfXQ4KeSLGbaQXhEuRbP
+zdMpeY6NpDgpF+U2Xpvu89Rq3E5wvpHHEopq05X/92Vf2lQVE4Qorm+p+1gWP5L2Jp5IbxvXwwDR
+Hz60NH+5TKaUahaLsAv8kfsBtMNT/UmQgps6OBJRwGOxqW1jh7CMq9qt/eFDuKJxoiCFT2PTXUgZ
+8RGpAicW4/S83agont23fTYt4Ype7XqeHhGJe10II5aXD86iI4z3TbUxgeh+elxzMup7S4U8cEEA
+15C4H/GCtZHt2wJF91NdUag4V4r9y8lUFwaUah6AYv6oqWpqji/XXOlRN174I/9wh/0sHAD0bmmE
+CHDrep5KkRhzQ5Et/LG0m24tjgWS2vRtv/ClE/pvjGYQ7cPxtjkeGcIUwjdAuFj0sURqAXaUpaVI
+1NR6jjRh8QsR7+irEnjrOFVxPAp3rvuNvtv0PbeA4TTcMYXLTAFMpQdJOTfhC5ZUuJGJM6sNV84Z
+hQ9S7V9KAKyeW1ra2tpaXKuqtcJVqs0wAmItqyw2xZ5rrotEh4+ijJTZdNTVhEMq3KA5fuw/N+uG
+xBXHtjSxQQrol7KX8F4qm0ftCfh5z2/6gtt27G/VPUWjZFQ7lf/uf/OJdM2CwlTh8VxWME/GS90E
+gikrBsb4qjwS6TuOHH1mhMk08BTmERexySGpmP9dMRu1r2o+gfq15bAB8wjVopdKdcM43M72ubo+
+oKyVOTGg2vsUsH7elWUYF5u29PZEMovnOzDKzMkHyOElhg73nS2E5h/QIrbvio11bxNAphgrRFqL
+4UcEtI5oT1usueaEkH0pHFOIgXBPnFiSxFtRkUCWtCad4sV+0wclnc66YpC0xckqDfyReQXDETVS
+QK3FNDqYdakRChQZVNTxmDWJVaaTSlbbT5e9cQ/lrr0ldAcBLRXark/+FoH0ZdtvvAtPuaE0Oqh+
+60QxNdNUUeYbDh3b+DCgaEviDCvGNLvoXzmV51584Sj40WUf/Zs5BhtRPnHRKAdD0AI911YoI8Ky
+Y5pg+STGDrfQ06htuSbRvWido0vDvklVulp5S071z7JK9b80qn0QSsSGDTE/dDq9c6o0EjWcV+j8
+bzCvpGWe4cb2GgryBBOltOt24uaNIHRGwg9tsw7zmT/F+6Q63mKM+wMnHRJ7MWAlhxuuDCJ3Ptzg
+fRWLJlbatijaJlbZot3PaCeMSJDgUT1yyy9gtfbS0tPPtg2OCw1pIB4pSqpBmFj2CoUeNZHlzbtw
+UrUhcpd4Fzp/84+u7PvBf19Y6P4kxf9b0fVAJfcIGpxnSSl8e6klp7QzpmZxcMi2pKjgnNAnUJoV
+fGgE0o7KxAyKUq8iaiKci6YaqHPERClzg9nZUsZdOflLgpUgttuiotnXCD0Vtq1HIvrQ06Gjsszf
+HqGFS27pJgJGCwjtcgBXUg/kSjauP99aLKuyqeDtFGAfuQIZXdrS2E4KLR03UMWjUhTHojq5qQaq
+3uXYsOqrMU3nI8Jm2Bdfg+9y3Nn21uEsgDuFWOfjy7W/j0zebVPCMkYHJYQEM+y5J0VF3ZdrXRVN
+VMdUSw2jiSxgUaKFdhfYzATdr9aCWbfFQPZnZQwbeRR0VuSLU7mPMSV2pGjBDF0DLlCBsNd1Z5il
+17Uh43uczqDEqlGMKX1Jki2RPBUdKRcHTINno+i28eXfuzAK7IA/r/b5vg+R3AJiWAv4kOO1OjqO
+siuE6wtc2m5nCeYwZDUjRYstNtbtKgE2fTCRA4f4edD7XlKRB4Mqyuu+33flst20vsCchy9pryMm
+rKjfbSoK4LNDCt8XZd9DqjqK1WwtMZETEAmVOushB95cQCF7H1VHSc3HNNWzVel4Ks2rSq/QOpR8
+3l5RxVCPS9A544OJJL9zwYEfW1RbRxfbJvGVbKh9Bq6XV8Zx57z4oZTOabWUY297PVcIh1wCSprv
+cFbjGfB60xkRFMye9tW4FcNgHIFhXiI4pzDOmhhKxmnKoY4fJRjUcRVQNCq0baJyK6GtioqLQ0Qf
+2en4rK/KEyaBW9OabYxCK/QJtUXt7+qSFsrUYXx1NeXkEVlO+B4n4475VQkywzW233cpilF4PWeo
+rMcWL1Sb3jXmxRJesxetjz6HpfHpJcq2VrWEMktJcqewplHlgeFUiVqZ83C9eqz1w+TvhJGn1DvC
+Z2JwyPSqohJ/YUPlonCTK54crcJ31AGpgnyGPH5cSv06RXWJuqaqUXjLlybSup1SthAacbzvKXcq
+TVrTVGq86vXR0JYkfYy+m5xffD3ivDDYU9MEUnFfI4xmyJiE90GYSsB+5jbb2sUEIPwsgPMwRLlN
+Oi8MlSsBBB6FLBn5Cug7gSmX1VbElzP8AGEQuEa4+hOB/47YP15AqkrY+W2ZPJnDLYHmMawlqlfD
+DZ/lTFBcvBt2jVwL2ocTY53rW+iLtWoUOM0neCngXJQzF3O1/L6SMRo6Y+msoX9T3K+NOdOe3cB1
+VFYm5t1qJyWixVj2PZirXYWYx2Qa5+Twi6LDqGwwyWFQFi0CAQ3uybBtegIQVyXZABxgQ21ITlvh
+ByeV36GSClxrSKsZHoN7OvIxs3GI4U8CGG0U7ozUqMJ7qe3KqLOM8nTMPuvLpjKcOyIwD4WAkYON
+y1Vi3p3OGoydkrAg568wdYm4mgAJWa73wW0iapUKWIhTDnPKjzvVJLJAW5p4/tpeD5c42OGUeyKE
+8QnlgzPSEKexKtsLyoYpiVuM2hmlhE1ToVK5pDItVlThYXJmWESOoC1a66tV0V+O3HKkqgn5XoQL
+xUIl2NNSeZ0nrQmKeKC0rPmBrc2pUw2uECRXDbKOFXoft2XLLHvwuUKo43yW0CWuu4CRXyNrEPo6
+2dKrY8IUUfT7ZIylkDCrD43ri2/CZ4HKv5teCG3DDs3zKeCs9r2q5Ew5AmQUfBNrZmRvIW+ZcC8C
+TGRUHaI2+6qEkc4vVpmnsO+G1FLw2Yp9w/Y2orVULbvUouKmiZkp3iEU+IFZqqkiTI4ReN7Q2VL2
+xq46nGLFE9dXcolsUOX0oa1iO3Q+Il4gnFI+C5+DOC4Wc2gGJ2wFmzwb0cwG126qjXZkBUfka0RC
+a0TyYeFxhWSDLxNcgbLXmDcIrCDSkAh1rOwJpGko/czCI8aX3J9cHJVq+nA/5FJA9NE47kGlwuRn
+QQMESBnrhQZVTXsF1+3BWe+EB4+0dsWORQSxrVFMU/YOPEN5EUTZX7hPKtYMmEPmtXmq7HsLnLcu
++ZGFDUJCinNJ/BhYmwRkp5mDCYUt8Welzpcxxnx/Ve7F+yRJZeChE+FbKWil3O4WXI8UpMQ1Wzsb
+dOIFt6SwYcEkB5xCN3HOE5OciPRHRVr0f52tmeaAgVmSfAqCwOcjypSwqwuruqkGlOD1PVhUMXuM
+ZQcuJmFhvUne3isWfMHI8fs2snbAXi37Hv0FBdBv0d0phlCJWHLwoeeKguODK+gZRTQRq5RiX8Uz
+d14Y/XAdhWBDIFmKoOI8Uv4/xtxo3axzmlnkacEcaUcLBvVJkFRM8xCMOWMWUTfqnIqsD2UTDK9Z
+CEDD9eS3h4bKHKmfw2gDi5shxpisBISFD+kgR9n0iMiBKShrQzAagC5ivC0Woqd92DaQGBFnkvsW
+F38U8oY6GrQnv492qVQ8al5g0mNiZQbYgyE67Kh0MmZxBf7ElT4sTIs2AfSbxTw/l4Pz/Wl9VQNj
+13iNU1uVqxoI7UE7GGUe6bBT2/AA34eND9pjaW8X+m048rhSgiMoKSap7MwawzN8sAsyfYzlrLwu
+Qrv7fbDozdPDDV87ycfT3mIkuzgf7SLMxptIkQz9g9uIH7g5VebcVS+s4IfGRYh/UwXnhrKfx8or
+2hu5eLuscM+BRUO2kew4Ljo4ZnV8KoHc5ldV/Id06mk9R11p44WXFveTIWz4lnOttC9R5WFPPJNi
+DMQqcCe4J4yIwLk3fY96c9PVcyqQndibi3G0cwZVittYzNfEbsE1y28mNWjw9lKjAp9XNc3xLR8I
+LMiAwHXbj/4vVzHxWRb3kEXUO2sa4fwGOzwQWiH6qvNS7gPjwpBrrIcW2wnjPKIwTjW6BlkScN+g
+GQFrgPZJ6kk6xJuC4wCN4CzErg+p7AG7ILFd+BqelWy/CO4lmXu2FVO9U3DoF4gEeVzLvox0ERS3
+j7AuKsBqeL3Tc6BPqy2ugWUsEYNcxnJWk/ICVW+fdmca2rvo7JdqU95nRhKntQjoYtsbUcEewdPx
+UEj9EFwZXIS3DaoaK6cq1m6WXEOzVZFt3CtsDf2T/KYXOIk9pgpvR5DcVae4f44t/R//LXzjx/8t
+fON/WVpzR3/UrNfVlnE/WWabBM/yZquKbLIRUWLLPlSZSzFNLG9BBmAb53DtbMElhOGQKgCK1enR
+/qG9SPEoBRfzOP/lqFM5nb23kF/vDjLtvbt/du8RshPu7p+b+fPDxz9NjFxfffjeX37/iz/tfvjV
+Ly+RKjT/fO9c/Pmrt177y//5LyC+/Obunz777Kub//rVLx79x2dv/eXXj/5y6Wf/cfeT/7j7yZ+3
+H/7p89/8aff+n+699tX/uPynB2/9+c79P99/+6tfPPrzh7/86s6V//faT3ndYRp83dU1wQxEWwVA
+BL2K43V4DWyRGAfrozZuJaABzsfhNcKEafs20P3/8C6JMsvnVSBlOowuV01tV+1LFkWWCQWOnzvA
+0QvuYehqeOZ3/WqN9jCqDpdVICYG5t0cgfFK16dCcebyDF5S/QF5MHveqne0gsyA+5BgEDSjX1Gc
+Vt4L+Fbo51Hj489wM8mduXKA8RmCK0i8rtIoEZF+xu+mCL6D8kGRH7XI3AecgcDQ9w4yG54D5r/E
+Cym8m/s/IzZJYP9jLsbE2wnskmeR9RFZGYl9cu8m8gDuRLZCYeMERsb4rP03YQ7j7Lyt77O3k7gG
+iY1Sno2/P9h7gFyVn+DM/xR+i8yFwFYY77n/Bt7xFvJU3lPPUtyIyK+4u38pPpPffW8n3n8H2RGF
+23J3/7wwa+7/bO/fkXWTWA4jT+b+BdWHF4DnEjkj7++/ie8CjKavAWsoM3beUVyhd7iHbwJDJ7CL
+cp8AB2Vit9zZv0j9s/9q7Btgb7wN/br/xt59Zl68iSymb+zd27u9d18YQPfu4F0e7T3af0NxSO6o
+d7+J/UTMnffpXYl7EllM73PrPiEeVhwxumYX3+kW86jCDIA3Qx5P5IMktswH++f3PsMW7PJ9eByB
+uRKZJT/bu7l/EX+/hC2A+RnbtvfbNG+JBxPn1ud7t/fP86y5p74L7/II+Tcf7n3Go/gpcMriDMQ3
+3/sc2w8zFfqH2ovjAmMK7d5/De9AXKHw10fMQ/v5/oV0TfYuD9VY7NLzcM49THMYn0wzYFfWF/Jz
+nt97V62dz/cecr/vRhbVOziPHsD74dum9bIDfb1/ltZXmud7O4kjdP8NnKHwHg/23yQeVV5TxHBL
+4/sA1wDM25/vv7n3ANfN2f03eQxpT4jtx/V4k1fgvb27PAK7yCC6g8+9u/+mmsMwjmkdRT5UmnXY
+37D//E/mst3d21Ha3OdRhfmmaElfZP1x0kDffiR6zVdEQpo0sq9/cfUy/umK/PBQ1LEvfbH9bpIg
+T/chIen31T1viHw5ykzD5+fxkw/5u9vbqj1nUaL9Ybr+6iX55ApfnNS66bn3UN37utz/ilzzgeih
+R4lqFBDn6+kt3mIFc7onS7e/nZ6VnksS3q/hD1fw+o+VKPYuPo5aS+91F/99Bd/riqhyPxJp8k+x
+YSQEL82Gz3+NnfN2/o5n03ttP0yfp166IgLo10Tq/aLSlyfdeXrZj/C5/ybfov5/T/okvu+7X2x/
+js+N73hernkbX+RTVI2/LCLdcazfkhG8Ln0r97z6qvSP9Mn2QxnZ2zxnSOWcNcrPy7vfkDE6yxrx
+3FcyjvD5B3j/d/C2sc+pJTexnXdlxM/jh/GaN0S6/XY2H7jf7mHXbfMPvC7iHDgrb03C7u/Ls97C
+Nz0PevHc/l3Rgt9Nrxzn7fbD1P9w/Vuqr3ZkBe3iba9Lf15ngfvUZuqrX4uE+u30XtyrKPHPyu/U
+D7/BO29jm29iT56Hf7dvp7HjdfRQpORfUfvGjnx+Pc1h6rer53j1bd/kC65ekmt2ZZ3ew+aR9j3N
+N5r/b0ufbMugfCpzgNr8KTf46ln13bOqDy/Jen8V7/ap9Mn7an84q95R7Tn8RtKl2zdUe26iWn3c
+Z/C521dk37gt+8OuzI0r0ku3Wciex+uXao5dlDlzVSnyn1fi+Hr/PIvforX2Eb77I7kPzf/zLLWf
+7fn35Jr31ZyhiX1b7QO/lz75QHbybd6jtrdlA6HpsaPuf1NW0OepH+BPr8ifbsiIX5dRwJZQt6T1
+/stsr0iDK2uN5/kOv2zcH/hXGguaez/D++O04V36Zjrj+H1jP1/EX3fxmg+kkW/yO/I1uzJAt9U7
+XpJ/f4l32MHP76qz8iZ/F/YNOu+uxnFHtmjgxX4dGZI/QT7pC5H1+c7BXWFsfnwO2KUfX8TPP4Xr
+FVfz74it+eA2snlHHvDIsHxNcSx//PhVYGY+uPX4lcQMDp9mHOI/JWZseN7j8/ws8YDh5zvIe02f
+fwBtYrbpm8w6Dj9/CNfyPT98fB7vRO25hbzXcs9rj88/vgiM1I9fPdjl64Gh+5XHrzO/9SXhKEcW
+6fciN/mtg98+foW5xq+r576K/NzYNmY4J0bqO8jajc9Fbu6L8P7Yp8KMfQv5zV8hvnHirkYe8TvI
+P02fX0AW8xvcb6/gW14nxuqDG48v0X3w00+RL/31g2vMYn4D+iB+Fzm6ma/7+sEtaSeyeV/j/rmF
+977L7/jRwc7j1w/u4B1vRb7u36t/d+C70ueJ6/zgtuqTj4APncf9A2bXTtzxl+TzOKbA733v4Hdp
+LOQdD96H1lA/HHwkjOOPz2GfJHZvGReYebd4/nx8cOvxq3hnmP+fHdxCnvQdbIHMjeuPX4nveO9g
+Fz7HuGJjPWYOk5yoL9fMioKZETc4Y0+RUmNtVHP0skx/QbppCSsj+XBty341nDuKyRDiaxkDiqdx
+fUMiWj1f90ZDjjguamywxuSHzgQSM+W2FZmV60NeJ0jONvEpCZ11O4q8C5cmVqBv+l5VLvpe1RYi
+0ZpS7UKf2Kw78z0bgu2tj4JrGiq24MzvCib/JDODQXYJw27UVTkqOaGhMBBfGwgIHSsFnlGoVzOk
+LJQtXTUKiHOPwGARGGfCHxVMsXVvvU0gsjaGnklUaaVtA2EXqWZwtFG7ISA8RnWjocUm6ZoW4/ln
+MeDNMNmNM8up4Ynqz7vwtJ47i031XcjKnrTBzSUEEjILJHgd0yRz0P8ZBSKZ50g2FU22jaL7Zozy
+wBbBAU94QBaS0oXwPdf3jatrWzoL2cTaEi1GrXJxJHQXk4+GFPu4q2BcI5kak9iueW7oRu3WCXWS
+6raR3kNhgGIOG1MRzAe7COkMzBpLKugFW5pnalv2fOhVGm+SCGWwzBFuhDWdjesnZnhbGCGjwRXG
+4AaKtEe2XoZSMGw4Ul0TDhtFq6Ow7JyGWZ9WUBjjS4IQ9mygYocQiYcjF52pBt+unW2Qldf60uik
+KeRTNjFHbZpK0e6vtJeNKlx4aRRS0QkXlzAR2whTAJTWY+ge/KnCIvoEEiCcMW0BqkfNjzAFJOnI
+lg5wG40aFp4BR9wVUfy+MKxQQKL2NmGtiFmA+aiZq4pTGqZX1ZR2gE7o1TgvaZITLoExIoq/vLNE
+0gqydeoqDWb7ERgkFmmtFlXvZWRMqlmd1cTKd3iOKr0uxkmW2dZjzL0QF0idgYWWFXK0GCvau2JM
+6LUfaygbtxtHW2ABHY23qHRxQ+LzJ91mpD1ZaVdrx5fOLIT1qvfylt10C6keBdcpcdRjNuVZhSCf
+ZxQE4UkUvsglOjtGZbLEIk57lXdJGEdI9mrEiGzVIhRbwyaB7EMa4zafEpo28GqkxWgiTR4Reoi2
+ecGEHbZHKINEyQzwHuKF55x3qTgqYyEFDDCmOuEq4FVdr4YQVycZcFbQJxBvnFGwJWn0Z6DtTJYM
+c+0gUDBWSDrMDiXN86Chel8rhPiHEpLE0InXWaMwwm2T+Cy6WSI1AoeYf0gGz/UpRcYwpFNlv1oQ
+QMnmqCh5eEkbHBGLQg4d8euI+RawWFQDPembMU9Igg8IpyfhU6pRHeV+0w7rbckEKyTqbhv1i345
+k4rBerZY1GUmxMHOt444LRh6VfdyuHVKCDHgSoI8GpwvTuNAF1nYmCSAef146URmyITZyXhvzEpH
+ogDYowRJShC+yKIKFyvJi4hEDjRDeGVxGeR6JdutwpMWY9jjNzaEVZgJw/F0wf0tYvKrkiTw4bJO
+hj6m7uUqeGwb4y1Or7vghESFaK1hA8AsJEs44y1p8RKRgOic4qSIRVVwN6yIaHFJRIT8wPad2DGJ
+1Io5L70LSck3UuzzFLOlSfLMqZTMVAPiyGaqbEjNxjw21QbxDWKxDGzMhNQUCGcqEWgbWWbEbRaB
+NYOqPqF3/wTaM9XgkC6cVSx/xVhXkgY5J3EPQTwy17n2jdLbMUlHhjkvI6mPTbVvSKmDcBrs0bjR
+cVexeZHXEhRjJqqDk2GlrSoFxsaGcdkzK1SLFvkbYO5EMkmYYqRfzDx+RuPhsXTZWzoOk5Cxomwz
+YB8kqX/jS+GOwrmjCoaKcYf3VPyfkGXT1lmaNBUQYIVC/aYaKAJBW6SC1mJsNn3Nej62KHSB7rw2
+j4PwiI+pVoHnAbOXwXAQADJpehofnuJqRSb8TLVcATgCIlklGICCrj1+UsHx5k/l7gufp9grMJwi
+McN0RnwGw0zzA4/n4WrVNJXQX83pElCFRmsbRe5ZjAlYIcxVkc6ZoShSMh6EfhQBcFRelaiHsGAI
+Z48u2wzJEsIb8oQbNSttOCROwyokZoYiYeIUNhCJx5G+DN3UgS+GRhi2F6gKkQp8RTUBJ4UUUtJO
+kYwSH1j0nm4qexWChRiwRkCyUblRu56DHdb1fTCJsS2JihhfMlbMIArci9AyH+8bo4aHUViZcEUm
+IV7TVId0KaKtXcKKwX5deC5a/Y4C2psTejlHkn24LOnfI3FGVOQyFiuLXCz5knmN+FvhnVm3TSQR
+IPJhZpAytuxntmesWRW5fGTlGDtbfzuxZ1WDRBSN2KtNF8FX0vHwlQCT0FMpZFMd0nVqmz6M7Kxi
++3mCsDWI2gu4MKQkAvaQeNqiVo60ACt9iH7Uhh5u+opdxg6R/LQadHXltnKgqkE8R7xtIn8eFrRp
+ogGDyshSei/nXDUwNmhWe9fShwRZ7vxLLBvgeuUE3Yrk8wbDQlzJQJzmMt2MDa1sVkVWUWRrIJQr
+/g+5EYULKllCgwq5e0K1wNNZl8JiORDO9OCIiXaDxQdisS2cP2rC+vCUE0149uuJRsiXa4Tq45iO
+3qpoDxdscUsPiS1w6yIyWlgysbQykWGDGSGxgDGTScYKH83gEOjRxgdUOGNqX+SF6dlSjjZfqsKh
+pS72TixX1uQNip490KkgdF/VQO1iOno25GK9poYh98EkdnouYaLhh/050qYXfpOqpHj2igJIMX6m
+Uljh72vbXxHb+dIHdaARjpMXu9RSMyBQIN1MDC9mUTdRW3kXku488ygBvhbaVZM/h75bEwj+RyRD
+Y3F90ZP2wSQjhflAiVNGl7JYFq76Z1zBS7rC1ur64topbRLR70Dcce3gZpaq7Vlpy/ZHtnGnI4S4
+2arWarvpZfNmcCX5m75sJDJRjBP9FGz4NJzrGBbhAmWqeFSVzMU4QSkZLx0B2bYoPLYMZj0x0SJb
+Y1NpV2SecJZxsIxgNqsBUz/RpNBWDbovMfpVVLZ/ct3WzaqzTVOZFGcmCi/qKV3DYgsiuuRKFCJc
+8i5gaUAqubLFqlMeZbRSYbww3iuqIgLBR+6WZ1w/HRLw2hGQGp0uGBZrVAE/9NnzUqCDISD2UfqD
+2jkTAJFpa8c2n2AupcaGQfQRLGq37DjC1pdVTUZH1z2MlZqVsYyg3XL1YFRockj7onB3wEYQt0Go
+6BZyZmx1/AtYdojkFn9oVDa+EIcXd9iyrEawzcNMjLw1+rVDl0M/GEcV5jsyzp56zjz73Glz4run
+n/4+k8ST5Yn1KmHp70JVNyt0hDJUNUFtB1W9rgMDArwdofJMYoIuxikiA3tf5AyognIRfLnmA74B
+ceEx9SBNf80/U6KxIIZFgn5TKa5UGQe3rKsX045sy/6yUqY4Tp4r74mJ34WI/1KGgM8XOsupZ/k7
+q3bdBmu+5wpAhVZ1eNmsVi8P/3C1oF/KMf6fOLz+n3eRxYvTED1SirEFmlWMAqU8CP9COxX+wpKM
+SfHMDlf92ghXx1MVnJmgDXtk3hyxfdKm5+CaH46KhnIUxTgqtjOHhPInJKFCPsJxxmazH6MZQVqd
+pa42IiKJlaX6rUFtY39HJx//xuKj/ISODs51V8A3/Pq3Tnz9m898/ZvPdLLUiPx/owpN2yxlaaCf
+HNXLcjEoaqC5E1lPrJjSbZkTdW3HUGSQxXqyE2f5xXKAyROM0M63u5qTInt6d+UIzoyw7lxzxLD6
+FNvI80YH7zuFV6wkKOwZlQsVpSjs1U27m96I0h8kINQ2rSyAHmVbLcu4DQ1VtgERp0hSRFnHVCu8
+iI1e5Jc2WbCC6CSEl19xzSMvaSQu6Y8Xszi2OalTeIFGShojNZIUBVpY0H3/fO2HzpAShqvTwRAa
+3wPjyUQ6HxKJiaJeWXwC/paMGOrkWElrU+UyMSomO0a84x73Upqtm1jNlo6sbAW0KMYW2DrN9nXj
+U7UPhoZ1aoU3SdigpBYxrj/N8GCLoNMRC0oL7qj50ZNJU6JZd5mr2+pkKQnF1At1D6ziQN/r0BTj
+zAyWmckt8b9kM/gUSzJNFY4um5fCou+bFeP7SV3hiSe+3s62U0P1UCdts14VvkfRcwlODrQxarIM
+y7FGh0NM30NDYzQb5khUcdnA2TPk2bP4UlBLRwm9wmvR9saPoAL9p2nfmttaVPpjc2SyN334p+7G
+uBVMJXtK53LnTRY1OfnPrrceteY4df5ffLnWr4Yp6+TKHqxFMknQsSZOPKkdn8tCYL1q6JJCluLH
+i8kRHuiQUnOY9ML3OkI9cYT5hwrfbXUKj+wk0V/tZrdh3UR2aOayFPmhbAvrZhmlVF0JbVWKfcYG
+o8uTu0TsLLEhjs3x3tOz9SpSVFVnfN+1Mi+Y/0eZgoWlLGCsdO1ICFLtRJ0sISuOIqmnnPZffvao
+XDP/1X95/1JD4Z/ARjIvPJSoPP61J7LUQpbG6aDByOxEri+5fbA+2iY7nFqZs2tcr1e44C1RA5M1
+K5GqxHYB/dnJ/Bm4BlmbokFGJnjjh86Xhg9uZ5oqizYY9u+pVz3FlGV341g6j0o7c1yz8JthCTAe
+6ZD+EMmqF2ATD67BiWgkt8AOBF0owqKM3lBcO7IrbtSOQxY43WLlNUu2VrUpqyY5ZjBRU6UnWNTW
+ILMJgwbwj0bS5MnMhFkXOe8Rb5AyNtBQRXTHPlkSfD+hU09G6fz4JiU30P/rZEezycwZ0pOT9qDv
+EOkOcMfkcm9Phm9MaqcdJeCV7AdQnRytN1bMh94w0THDcAd8gvE1Z+vCu9DQIlfR6i0bMjvkcLaK
+jVKaxRCK7NVw08PZumW1Zz5NsmQzcafVwqOPGm8xMaFCErbsW6O58JToR4w9rPJdZIXTwtVeWu1W
+45uCaSY2Fe1gYCX7uL0mqYWqdIZjCzw/T0JP+yKG3bxaAcZoyzjD3ZjMlzTLGX5JMTXgClCgp5DY
+oRA9UPZNErK2ibvdeMVVBleabGdX0n2TZ4c32YTJ7HJjMb4g4BLYewJKUcIclDQwsT3VDs9h3kew
+B2OO/L/6nnLLSd1R+m3DYuk186jg96Inq0jxjm2cUTwaHC1kPm8IDxDFBK/bQ/kL6jipgYU5XC04
+0sNBPnIHg4gnUI1t5ujFADEF7RTPJ7OAqHpa5maqBsqr5N86WTg3a2YzlyHxCLaC5baubFSEDeeg
+sHrDqkKOjRjE0asvOEn9Ub8cU2yCnOeJfU3vLmED+k3cfqU7yD2f8rKtLKWpZAlZwAMzdph6SXW0
+sJdxCCbmrSNhvlmV3ZNbLUu6cLY+vqoUqYBvKekyGV8SA6Z3W7gCfgjmaQK62A3fWFm3h1sKbxSY
+mZL/xmiOEaVJz3zvu99pmo3vO6QowTxkhKkF2NH6ot2OllXszyxhEsKoHtiePAEtucilNqhQ9h9j
+d73xD4NrkkcfU9FCOa8MvVOZXS5eVtQtUMp9pCYk7OQYSeYudv2nkuK8rFsnod2TmX9EpygcZjBq
+uJSi1Fs2k3uKXcdUg8xpPewHJHKNwleD2q2x7oDQ5PuYho+4OBxNtqif5f6kOcG0alXKZ0E8bsOp
+cT+RmCpMNWhl25uc9lUZ+yWeK3PZ8s8AeyGXqyVhaxG7wFbEHXqpqyeaySCSuI5GNYA+eVUl3pxl
+tjwHg8FPWoutfKNIgjIYvYzas5CiyiY2Jl7ivoQWRAzYsacoHlHSFTfVIEsVYIo7YXezpqwq7Ubj
+WbGD+E+GrqwkeUiCN9qFziJqC6w+lvYCyTNwBo4JxvBsFNyWQUatKAhufBlJ5PCZKhvbrDuy1QXD
+CbtGsuT46IEFDwGGdWfUfTG7Liw1GmrQWcrwEkYZS63DrSxGTmpeUR4nwjtxV1TtHFR1CrnzjHQh
+iFjqFosrkMHPyiS896R7Dm3ftTO8hVKuNTbwniURe5Y+XGmXVduQ0JtIL586NW++UxVjcuShLUkK
+WMKwvgb5ppQ8hc8ytCKBWaO/aTHIIAiNpjJKLTaSuAlzF0t3C7dQSnJpNr2qlJS3D0mPiXNgNuow
+IBo40sJUFQvQpN4nv428AOXj4f+ysxE2D2XlJS+KBNOiULlZHSeabLLPMGnHWVX01aLAo+BTlUJN
+PK8wgsX8psVYTuYQ/b/EBqqCikoun1g7msoowjSGJvNaEdFUXmZGn+gUjRHch/Jz1n1gCz4K0DsT
++cnHCc0e36gqWeIp20M2yFcWJdBDhyafnuTN1bFlVscZLFAStGyfcdaCF0UWPO/JLkAJuQyfP584
+snzZVJn/3skw5CbUG+Hlah248cLLvsliCXvvQO0/sWns3ds/q3+L1r3iWfAb9BtWp2M9OtZ5P9y7
+pSrw9d/uYR35fa6R/0xXpe+/sfdv+PnD6e/tX9T33P/Z3m3kGCAWBHXl3k2oAidmAeAb2H+TK7wv
+xSpv/YT39j6D70AVPLIDROaF/TfUPYGN4FzGBaBq4Pd/jqwjwOcA94R73OIac+BGOBfv8QDr0C/F
+XkE2if1L/AY7e/8D7g416vAOe7vxm8BRoXoJWQN2sBr9AXJKvJu9H1TqX468DOeQC+KT/QvwtMRy
+AFdyZf9NroyHKvsH2J4Hew/1E/Z2sp7Ywf/fwdp3/l782+d77+DV95HV4CZXo71ycOPg48evxZ9e
+lwohrOL6FCu5uJ4qVbfh367FOrZbWHf0/uOfUkWTVG5xjdi9g08Prh28m1VxUT3VjYMdVZ10TV+J
+rbl28D497+A9qqbiuieo8fvg8etcTfXe4/NYM0V3+fTgM6ju4nc4BzVgj1/nerobWI32+sH1rJJN
+2gIVbNKW9w4+gqu4vusaVqzJE97LfruB7/v6wUf02+PzqVZLasD4eR9BKw7u0j2xJ288vsg9CG28
+ANVc+BvVo3148LuDewfXuYbtp48vxXe4Hp9w6/Grqp7tvYOP1d+u6Xd4fBEq5+LbXjv4jOoBQRSt
+qo8ToKZejkKYdBKJ0gsf+O08qqskhZEOlORXpAgGtjvY0DhXCjmXHyfBvM7f9KseHI0Gg4/M7Bl6
+tXNwSuYB4ywzt9Rt5Vt5ntwJKRC25iCtl4V0Wwgi6Y0aAzdsm5h6Aj/fzUn8MCYrdeImSGSHW8XR
+Dcp7t42y9uLh0gObCl2UVn74ya34VxVGQOc5C22jL6r8taPLmauamf7t7tE8C7ea5XTS+/aq6mU/
+EUFc+gn01Y/Idj7+xLLWEAE6UNYx51st5skOOGaVd5ShvlotnTzedKaTj4pKv8Gd2xm2oJ3qNvCv
+SUsQf+Ukqswr8thiWLLdzaKGufXVOVWSMwX06v0wL9laQ+laFvCWB2nFBxCMyK01G8yWK4okW2A0
+i/vhPJjBvcJi2C2l2i4mDUd/oxJ641dZMZaNvVi1kjk8x/NUVcfkqXhJBrD18m3UvI+UvHmI82uc
+eQ2Ned7WzTgRzVEzUtqiB+9Nz5cgEE7kpOXdNtlaSXAzmvHg1Cab8rkaVm91xpxcH9W9dSVcDBfn
+fi/iu9TiISDLOkLNbLP+k6Nz2tKisZbXDRHHSrfmaRYUQPNZ+LdGmF5cmoRfVlCPUV3MYYw5uaRh
+wvuRtByZw1l1ZRm9WhWwSmkKfK7yexQlIFyfPBcKhJksqYJxo9R/Ul3Fnk8C9CJiMt/NultR0xqn
+ClKh/rBnaVteURreyugXb1a4f1kSmdX65fVxcBKRu4omEaelWmVEayayL6Yq8zybyIOPkjtghCUX
+Q046DGm0b2fLvkZQYRymKOxG3CixJjJFX6N2Jf2VRGcYMbs6VjryNIKwnOlBiHZeI3V62hqwVak0
+IK5jAjHYvO70O/nGSCTw0S9UYTJxZVWc6cWSpBb7Bgevm29BGuQII9hUG8/X1YZds8KWzBrMqgZF
+oq+q7AMmCNHKVUO7VrrG91wJ8Z8XIF7acyHlnelBpzAvFUclbVW4FarC2E1bsx+7KayTOZ6FuUBj
+vD+rofZlng4jvs4Ep9PFLoLGTlnwbBM93BoVXS1lFecqbTKYE2G8JKwymqFsSRz3wWiQsRwxVNO5
+bHIYDiwNpblDzIdmjjgRj0aVQRIzTEcqiR9q8JcLKY8HPq5Duo8bSNNBBE2RBeiyMHJ8pPimbvNl
+V99gZpir54heQ7GLXBGOlF2hARFel6vnhLloF0g8iMaEGX5+Ixwpj5AM5EOmzeG/fsosTMz6olsV
+WWV+JcRKkVREuLaunkvXM4fS7S+uvoZf+UhYaB4Jscw9xS1z44urF4BjBL7yDt75t0h7sq1Yp94S
+qpwrfGe+1dvCmUNMLL9Ut/09MsP8TNhUrkszIj8MMSC9L3/9ICMdYuYTYf6JRGFxULgnt5nWiSm5
+zuGfPhDKl4/l6+eF4+V9xYEjlFDwoP8praLnEsfLXWn8W/z07deE12gb/0S0V+fxia/inYkx7A0h
+dLohJFrv4c+/FT4iTf9CbEW/FwaYy9gzdOdfynMv4jy8jO18CP9eRTovZhnaZQod+Hlb+vBNYaai
+N/pQ5s+voTFXLwsb2Pt4q10ZxPd5YvMr3JP3lZ7kyXYZvxKZr64J2dEjoVHaka7blvd9H6mE3pbv
+Xpf7fCpjRHMj8si9L5N2WyiJ3hIWoBuK5eyuTGZ6xFlZQZF+KrLobMtqOie7wSU1Cg+5Scwv9C5T
+D109J+3clun3liy9K7hwbiQ+uqtvyA3fxn6O+8n5tEVwZ1KTfsMfRl4vvjNx+9yWi2XGck9G0jDp
+OrjsCn8F/kSUTcLldfVVaeFNIe9irrM6hG9ojY2FJs/y4Mfqd9tEkDCm1XODu9WZgGGYrK6s8N0J
+r7jhmtHFoS/xVou9wvdeTpktk58nCxMmbUvuH/1qm7vsR5cnckOAXu67HtfIHzcTtmrCJ6E5dFz4
+2DkUa54kXDKUa5cueCu6jmJVd+ABqJEGFAFuhRB4yVuY8PxaAMfQemOR1oXbb/t9hM99F30PV0f8
+MBXEmjAxAEsTsQLmQC7XTBiHxg3buQl5rEHi8JX2DyFz/3K7i5w0JiEvFdq7cINmuTURMZg3eTbf
+oomdNLsmnPVOfD5nGJcnOoBwCslURayOLs80OeJJOT9Ul6l0uMAKEldTfM3o4yL/Q7vbnvCoWsZk
+aVW48bH/VRV2cL7pKTewo6KZo4KuSKFinhz/M6mCM189EAf9uFkfDVd/DP33400bGu0aqh/xJcyE
+H5JGAnEeQfOCwxdeoKxTlLTResNUg0PLh+3E44zUiAZkVjIC98ReKCuyFdtdclUSVlKXOOJ4mxwP
+ms+uJ57g9bEQNpwFwQ8q0nS1xIeUH42kI3BPra0HWqw9mME9csPbucO/8AwW0GKG2YaqnJ9YDofC
+k+PTdg0y/nPh6D8+8d8nTNvO4sQHaSZQgOwINuvIEfYbjqj8GHYgB4ViEDDh++jfpQ30PYzp0A8T
+PkjD4Yw4f1cn9pfIMU6i1kf/eOWP9/94/493//jgj/f++NkfP9i/CGzAezc5fo65BmTQvYnR8V1k
+OQauY4yw77+59wvMVEAkH/l+83wHc/7epb/it3+NDMHAJwzx9/N7/45ZhB38K2UsiFEZOZ/x//n1
+OgLPfMrMgbu7/8r+G5wd2OHsxA5nBR5iC97cv8g8vMQaDTmYR8g3fIHYbPd/Ju+3/3N+vweYL0Gm
+7L2dvZ28P/DqB9hW6p+diezIzRTdV5x4zEum4tqvQKT74NpkxP/xBRW/vqUyCReAqe3gOn//zsE9
+4Myj+x18CCxl+ITETUbfej1y9b16cEP9HZ+PfHs3JI+Q8ZxdPbhDEfrHr0+0MXHe8fPqarVqtFhL
+zOVTLrfdTTWUTFjWmTyjcI9DkBvvdJOLqhOJoQAtU/WqArl+1NoPbTMRUJxcSB2tFILF3xQxQNAL
+/UggeMTqlBxZ40iueKPtie0tYedk/TZbrmy8Sxs+qs5XJS5hXMyi7mmeBknJ2tV2MWNuONzKIi1Q
+q9zJM6Nw6kjMmbswM1sAEh+/wgPTFnmc+H8dRKKtR8ZFUL05V9K6M61J64P30gQHpCGL1sxK+0Tt
+bTFvvuOKTax3ncdx0VmQFMLgL6cidvT8XRDMf2cJoTHdTmahgerTBDa5GlB0M9YATSUqjnQYDb3S
+HjWDhf/c7rYSSjMCEjedLVRYsnEFj6YJDqPjk6edmTSHEOgjcT24R1ZOBU0HBMeAJJfoGMgrbxCQ
+oIrGVd0q4u4G/ky7OzG0h1vNeu3cQt8PSQPHFhQLhjeDAxWQjANDcdSTtq4KX9oAqvLOvOyLauia
+GqgEJ0xHwhr1mjhjIh+SBOGeX68whGVOsGKuKHjE1+ecQBHf5UlXvmSHntjdXi58WVeFWwATC3pt
+zQ4d7x+pkseX5gckAMUKWqKhZMQ4eL6uEIT17VHjylWoGNZEOAKYRVQOsiMh4kVRgREsCtEpWk2V
+md0kNyIuR4qPS0xNIJgCDknWfdz6MKYYlqQ0LfYHy0qBjYXRx5CXQgEyiCzUFMZECokRlOVBqYTv
+hYz5C24qYG8Sb7JrFa/jw7KQD0+aBS3Ajxkt9GzL/rzRO0JGCwVP+Z7v1RUU8pofYCYzpBoI3gsT
+DI3ngTJmaGvL+MOwPypc8yiShMHzxomxxESVQkyhoEy5C7FYO9tDqJiIIflQFbEqBi7Mir8loPlc
+0U9kJ1zlmrTPfRkSHsUgewDV2sSbaglsrIceIibPN7GsQAvxspLO2kgUNgm0GItWlnoBsnFJH5od
+mhOTm3T2BTgVexUU5vddnNrNVrXQrPs6CjM+NWI5PB9IlbAvH8Dtal/180IGQx2UO1bMj8d5u2Kc
+kRLy2k/Mk3DswinnarV/ZPx18BWaPRQvx67OODchbxEdE7saqmLUuOX25CYcg9zHJR6+rLxScpSW
+X7Jnlgq/GjiesHRsMaWopNqIBkPFuZd4WWvzB00Q2OUXkIhkpf187Tdtb2yeRxQxHH9urv31b51U
+ZX5HptrcfU7pvqGI5rzyBMlW+mYngSflLFWkG4Oiss3x0peuQTyaLMKptd+aMsjCdAdp64m4c8zU
+zEvoTFF4bJtJQ6095VEqXjN+PDTrPy0VVRUcV0/21QSlCdlZmrQKkM2uGCsyDtFJTHVYy1NGoE6U
+E94tIzfE66uydrYPElmNo2q0PBUE53C0L6KparKECvm6SDlGEqrosXf+5qnnTp7+359/2shQaNIe
++rfdnYxJZQOMUZUc7wcPW5kyNHzJJ1NtoPRjVLuJzcUsdfOqJxjX79h609Z9HdFJRhW1aolMZV+m
+YMBzZwZV9iXDVvnLboxYgbbp8VuomaY8Ba7PHCDTRKFqiPUMJ/PcTJnteU0GfNSZOmgWp07vvJYC
+vhUKmCeFSq77ko/AyPrRuMLllY3CHJXqTuRQI+mwQcNEhcJIZbQWaDIvdIUbFdBlSxQMxl6OFqUa
+QX0VjLs1kzGm01NtnvJrmoQvhkCUq6uaSXzcmV4xCkggkRVfYvRFq7ORt9cbFVQiHLlvTp46weGm
+Z2yvWa2qlyM2IDFllDRzbMzdSt112pSx7NUNVfU+Mm01BcTPeqQYS8nvYmwkfDQvY8HawgqrTrtA
+YMT/c02jVCO7U1vkidJMpNoV2Umk+wQHZlQnk+BkVdKUbhw7wzBb1lxZjQKX4oXn66rnHMznlBDX
+qOrIH+RdUAADAgkMU3WOzoQadAhs/yWLmJo4xzDulQWE+z4EJiCKrKeKE5aYbnyfNtqYmF8nYlJh
+KMd6RkZKxHDrUxB5RBtKarEjLbChClWhNdKVSlJFmhiJyYjUvAm1C420L4FP+O5UgNVD2hCawGXj
+a6T4DcFFnfDMKYjLKkYbwbqB7qwI8+N7CQZj7F87B5OnlJm1W7buhwkuhK2tRcY7KYTKkxXsvEAI
+w+x5YaKGrO6tK0ggz8yJWngwzKmGEsa3asqqcUHUNoWCqecy3nf0E/LqXyLunvQz47M90G8tbqxv
+TGDtjC+nNtpWYscU6iNrQlNXuEWwr5tHaUHWMceYw7eECCrZ8ad1hQO+u8Dr2vTw9tHlROPjiV/W
+ZVA77GI8VI2miOMyLQzJELfaE8tmIhmV85PgPMQTFn29pTMLEBFWD+FxNFPuvP4rRdFi+iH+QHsm
+tmfDI6fL3r/uv753H7DL+2c5lntr6pNtUgFDJPkrEN9lZbfPJlTYRNWOY5z5J/tvclT1Diu8QVwV
+4rqP8HeODO/dwm8+IlQ54qk/x58oyntz7z5HY+8iqpxjzPCJ4Lr56RRRvo+6fqRrRk8H3Pdn/BYT
+7YGYMtyTldsgzvtzRJ4Dzv6TvV2O9YKy3S2OJj9A9PcD1Gjb2bsf3xSuIRy+fPIZvQWrtMHT/2Xv
+PqP94U6f7JNi1GUBDNyUhOyrmFE9h7np25jtpfxyBDAo3TGWcLooX3lf0rX3JL1+WbLVlNJ9V2ld
+va+QAJTv1gpcgo64ejZ9C/LOv8Gf32YUB2sJEeLiNyr5e16l3a9jG84p9Z+H6r0iWia+xa7c51/x
+K7dF++y6gENuCq7gLN7/JufNGUtAuBqSxflQICKfy2u+il/R36KeUf3MfXhDvqJUeBLG4LLk0zW4
+4pFCbtyQTn5NBuKewueoPkwAkogl0FCQhzL0gkZIaJy3EoQg07w7p1AonyrkzHvSY49kdO4KKEKB
+izKkx2XRwLqBPzyCmzCC4gPV2vOipkfokd/k0JTbcvE1/OL59KbpWTdkRK7gtz5igAqL8dEEuyZ9
+8pEocFHXbYvMlmi68dM/ymYv69m9ij15FoErBGN4Q0BBJHJH7fml6v9XpHmXZD7Hfv6AcSkJVvS5
+zN7rsnyuiwRVnGOCukmf0DBdV/28k+5D4BBeF9dlxUXhrevYwo+VGBwqBsKvH8X7TOeRstqUVJFy
+4fFFqG+AbFCsahA1I7jm3sHHBx9n6jzpE9FpmswP/Y7rRF7FDNUnmKWiO0NNy/XH5+PTL4rGkfrk
+Dma7fiqfHHyA7ToH1Rys43Nt8lkH1zhfhT8ph1JCMFvfWqzqtaVjf/u3f7t0Bkl8wEeYDAqh2wt/
+XTYxFTB5UVdYm5Q+R9uOkMsGWb9X2tVg0NaUdkzjt9xUprBQicCg/9WxmQooma9N1IeAGahTVpzD
+iVbW19qzBHz4y1rQgz9qdabyMUtLTz/bNvqBW67sVX334vdPnYQS0xKtJX7gS3bT0i2OTyaeUWN8
+IqcSejGqY2I8TtNZ8WPNtHU37Y9nBhk/eHpoDVihExEtSVRgv1MgamnG2E4HnrAncJApKfR33dbW
+VBrk7yZSbxhbwVx/di8z5bEen463tDBamKl7NJ3pkJSZHSuZALOrwIx8OZ/f+FKZhASxOmQF58gX
+ZnIKgxSyzwL+uhxbOnqyTRCuziHL8GVFviRT1EzlEo/FOhP4FxbC2iroeXNQ4GRVrtUuTKeT/VBA
+VcqFOdGzfTekuAQhR4KKJAmqR3uV3PzFNdewE/Hk+FR/zvePEpPcSwLMBpf46SKn9jq6bDrTYztt
+oreycaYYWXc6YixYZZeqXBgmjsIXzIGhNR04dZ1lwOnf6Z3DZ2oQFFac4AojuoHGFhxDjJxQ5gfg
+/ZdrDRArPrV4cnF63i8QD4NwQUBgY15Lk3FHK6+wr8qtJ8qGVMJMQp1cIWaLFH800wHIoQ+5PFs1
+iDxV82adncIwHd08WRWj4aq36mbuzIYVUhQuThkFUidQ/caBwCz7C+GaPGhnBwNfKIAgRlzqWIHO
+yyoF7xRD3FQIXAIrcN0puEXZ11BH+sGkLFz0trVkAC/u5yR3ueVWg0e1FYicQpa4SUWLdNq5vsLt
+Ac5I4oox2yokCJHl4hmO7KomM5RJKwAxK7yeJs9WjRov7MKsxMnivtOzG1YESRILmg7cZlOubISJ
+ZzCqcZ6qfSz1F6kYQH2HyFmkrRRCYONqVK652qwSsdn0AHXy1NA/LLx4YmFjfRwI7RBFagAHWPsG
+tjCZfTE21YM/9ABtNyevkZKjuA1gxyNuhN4xMCtHjHM90c4tGlDl0eEfCkTWjl43GFgmmM7Aceyt
++6Jfu5Lm0de/9fTXv3WS59jXv/V0++jR5cmIFJyQGUkhfw7T6ocTdY+T+NqyKt3EJo3Qy+k82z8s
+lG7LPGUbN3cUNu3TfujmjprpEEw7O1bYskgWUrJ5JgpyUZUuvoMyjZCqVlYXPa+R646oPshLZ+k2
+CY6jsngzTCaO0Ov9qREDST+ZqVlzU8RCUP80cgxbOK7HPPVOxtJIHAGtdycbTwa+5Qfl3B3Uikmc
+M9NWrNV2iPiXiIDQAkfcvnS/hLbICmvluhkn9vTx+U/tzgyDRh1H0UbN8hd8NfXyxEj9b90OvKie
+wsIop2cgGnUTldiTeGvol7aZziS3Z5ybM6y3No1lk41lmGH5HW5Nt687a+LzZiCCdKA/tjzj0EiM
+YUkwJSpXiTqJBzEDlm3QGGzC/GcAq4nMZwf+zdQkOC9G75OzH6ult1n5/twTR5fbGeN4LHWedtVS
+ziFt3d0ZJneOP6SfJirUEVxCGCjUHCXOO3hfxWwli6EzHWTucVYbTo7CD7B75zMdRAGjTG9hJnFQ
+wnVkHvT9RkHEopHBJiRen/S+ODEm8G9mRh9w8jqIjQNtmeF4+kztSplumVwXcXxNeXQkpYbZ7viT
+lm4SVYp/GvlmMrMytPXLrp/Prb6IRfD+FUFnIojEfRq1etR1nNfK3KOJAmmEEuCYbgKOCGiQ3Vrt
+XCDLbgPynZHpai46yMmHUH0VM6KJBTvZRzOGqGtmOJCIwSFQAgKHoG24/ibgEDgjURYo4SuVOhXa
+K1s+uA1XYzYdlyH3Fe4vEw/PCy9pXZJ+mMOcou/T+/ELwS8x6xizauqAgAGphr6nDa9MLpFHYDBj
+f0E8JstCMT4IyfRHa6M/3AhmjnLro6PCh7P/q70H++cw23Fz/8L0Z3vvYNbgHHIEUW7k3mTughDm
+ew/2f4pZgM8ln7J/IWZBUg7hAXEGSfYBuHWmv8tY+cnP3qEsyv4FxLDvRow+5UN2IivP9P1+Tu2F
+Fieunb1PmB3oJn6GfDjCb4Ixvw9Qef0Vjq99wpHCCwo7fp4+O/gQPsvPqBdPPwN7SSDrqxo1qhYm
+L91lOdZs15HZBL+ZFXME55ps9lPbDlhUE5Esig6FWXZWe9ZhoDDiaUuK1khLmSO43CaXX2eWkSJ/
+zCycVBUiTGCubaZsRrixqUqsYMtOuTmxbJVh+xNFGCqWTts8j6b7kaD9KmNmGeSThjZa81PHNJWk
+ZEcX/W9yb1dXTvz7NeD9hOX4o6EvF7hsqzXLxiXSmm5OXkOH10Q4Qi5t6WsnTIpW528WFv4xJ/A4
+4WtoSbc1o0dmWaZbX+y+88Xu7S92fwf/3vvpF7u/+mL3t19euPrlhX/58sI7X1741ZcX3vvywm++
+vPDrrz587y+//0UsOb937i+//8VRyYvdlOQFZRnexbj9a5wC40SPZG244vKypNtiVelEBiemJ2JS
+IFah3pAi0F3OQl49m+7AX5eST64sjsWzv1Lpm4mE4BtSBP1Iclj/Ls27KPWkN1WSMVYNx3zldSn4
+1Ymbc1Ol2Ve4wpSTYjqfNVGmvS3FvJe5aJ375Do0e/uR/OmGJMiuyJWPJOX3tvo69c95bLP+8IZU
+7+ZV8NzamyoLua0yRHHc6VvvyRc/kl699MX2u5lwafSCp9GEgD9QZoQqS5ua9vA/9k4mLQggW1GP
+kfob3LAm/ctZp367O/E7L5cZcfkzw0JdmzazCXop2T3TDqmaYMyKmWFDzbKHl7qTIQ3yahZn79zK
+40yLXm+kaXdtd2dtnxNuKFeDqt1duXgLS0s/+NbJpaWnTj9l/uE7p7/3XXNs8QmTO10SkZlweJgq
+a8mK2A26bnUFzw6t7PSUr6RIxt/bTfsCd/qsfgxPrs3y/8xEZ7FHi085MjGaR6ZjUAggyu/A42pm
+HlkrR74xIwPVmXlktiDRM28aYO6EV5/H1Juq+lLlaHkducyzmVv/7AWQH2fRQJgVKWp3be3tAg3B
+SrupR67d/b/udvBvZnIdFCtPLFMdujKPfqQcf5zaxw0wI82cqOuHDs2Kp9mmqttmsiXdmcf/zLeY
+fa66md2wUs98nFuZZaxoa7xfmSdrG3xxVHEt7u7dFQsardm7aCN/mvA7Ge/kbanGxNrLO8QeuX/2
+/+8Oe7t4hzf37jJ65z7Vnua7p3n+xSe/e+qkaZfNwukJIgMYvumYykn4O0RjGHam86u0E58ZFmXQ
+fT97Ozj91OmnaO88ttBkN1Sm7env8yVLMyzkjebIsq6AVEcLhoqaZ6u+W6S6lScR/jpz/yjtS2F6
+zvZqH93Zo4uIh+fJO23D4hdwDk+fNdNRR/zzrEjXUldVU2dGfWf2aTEdtcJGqTNEU+7NwATgKpm9
+U05HR7CT7eJMZ2Vmtw7d+uzXZIWoydmi5wlOk/+0+MQxczo//WPC8a88s/NXhqdtZp9gM14e054r
+M/uwmTtiZp8Lf6W/2v3ZRkwAZHAxAw4x/f60NjqzzZNW66/8AdfS4nRPT61lXvkTZkQ8HP/KELb+
+Snd2Zu3T8F5/pdu6iM58U+1QvHsRBlNq59nrT8jHXUZRxjp0QF/iXvkIEY3xPoI12xVzNVrrVwTt
+9SsFK9vNMW5vKFYYsu6vKeBSROQ9UgQ/j/ieM8x5clyuIMUU4bZuClbr7Vl+xo3cE7osJE8PEfm1
+m1v3jB37/wYA
+`
@@ -0,0 +1,827 @@
+package middleware
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// The decompressionReaderZstandard function creates a pure Go zstd decoder,
+// refer RFC 8878.
+//
+// Supports concatenated frames and skippable frames,
+// the dictionary is not supported and the window is limited to 128MB.
+func decompressionReaderZstandard(r io.Reader) (io.Reader, error) {
+	reader := &zstdReader{reader: bufio.NewReader(r)}
+	err := reader.readFrame(true)
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+const (
+	zstdBlockRLE         = 1
+	zstdModeRepeat       = 3
+	zstdWindowMaxSize    = 1 << 27
+	zstdSkippableMagic   = 0x184D2A50
+	zstdSkippableMask    = 0xFFFFFFF0
+	zstdHuffmanMaxWeight = 255
+)
+
+// zstdSequenceTables defines the predefined table, the max symbol and
+// the max accuracy log of literals length, offset and match length.
+var zstdSequenceTables = [3]struct {
+	table     *fseTable
+	maxSymbol int
+	maxLog    uint
+}{
+	{zstdLiteralsLengthTable, 35, 9},
+	{zstdOffsetTable, 31, 8},
+	{zstdMatchLengthTable, 52, 9},
+}
+
+// zstdReader defines the streaming zstd frame reader,
+// buf saves the window history of current frame and the unread data
+// after pos.
+type zstdReader struct {
+	reader   *bufio.Reader
+	buf      []byte
+	pos      int
+	window   int
+	last     bool
+	checksum bool
+	content  int64
+	size     int64
+	hash     compressXXHash64
+	block    []byte
+	literals []byte
+	huffman  zstdHuffman
+	tables   [3]zstdDecodeTable
+	repeats  [3]int
+	err      error
+}
+
+// zstdHuffman defines the literals huffman decoding table,
+// the entry is symbol<<8 | nbBits.
+type zstdHuffman struct {
+	table   []uint16
+	maxBits uint
+}
+
+// zstdDecodeTable defines the FSE decoding table.
+type zstdDecodeTable struct {
+	table    []zstdDecodeEntry
+	tableLog uint
+}
+
+type zstdDecodeEntry struct {
+	symbol uint8
+	nbBits uint8
+	base   uint16
+}
+
+// zstdBitReader defines the backward bitstream reader,
+// pos is the number of unread bits.
+type zstdBitReader struct {
+	data []byte
+	pos  int
+}
+
+func zstdError(reason string) error {
+	return fmt.Errorf(ErrDecompressDataInvalid, CompressionNameZstandard, reason)
+}
+
+// The decompressReadError function converts [io.EOF] in the middle of
+// compressed data to [io.ErrUnexpectedEOF].
+func decompressReadError(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	for r.pos == len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.last {
+			r.err = r.readFrameEnd()
+			if r.err == nil {
+				r.err = r.readFrame(false)
+			}
+		} else {
+			r.err = r.readBlock()
+		}
+	}
+	n := copy(p, r.buf[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// The readFrame method reads the frame header and skips skippable frames,
+// returns [io.EOF] if there are no more frames.
+func (r *zstdReader) readFrame(first bool) error {
+	var head [4]byte
+	for {
+		n, err := io.ReadFull(r.reader, head[:4])
+		if n == 0 && err == io.EOF && !first {
+			return io.EOF
+		}
+		if err != nil {
+			return decompressReadError(err)
+		}
+		magic := binary.LittleEndian.Uint32(head[:])
+		if magic&zstdSkippableMask != zstdSkippableMagic {
+			if magic != zstdMagicNumber {
+				return zstdError("unknown magic number")
+			}
+			break
+		}
+		_, err = io.ReadFull(r.reader, head[:4])
+		if err == nil {
+			_, err = r.reader.Discard(int(binary.LittleEndian.Uint32(head[:])))
+		}
+		if err != nil {
+			return decompressReadError(err)
+		}
+		first = false
+	}
+
+	desc, err := r.reader.ReadByte()
+	if err != nil {
+		return decompressReadError(err)
+	}
+	if desc&0x08 != 0 {
+		return zstdError("reserved bit is set")
+	}
+	single := desc&0x20 != 0
+	size := [4]int{0, 2, 4, 8}[desc>>6]
+	if single && size == 0 {
+		size = 1
+	}
+	dict := [4]int{0, 1, 2, 4}[desc&3]
+	n := 0
+	if !single {
+		n = 1
+	}
+	var header [13]byte
+	_, err = io.ReadFull(r.reader, header[:n+dict+size])
+	if err != nil {
+		return decompressReadError(err)
+	}
+
+	window := 0
+	if !single {
+		base := 1 << (10 + header[0]>>3)
+		window = base + base>>3*int(header[0]&7)
+	}
+	for _, b := range header[n : n+dict] {
+		if b != 0 {
+			return zstdError("dictionary is not supported")
+		}
+	}
+	r.content = -1
+	if size > 0 {
+		var content [8]byte
+		copy(content[:], header[n+dict:n+dict+size])
+		r.content = int64(binary.LittleEndian.Uint64(content[:]))
+		if size == 2 {
+			r.content += 256
+		}
+		if single {
+			window = zstdWindowMaxSize + 1
+			if r.content >= 0 && r.content <= zstdWindowMaxSize {
+				window = int(r.content)
+			}
+		}
+	}
+	if window > zstdWindowMaxSize {
+		return zstdError("window size is too large")
+	}
+
+	r.window = window
+	r.last = false
+	r.checksum = desc&0x04 != 0
+	r.size = 0
+	r.hash.reset()
+	r.buf = r.buf[:0]
+	r.pos = 0
+	r.huffman.table = r.huffman.table[:0]
+	for i := range r.tables {
+		r.tables[i].table = r.tables[i].table[:0]
+	}
+	r.repeats = [3]int{1, 4, 8}
+	return nil
+}
+
+func (r *zstdReader) readFrameEnd() error {
+	if r.content >= 0 && r.size != r.content {
+		return zstdError("frame content size mismatch")
+	}
+	if r.checksum {
+		var sum [4]byte
+		_, err := io.ReadFull(r.reader, sum[:])
+		if err != nil {
+			return decompressReadError(err)
+		}
+		if binary.LittleEndian.Uint32(sum[:]) != uint32(r.hash.Sum64()) {
+			return zstdError("content checksum mismatch")
+		}
+	}
+	return nil
+}
+
+func (r *zstdReader) readBlock() error {
+	// keep the window history
+	if len(r.buf) > r.window+zstdBlockSize {
+		r.buf = r.buf[:copy(r.buf, r.buf[len(r.buf)-r.window:])]
+		r.pos = len(r.buf)
+	}
+
+	var head [3]byte
+	_, err := io.ReadFull(r.reader, head[:])
+	if err != nil {
+		return decompressReadError(err)
+	}
+	h := int(head[0]) | int(head[1])<<8 | int(head[2])<<16
+	size := h >> 3
+	maxSize := zstdBlockSize
+	if r.window < maxSize {
+		maxSize = r.window
+	}
+	if size > maxSize {
+		return zstdError("block size is too large")
+	}
+	r.last = h&1 != 0
+
+	start := len(r.buf)
+	switch h >> 1 & 3 {
+	case zstdBlockRaw:
+		r.buf = decompressGrow(r.buf, size)
+		_, err = io.ReadFull(r.reader, r.buf[start:])
+	case zstdBlockRLE:
+		var b byte
+		b, err = r.reader.ReadByte()
+		r.buf = decompressGrow(r.buf, size)
+		for i := start; i < len(r.buf); i++ {
+			r.buf[i] = b
+		}
+	case zstdBlockCompressed:
+		r.block = decompressGrow(r.block[:0], size)
+		_, err = io.ReadFull(r.reader, r.block)
+		if err == nil {
+			err = r.decodeBlock(r.block, maxSize)
+		}
+	default:
+		return zstdError("reserved block type")
+	}
+	if err != nil {
+		return decompressReadError(err)
+	}
+
+	if r.checksum {
+		r.hash.Write(r.buf[start:])
+	}
+	r.size += int64(len(r.buf) - start)
+	if r.content >= 0 && r.size > r.content {
+		return zstdError("frame content size mismatch")
+	}
+	return nil
+}
+
+func decompressGrow(b []byte, n int) []byte {
+	if cap(b)-len(b) < n {
+		buf := make([]byte, len(b), len(b)+n+len(b)/2)
+		copy(buf, b)
+		b = buf
+	}
+	return b[:len(b)+n]
+}
+
+func (r *zstdReader) decodeBlock(data []byte, maxSize int) error {
+	n, err := r.decodeLiterals(data)
+	if err != nil {
+		return err
+	}
+	data = data[n:]
+	if len(data) == 0 {
+		return zstdError("missing sequences section")
+	}
+
+	nb, n := int(data[0]), 1
+	switch {
+	case nb < 128:
+	case nb < 255 && len(data) > 1:
+		nb, n = (nb-128)<<8+int(data[1]), 2
+	case nb == 255 && len(data) > 2:
+		nb, n = int(data[1])+int(data[2])<<8+0x7F00, 3
+	default:
+		return zstdError("invalid sequences header")
+	}
+	if nb == 0 {
+		if n != len(data) {
+			return zstdError("invalid sequences section")
+		}
+		if len(r.literals) > maxSize {
+			return zstdError("block content is too large")
+		}
+		r.buf = append(r.buf, r.literals...)
+		return nil
+	}
+	if n == len(data) {
+		return zstdError("missing symbol compression modes")
+	}
+	modes := data[n]
+	if modes&3 != 0 {
+		return zstdError("reserved symbol compression modes")
+	}
+	data = data[n+1:]
+	for i := range r.tables {
+		n, err = r.readTable(i, modes>>(6-2*i)&3, data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return r.decodeSequences(data, nb, maxSize)
+}
+
+func (r *zstdReader) decodeLiterals(data []byte) (int, error) {
+	if len(data) == 0 {
+		return 0, zstdError("missing literals section")
+	}
+	typ, format := data[0]&3, data[0]>>2&3
+	if typ == zstdLiteralsRaw || typ == zstdLiteralsRLE {
+		size, n := int(data[0]>>3), 1
+		switch {
+		case format == 1 && len(data) > 1:
+			size, n = int(data[0]>>4)|int(data[1])<<4, 2
+		case format == 3 && len(data) > 2:
+			size, n = int(data[0]>>4)|int(data[1])<<4|int(data[2])<<12, 3
+		case format == 1 || format == 3:
+			return 0, zstdError("invalid literals header")
+		}
+		if size > zstdBlockSize {
+			return 0, zstdError("literals size is too large")
+		}
+		if typ == zstdLiteralsRaw {
+			if n+size > len(data) {
+				return 0, zstdError("invalid literals size")
+			}
+			r.literals = append(r.literals[:0], data[n:n+size]...)
+			return n + size, nil
+		}
+		if n >= len(data) {
+			return 0, zstdError("invalid literals size")
+		}
+		r.literals = decompressGrow(r.literals[:0], size)
+		for i := range r.literals {
+			r.literals[i] = data[n]
+		}
+		return n + 1, nil
+	}
+
+	var head [8]byte
+	n := [4]int{3, 3, 4, 5}[format]
+	if n > len(data) {
+		return 0, zstdError("invalid literals header")
+	}
+	copy(head[:], data[:n])
+	h := binary.LittleEndian.Uint64(head[:]) >> 4
+	sizeBits := [4]uint{10, 10, 14, 18}[format]
+	size := int(h & (1<<sizeBits - 1))
+	compressed := int(h >> sizeBits & (1<<sizeBits - 1))
+	if size > zstdBlockSize || n+compressed > len(data) {
+		return 0, zstdError("invalid literals size")
+	}
+
+	src := data[n : n+compressed]
+	if typ == zstdLiteralsHuff {
+		m, err := r.huffman.read(src)
+		if err != nil {
+			return 0, err
+		}
+		src = src[m:]
+	} else if len(r.huffman.table) == 0 {
+		return 0, zstdError("missing huffman table")
+	}
+	streams := 4
+	if format == 0 {
+		streams = 1
+	}
+	r.literals = decompressGrow(r.literals[:0], size)
+	return n + compressed, r.huffman.decode(r.literals, src, streams)
+}
+
+// The read method reads the huffman tree description and builds the
+// decoding table, returns the number of bytes read.
+func (h *zstdHuffman) read(src []byte) (int, error) {
+	if len(src) == 0 {
+		return 0, zstdError("missing huffman tree description")
+	}
+	var weights [zstdHuffmanMaxWeight + 1]uint8
+	count, n := int(src[0]), 1
+	if count >= 128 {
+		count -= 127
+		n += (count + 1) / 2
+		if n > len(src) {
+			return 0, zstdError("invalid huffman weights")
+		}
+		for i := 0; i < count; i++ {
+			weights[i] = src[1+i/2] >> (4 - i%2*4) & 0xf
+		}
+	} else {
+		n += count
+		if n > len(src) {
+			return 0, zstdError("invalid huffman weights")
+		}
+		var err error
+		count, err = zstdDecodeWeights(src[1:n], weights[:zstdHuffmanMaxWeight])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	total := 0
+	for _, w := range weights[:count] {
+		if w > zstdHuffmanMaxBits {
+			return 0, zstdError("invalid huffman weights")
+		}
+		if w > 0 {
+			total += 1 << (w - 1)
+		}
+	}
+	maxBits := bits.Len(uint(total))
+	rest := 1<<maxBits - total
+	if total == 0 || maxBits > zstdHuffmanMaxBits || rest&(rest-1) != 0 {
+		return 0, zstdError("invalid huffman weights")
+	}
+	weights[count] = uint8(bits.Len(uint(rest)))
+	count++
+
+	var ranks [zstdHuffmanMaxBits + 1]int
+	for _, w := range weights[:count] {
+		ranks[w]++
+	}
+	next := 0
+	for w := 1; w <= maxBits; w++ {
+		ranks[w], next = next, next+ranks[w]<<(w-1)
+	}
+	h.maxBits = uint(maxBits)
+	h.table = h.table[:0]
+	for i := 1 << maxBits; i > 0; i-- {
+		h.table = append(h.table, 0)
+	}
+	for s, w := range weights[:count] {
+		if w == 0 {
+			continue
+		}
+		entry := uint16(s)<<8 | uint16(maxBits+1-int(w))
+		for i := 0; i < 1<<(w-1); i++ {
+			h.table[ranks[w]+i] = entry
+		}
+		ranks[w] += 1 << (w - 1)
+	}
+	return n, nil
+}
+
+// The zstdDecodeWeights function decodes FSE compressed huffman weights
+// using two interleaved states.
+func zstdDecodeWeights(src, weights []byte) (int, error) {
+	var t zstdDecodeTable
+	n, err := t.read(src, zstdHuffmanMaxWeight, zstdWeightsMaxLog)
+	if err != nil {
+		return 0, err
+	}
+	var br zstdBitReader
+	err = br.init(src[n:])
+	if err != nil {
+		return 0, err
+	}
+
+	states := [2]uint64{br.read(t.tableLog), br.read(t.tableLog)}
+	for count, i := 0, 0; count+1 < len(weights); i ^= 1 {
+		e := t.table[states[i]]
+		weights[count] = e.symbol
+		states[i] = uint64(e.base) + br.read(uint(e.nbBits))
+		if br.pos < 0 {
+			weights[count+1] = t.table[states[i^1]].symbol
+			return count + 2, nil
+		}
+		count++
+	}
+	return 0, zstdError("too many huffman weights")
+}
+
+func (h *zstdHuffman) decode(dst, src []byte, streams int) error {
+	if streams == 1 {
+		return h.decodeStream(dst, src)
+	}
+	if len(src) < 6 {
+		return zstdError("missing huffman jump table")
+	}
+	size := (len(dst) + 3) / 4
+	if size*3 > len(dst) {
+		return zstdError("invalid huffman streams")
+	}
+	jump, src := src[:6], src[6:]
+	for i := 0; i < 4; i++ {
+		n := len(src)
+		if i < 3 {
+			n = int(binary.LittleEndian.Uint16(jump[i*2:]))
+			if n > len(src) {
+				return zstdError("invalid huffman jump table")
+			}
+		}
+		out := dst
+		if i < 3 {
+			out = dst[:size]
+		}
+		err := h.decodeStream(out, src[:n])
+		if err != nil {
+			return err
+		}
+		dst, src = dst[len(out):], src[n:]
+	}
+	return nil
+}
+
+func (h *zstdHuffman) decodeStream(dst, src []byte) error {
+	var br zstdBitReader
+	err := br.init(src)
+	if err != nil {
+		return err
+	}
+	for i := range dst {
+		e := h.table[br.peek(h.maxBits)]
+		br.pos -= int(e & 0xff)
+		dst[i] = byte(e >> 8)
+	}
+	if br.pos != 0 {
+		return zstdError("invalid huffman stream")
+	}
+	return nil
+}
+
+func (r *zstdReader) readTable(i int, mode byte, src []byte) (int, error) {
+	kind := &zstdSequenceTables[i]
+	t := &r.tables[i]
+	switch mode {
+	case zstdModePredefined:
+		t.build(kind.table.norm, kind.table.tableLog)
+	case zstdModeRLE:
+		if len(src) == 0 || int(src[0]) > kind.maxSymbol {
+			return 0, zstdError("invalid rle symbol")
+		}
+		t.tableLog = 0
+		t.table = append(t.table[:0], zstdDecodeEntry{symbol: src[0]})
+		return 1, nil
+	case zstdModeCompressed:
+		return t.read(src, kind.maxSymbol, kind.maxLog)
+	case zstdModeRepeat:
+		if len(t.table) == 0 {
+			return 0, zstdError("missing repeat table")
+		}
+	}
+	return 0, nil
+}
+
+// The read method reads the FSE table description and builds the
+// decoding table, returns the number of bytes read.
+func (t *zstdDecodeTable) read(src []byte, maxSymbol int, maxLog uint) (int, error) {
+	if len(src) == 0 {
+		return 0, zstdError("missing FSE table description")
+	}
+	tableLog := uint(src[0]&0xf) + 5
+	if tableLog > maxLog {
+		return 0, zstdError("FSE accuracy log is too large")
+	}
+
+	pos := 4
+	peek := func(n int) int {
+		var v [4]byte
+		if i := pos >> 3; i < len(src) {
+			copy(v[:], src[i:])
+		}
+		return int(binary.LittleEndian.Uint32(v[:])>>(pos&7)) & (1<<n - 1)
+	}
+	norm := make([]int16, 0, maxSymbol+1)
+	remaining := 1<<tableLog + 1
+	threshold := 1 << tableLog
+	nbBits := int(tableLog) + 1
+	zero := false
+	for remaining > 1 && len(norm) <= maxSymbol {
+		if zero {
+			repeat := 0
+			for peek(16) == 0xffff && pos < len(src)*8 {
+				repeat += 24
+				pos += 16
+			}
+			for peek(2) == 3 {
+				repeat += 3
+				pos += 2
+			}
+			repeat += peek(2)
+			pos += 2
+			if len(norm)+repeat > maxSymbol {
+				return 0, zstdError("invalid FSE table description")
+			}
+			for ; repeat > 0; repeat-- {
+				norm = append(norm, 0)
+			}
+		}
+
+		max := 2*threshold - 1 - remaining
+		count := peek(nbBits)
+		if count&(threshold-1) < max {
+			count &= threshold - 1
+			pos += nbBits - 1
+		} else {
+			if count >= threshold {
+				count -= max
+			}
+			pos += nbBits
+		}
+		count--
+		if count < 0 {
+			remaining--
+		} else {
+			remaining -= count
+		}
+		norm = append(norm, int16(count))
+		zero = count == 0
+		if remaining < threshold {
+			if remaining <= 1 {
+				break
+			}
+			nbBits = bits.Len(uint(remaining))
+			threshold = 1 << (nbBits - 1)
+		}
+	}
+	if remaining != 1 || pos > len(src)*8 {
+		return 0, zstdError("invalid FSE table description")
+	}
+	t.build(norm, tableLog)
+	return (pos + 7) >> 3, nil
+}
+
+// The build method builds FSE decoding table from normalized counts.
+func (t *zstdDecodeTable) build(norm []int16, tableLog uint) {
+	size := 1 << tableLog
+	high := size - 1
+	t.tableLog = tableLog
+	t.table = t.table[:0]
+	for i := 0; i < size; i++ {
+		t.table = append(t.table, zstdDecodeEntry{})
+	}
+	next := make([]uint16, len(norm))
+	for s, count := range norm {
+		if count == -1 {
+			t.table[high].symbol = uint8(s)
+			high--
+			next[s] = 1
+		} else {
+			next[s] = uint16(count)
+		}
+	}
+
+	pos, step := 0, size>>1+size>>3+3
+	for s, count := range norm {
+		for i := 0; i < int(count); i++ {
+			t.table[pos].symbol = uint8(s)
+			pos = (pos + step) & (size - 1)
+			for pos > high {
+				pos = (pos + step) & (size - 1)
+			}
+		}
+	}
+
+	for i := range t.table {
+		e := &t.table[i]
+		x := next[e.symbol]
+		next[e.symbol]++
+		e.nbBits = uint8(tableLog + 1 - uint(bits.Len16(x)))
+		e.base = x<<e.nbBits - uint16(size)
+	}
+}
+
+// The decodeSequences method decodes sequences and executes them.
+func (r *zstdReader) decodeSequences(src []byte, nb, maxSize int) error {
+	var br zstdBitReader
+	err := br.init(src)
+	if err != nil {
+		return err
+	}
+	ll, of, ml := &r.tables[0], &r.tables[1], &r.tables[2]
+	llState := br.read(ll.tableLog)
+	ofState := br.read(of.tableLog)
+	mlState := br.read(ml.tableLog)
+
+	start := len(r.buf)
+	lits := r.literals
+	for i := 0; i < nb; i++ {
+		lle, ofe, mle := ll.table[llState], of.table[ofState], ml.table[mlState]
+		offset := 1<<ofe.symbol + int(br.read(uint(ofe.symbol)))
+		match := int(zstdMatchLengthBase[mle.symbol]) +
+			int(br.read(uint(zstdMatchLengthBits[mle.symbol])))
+		literal := int(zstdLiteralsLengthBase[lle.symbol]) +
+			int(br.read(uint(zstdLiteralsLengthBits[lle.symbol])))
+		if i < nb-1 {
+			llState = uint64(lle.base) + br.read(uint(lle.nbBits))
+			mlState = uint64(mle.base) + br.read(uint(mle.nbBits))
+			ofState = uint64(ofe.base) + br.read(uint(ofe.nbBits))
+		}
+
+		if offset > 3 {
+			offset -= 3
+			r.repeats = [3]int{offset, r.repeats[0], r.repeats[1]}
+		} else {
+			if literal == 0 {
+				offset++
+			}
+			switch offset {
+			case 1:
+				offset = r.repeats[0]
+			case 2:
+				offset = r.repeats[1]
+				r.repeats = [3]int{offset, r.repeats[0], r.repeats[2]}
+			default:
+				if offset == 3 {
+					offset = r.repeats[2]
+				} else {
+					offset = r.repeats[0] - 1
+				}
+				r.repeats = [3]int{offset, r.repeats[0], r.repeats[1]}
+			}
+		}
+
+		if literal > len(lits) {
+			return zstdError("literals length is too large")
+		}
+		r.buf = append(r.buf, lits[:literal]...)
+		lits = lits[literal:]
+		if offset <= 0 || offset > len(r.buf) || offset > r.window {
+			return zstdError("invalid match offset")
+		}
+		if len(r.buf)-start+match > maxSize {
+			return zstdError("block content is too large")
+		}
+		pos := len(r.buf) - offset
+		if offset >= match {
+			r.buf = append(r.buf, r.buf[pos:pos+match]...)
+		} else {
+			for j := 0; j < match; j++ {
+				r.buf = append(r.buf, r.buf[pos+j])
+			}
+		}
+	}
+	if br.pos != 0 {
+		return zstdError("invalid sequences bitstream")
+	}
+	if len(r.buf)-start+len(lits) > maxSize {
+		return zstdError("block content is too large")
+	}
+	r.buf = append(r.buf, lits...)
+	return nil
+}
+
+func (br *zstdBitReader) init(data []byte) error {
+	if len(data) == 0 || data[len(data)-1] == 0 {
+		return zstdError("invalid bitstream padding")
+	}
+	br.data = data
+	br.pos = len(data)*8 - 9 + bits.Len8(data[len(data)-1])
+	return nil
+}
+
+// The peek method returns the next n bits without consuming them,
+// the bits before the start of stream are zero.
+func (br *zstdBitReader) peek(n uint) uint64 {
+	start, shift := br.pos-int(n), uint(0)
+	if start < 0 {
+		if -start >= int(n) {
+			return 0
+		}
+		shift, n, start = uint(-start), n-uint(-start), 0
+	}
+	var v uint64
+	i := start >> 3
+	if i+8 <= len(br.data) {
+		v = binary.LittleEndian.Uint64(br.data[i:])
+	} else {
+		for j := len(br.data) - 1; j >= i; j-- {
+			v = v<<8 | uint64(br.data[j])
+		}
+	}
+	return (v >> uint(start&7) & (1<<n - 1)) << shift
+}
+
+func (br *zstdBitReader) read(n uint) uint64 {
+	v := br.peek(n)
+	br.pos -= int(n)
+	return v
+}
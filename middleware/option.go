@@ -53,21 +53,57 @@ func NewOptionRouter(router eudore.Router) Option {
 }
 
 // NewOptionCSRFCookie function creates a CSRF option setting read-write cookie.
+//
+// The Session uses the Domain, Path, Secure and SameSite of the cookie.
+//
+// middleware: [NewCSRFFunc] [NewSessionFunc].
 func NewOptionCSRFCookie(cookie http.Cookie) Option {
 	return func(data any) {
-		v, ok := data.(*csrf)
-		if ok {
+		switch v := data.(type) {
+		case *csrf:
 			v.Cookie = cookie
+		case *session:
+			v.Cookie.Domain = cookie.Domain
+			v.Cookie.Path = cookie.Path
+			v.Cookie.Secure = cookie.Secure
+			v.Cookie.SameSite = cookie.SameSite
+		}
+	}
+}
+
+// NewOptionCacheData function creates Cache option to modify the storage.
+//
+// Use after this option [NewOptionCacheCleanup].
+func NewOptionCacheData(storage CacheData) Option {
+	return func(data any) {
+		v, ok := data.(*cache)
+		if ok && storage != nil {
+			v.storage = storage
+		}
+	}
+}
+
+// NewOptionCacheControl function creates Cache option to set the default
+// [eudore.HeaderCacheControl] policy, such as "public, max-age=60".
+func NewOptionCacheControl(policy string) Option {
+	return func(data any) {
+		v, ok := data.(*cache)
+		if ok {
+			v.control = policy
 		}
 	}
 }
 
 // NewOptionCacheCleanup function creates Cache option to clean up expired data.
+//
+// Supported storage: [NewCacheDataMap] [NewCacheDataLRU] [NewCacheDataFile].
 func NewOptionCacheCleanup(ctx context.Context, t time.Duration) Option {
 	return func(data any) {
 		v, ok := data.(*cache)
 		if ok {
-			m, ok := v.storage.(*cacheMap)
+			m, ok := v.storage.(interface {
+				cleanupExpired(context.Context, time.Duration)
+			})
 			if ok {
 				go m.cleanupExpired(ctx, t)
 			}
@@ -75,6 +111,50 @@ func NewOptionCacheCleanup(ctx context.Context, t time.Duration) Option {
 	}
 }
 
+// NewOptionCompressionEncoder function creates [NewCompressionMixinsFunc]
+// option to add the compress name and [compressor] constructor,
+// such as [NewCompressionWriterBrotli] and [NewCompressionWriterZstandard].
+func NewOptionCompressionEncoder(name string, fn func() any) Option {
+	return func(data any) {
+		v, ok := data.(*compressConfig)
+		if ok && fn != nil {
+			if v.encoders == nil {
+				v.encoders = make(map[string]func() any)
+			}
+			v.encoders[name] = fn
+		}
+	}
+}
+
+// NewOptionCompressionLength function creates Compression option to set
+// the minimum body length to compress,
+// the default is [CompressionBufferLength].
+func NewOptionCompressionLength(length int) Option {
+	return func(data any) {
+		v, ok := data.(*compressConfig)
+		if ok && length > 0 {
+			v.length = length
+		}
+	}
+}
+
+// NewOptionCompressionMime function creates Compression option to set the
+// [eudore.HeaderContentType] allowlist of compression,
+// supports wildcard subtype such as "text/*".
+//
+// [DefaultCompressionDisableMime] is always disabled.
+func NewOptionCompressionMime(mimes ...string) Option {
+	return func(data any) {
+		v, ok := data.(*compressConfig)
+		if ok {
+			v.mimes = make(map[string]struct{}, len(mimes))
+			for _, mime := range mimes {
+				v.mimes[mime] = struct{}{}
+			}
+		}
+	}
+}
+
 // NewOptionCircuitBreakerConfig function creates options to modify Breaker
 // config.
 //
@@ -92,20 +172,57 @@ func NewOptionCircuitBreakerConfig(maxSuccesses, maxFailures int,
 	}
 }
 
-// NewOptionRateCleanup function creates Rate option to cleanup expired buckets.
-// And sets the less number of buckets to use when cleaning.
+// NewOptionRateCleanup function creates Rate option to cleanup expired state.
+// And sets the less number of state to use when cleaning.
+//
+// Use after this option [NewOptionRateStore].
+//
+// Supported storage: [NewRateStoreMap].
 func NewOptionRateCleanup(ctx context.Context, t time.Duration, less int,
 ) Option {
 	return func(data any) {
 		v, ok := data.(*rate)
 		if ok {
-			go v.cleanupVisitors(ctx, t, less)
+			m, ok := v.store.(interface {
+				cleanupExpired(context.Context, time.Duration, int)
+			})
+			if ok {
+				go m.cleanupExpired(ctx, t, less)
+			}
+		}
+	}
+}
+
+// NewOptionRateStore function creates Rate option to modify the storage,
+// use [NewRateStoreShared] to implement cluster rate limiting.
+func NewOptionRateStore(store RateStore) Option {
+	return func(data any) {
+		v, ok := data.(*rate)
+		if ok && store != nil {
+			v.store = store
+		}
+	}
+}
+
+// NewOptionRateAlgorithm function creates Rate option to modify the algorithm,
+// the default is [NewRateAlgorithmTokenBucket].
+//
+// Supported algorithm: [NewRateAlgorithmGCRA] [NewRateAlgorithmTokenBucket]
+// [NewRateAlgorithmSlidingWindow].
+func NewOptionRateAlgorithm(algorithm RateAlgorithm) Option {
+	return func(data any) {
+		v, ok := data.(*rate)
+		if ok && algorithm != nil {
+			v.algorithm = algorithm
 		}
 	}
 }
 
 // NewOptionRateState function creates options allow [NewRateRequestFunc] use
-// [http.Header] record current limit status.
+// [http.Header] record current limit status,
+// the headers are RateLimit-Limit RateLimit-Remaining RateLimit-Reset and
+// RateLimit-Policy, and X-Rate-Limit X-Rate-Remaining X-Rate-Reset
+// are retained for compatibility, X-Rate-Reset is the unix time.
 func NewOptionRateState() Option {
 	return func(data any) {
 		v, ok := data.(*rate)
@@ -115,6 +232,30 @@ func NewOptionRateState() Option {
 	}
 }
 
+// NewOptionSignatureComponents function creates [NewSignatureAuthFunc]
+// option to set the components that the signature must cover,
+// the default is [DefaultSignatureComponents].
+func NewOptionSignatureComponents(components ...string) Option {
+	return func(data any) {
+		v, ok := data.(*signature)
+		if ok {
+			v.Components = components
+		}
+	}
+}
+
+// NewOptionSignatureMaxAge function creates [NewSignatureAuthFunc] option to
+// set the max age of the signature created parameter,
+// the default is [DefaultSignatureMaxAge].
+func NewOptionSignatureMaxAge(age time.Duration) Option {
+	return func(data any) {
+		v, ok := data.(*signature)
+		if ok && age > 0 {
+			v.MaxAge = age
+		}
+	}
+}
+
 func applyOption(data any, options []Option) {
 	for i := range options {
 		options[i](data)
@@ -179,3 +320,51 @@ func (b *buffer) grow(n int) int {
 	b.buf = buf2[:l+n]
 	return l
 }
+
+// NewOptionSessionCookie function creates Session option to set the cookie,
+// the Value and MaxAge are ignored.
+func NewOptionSessionCookie(cookie http.Cookie) Option {
+	return func(data any) {
+		v, ok := data.(*session)
+		if ok {
+			cookie.Value = ""
+			cookie.MaxAge = 0
+			v.Cookie = cookie
+		}
+	}
+}
+
+// NewOptionSessionExpiry function creates Session option to set the idle
+// expiration time and the absolute expiration time,
+// the value less than or equal to 0 uses the default value.
+func NewOptionSessionExpiry(idle, age time.Duration) Option {
+	return func(data any) {
+		v, ok := data.(*session)
+		if ok {
+			if idle > 0 {
+				v.MaxIdle = idle
+			}
+			if age > 0 {
+				v.MaxAge = age
+			}
+		}
+	}
+}
+
+// NewOptionSessionCleanup function creates Session option to clean up
+// expired sessions.
+//
+// Supported storage: [NewSessionStoreMap] [NewSessionStoreFile].
+func NewOptionSessionCleanup(ctx context.Context, t time.Duration) Option {
+	return func(data any) {
+		v, ok := data.(*session)
+		if ok {
+			m, ok := v.Store.(interface {
+				cleanupExpired(context.Context, time.Duration)
+			})
+			if ok {
+				go m.cleanupExpired(ctx, t)
+			}
+		}
+	}
+}
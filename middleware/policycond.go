@@ -375,12 +375,12 @@ func stringSliceNotIn(strs []string, str string) bool {
 type conditionRate struct {
 	Speed int64
 	Max   int64
-	rate  rateBucket
+	rate  *rate
 }
 
 func (cond *conditionRate) Match(eudore.Context) bool {
-	_, _, ok := cond.rate.Allow()
-	return ok
+	result, _ := cond.rate.Take("", 1)
+	return result.Allow
 }
 
 func (cond *conditionRate) UnmarshalJSON(body []byte) error {
@@ -395,10 +395,7 @@ func (cond *conditionRate) UnmarshalJSON(body []byte) error {
 
 	cond.Speed = eudore.GetAnyDefault(rate.Speed, 1)
 	cond.Max = eudore.GetAnyDefault(rate.Max, 1)
-	cond.rate = rateBucket{
-		speed: int64(time.Second) / cond.Speed,
-		max:   int64(time.Second) / cond.Speed * cond.Max,
-	}
+	cond.rate = newRate(cond.Speed, cond.Max, nil)
 	return nil
 }
 
@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 	"io"
 	"strconv"
 	"sync"
@@ -10,31 +12,65 @@ import (
 	"github.com/eudore/eudore"
 )
 
+// RateStore defines the storage of rate limit state.
+//
+// The Update method atomically updates the state of key using fn,
+// the state is nil if key does not exist,
+// and fn must not modify the state parameter.
+type RateStore interface {
+	Update(key string, ttl time.Duration, fn func(state []byte) []byte) error
+}
+
+// RateSharedStore defines the shared key/value storage that supports
+// compare and swap, such as Redis WATCH, memcached CAS or etcd Txn.
+//
+// The CompareAndSwap method sets val if the current value is equal to old,
+// and old is nil means that key does not exist.
+type RateSharedStore interface {
+	Get(key string) ([]byte, error)
+	CompareAndSwap(key string, old, val []byte, ttl time.Duration) (bool, error)
+}
+
+// RateAlgorithm defines the rate limit algorithm.
+type RateAlgorithm interface {
+	// The Take method takes n tokens at now and returns the new state,
+	// a negative n returns unused tokens.
+	// The returned state must be a new slice.
+	Take(state []byte, now time.Time, n int64) ([]byte, RateResult)
+	// The Limit method returns the maximum number of tokens and the
+	// period to recover all tokens.
+	Limit() (int64, time.Duration)
+}
+
+// RateResult defines the result of [RateAlgorithm] Take.
+type RateResult struct {
+	Allow      bool
+	Remaining  int64
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
 // rate defines the rate limiter.
 type rate struct {
-	visitors   sync.Map
 	GetKeyFunc func(eudore.Context) string
-	speed      int64
-	total      int64
+	store      RateStore
+	algorithm  RateAlgorithm
+	limit      int64
+	period     time.Duration
 	state      bool
 }
 
-type rateBucket struct {
-	sync.Mutex
-	speed int64
-	max   int64
-	last  int64
-}
-
 // NewRateRequestFunc function creates middleware to implement request limit,
 //
-// Use memory-based token bucket rate limiting.
-//
-// Speed is tokens per second, with a maximum of total tokens.
+// The default use memory-based token bucket algorithm,
+// speed is tokens per second, with a maximum of total tokens.
 //
-// This middleware does not support cluster mode.
+// Use [NewOptionRateStore] to share the state in the cluster mode,
+// if the store returns an error, the error is logged and
+// the request is allowed (fail-open).
 //
-// options: [NewOptionKeyFunc] [NewOptionRateCleanup] [NewOptionRateState].
+// options: [NewOptionKeyFunc] [NewOptionRateCleanup] [NewOptionRateState]
+// [NewOptionRateStore] [NewOptionRateAlgorithm].
 func NewRateRequestFunc(speed, total int64, options ...Option) Middleware {
 	r := newRate(speed, total, options)
 	return func(ctx eudore.Context) {
@@ -42,24 +78,31 @@ func NewRateRequestFunc(speed, total int64, options ...Option) Middleware {
 		if key == "" {
 			return
 		}
-		now, at, ok := r.GetVisitor(key).Allow()
+		result, err := r.Take(key, 1)
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
 		if r.state {
-			state := now - at
-			ctx.SetHeader(eudore.HeaderXRateLimit, fi64(r.total/r.speed))
-			ctx.SetHeader(eudore.HeaderXRateReset, fi64((at+r.total)/1000000000))
-			if ok {
-				ctx.SetHeader(eudore.HeaderXRateRemaining, fi64(state/r.speed))
-				return
-			}
-
-			retry := int((r.speed - state) / 1000000000)
-			if retry < DefaultRateRetryMin {
-				retry = DefaultRateRetryMin
-			}
-			ctx.SetHeader(eudore.HeaderRetryAfter, strconv.Itoa(retry))
-		} else if ok {
+			ctx.SetHeader(eudore.HeaderXRateLimit, fi64(r.limit))
+			ctx.SetHeader(eudore.HeaderXRateRemaining, fi64(result.Remaining))
+			ctx.SetHeader(eudore.HeaderXRateReset,
+				fi64(time.Now().Add(result.Reset).Unix()),
+			)
+			ctx.SetHeader(eudore.HeaderRateLimitLimit, fi64(r.limit))
+			ctx.SetHeader(eudore.HeaderRateLimitRemaining, fi64(result.Remaining))
+			ctx.SetHeader(eudore.HeaderRateLimitReset, rateSeconds(result.Reset, 0))
+			ctx.SetHeader(eudore.HeaderRateLimitPolicy,
+				fi64(r.limit)+";w="+rateSeconds(r.period, 1),
+			)
+		}
+		if result.Allow {
 			return
 		}
+
+		ctx.SetHeader(eudore.HeaderRetryAfter,
+			rateSeconds(result.RetryAfter, DefaultRateRetryMin),
+		)
 		writePage(ctx, eudore.StatusTooManyRequests, DefaultPageRate, key)
 		ctx.End()
 	}
@@ -69,6 +112,16 @@ func fi64(i int64) string {
 	return strconv.FormatInt(i, 10)
 }
 
+// The rateSeconds function returns the ceil seconds of t,
+// and the minimum is min.
+func rateSeconds(t time.Duration, min int) string {
+	s := int((t + time.Second - 1) / time.Second)
+	if s < min {
+		s = min
+	}
+	return strconv.Itoa(s)
+}
+
 // The NewRateSpeedFunc function creates middleware to implement rate limiting,
 // without distinguishing between upstream and downstream traffic.
 //
@@ -82,6 +135,9 @@ func fi64(i int64) string {
 // When reading, first request the tokens of the buffer size (512),
 // and then return the number of unused tokens;
 // when writing, request the tokens of the write data length.
+// The read and write length is limited to total once.
+//
+// If the store returns an error, read and write are not limited (fail-open).
 func NewRateSpeedFunc(speed, total int64, options ...Option) Middleware {
 	r := newRate(speed, total, options)
 	return func(ctx eudore.Context) {
@@ -89,20 +145,21 @@ func NewRateSpeedFunc(speed, total int64, options ...Option) Middleware {
 		if key == "" {
 			return
 		}
-		rate := r.GetVisitor(key)
 		httpctx := ctx.Context()
 		req := ctx.Request()
 		if req.ContentLength != 0 {
 			req.Body = &requqestReaderRate{
 				ReadCloser: req.Body,
 				Context:    httpctx,
-				rateBucket: rate,
+				rate:       r,
+				key:        key,
 			}
 		}
 		ctx.SetResponse(&responseWriterRate{
 			ResponseWriter: ctx.Response(),
 			Context:        httpctx,
-			rateBucket:     rate,
+			rate:           r,
+			key:            key,
 		})
 	}
 }
@@ -112,41 +169,94 @@ func newRate(speed, total int64, options []Option) *rate {
 		GetKeyFunc: func(ctx eudore.Context) string {
 			return ctx.RealIP()
 		},
-		speed: int64(time.Second) / speed,
-		total: int64(time.Second) / speed * total,
+		store:     NewRateStoreMap(),
+		algorithm: NewRateAlgorithmTokenBucket(speed, total),
 	}
 	applyOption(r, options)
+	r.limit, r.period = r.algorithm.Limit()
 
 	return r
 }
 
-// The GetVisitor method gets the rateBucket through the key.
-func (r *rate) GetVisitor(key string) *rateBucket {
-	v, ok := r.visitors.Load(key)
-	if !ok {
-		limiter := &rateBucket{
-			speed: r.speed,
-			max:   r.total,
-			last:  time.Now().UnixNano() - r.total,
+// The Take method takes n tokens of key from store.
+func (r *rate) Take(key string, n int64) (RateResult, error) {
+	var result RateResult
+	// the sliding window state also saves the previous window.
+	err := r.store.Update(key, 2*r.period, func(state []byte) []byte {
+		state, result = r.algorithm.Take(state, time.Now(), n)
+		return state
+	})
+	return result, err
+}
+
+// The WaitN method waits until n tokens are taken,
+// n is limited to the maximum number of tokens.
+//
+// The store error returns true, the same as [NewRateRequestFunc] fail-open.
+func (r *rate) WaitN(ctx context.Context, key string, n int64) bool {
+	for {
+		result, err := r.Take(key, n)
+		if err != nil || result.Allow {
+			return true
+		}
+
+		dead, ok := ctx.Deadline()
+		if ok && time.Until(dead) < result.RetryAfter {
+			return false
+		}
+		timer := time.NewTimer(result.RetryAfter)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return false
 		}
-		r.visitors.Store(key, limiter)
-		return limiter
 	}
-	return v.(*rateBucket)
 }
 
-// The cleanupVisitors method periodically clears unactive rates.
-func (r *rate) cleanupVisitors(ctx context.Context, ttl time.Duration, less int) {
-	interval := time.Duration(r.total) * 10
-	if time.Millisecond < ttl && ttl < interval {
-		ttl = interval
+type rateStoreMap struct {
+	entries sync.Map
+}
+
+type rateStoreEntry struct {
+	sync.Mutex
+	state  []byte
+	expire int64
+}
+
+// NewRateStoreMap function creates [RateStore] using [sync.Map],
+// the state is only valid in the current process.
+func NewRateStoreMap() RateStore {
+	return &rateStoreMap{}
+}
+
+func (s *rateStoreMap) Update(key string, ttl time.Duration,
+	fn func([]byte) []byte,
+) error {
+	v, ok := s.entries.Load(key)
+	if !ok {
+		v, _ = s.entries.LoadOrStore(key, &rateStoreEntry{})
 	}
+	entry := v.(*rateStoreEntry)
+	entry.Lock()
+	entry.state = fn(entry.state)
+	entry.expire = time.Now().Add(ttl).UnixNano()
+	entry.Unlock()
+	return nil
+}
 
+// The cleanupExpired method periodically clears expired states,
+// when the number of states is not less than less.
+func (s *rateStoreMap) cleanupExpired(ctx context.Context, ttl time.Duration,
+	less int,
+) {
+	ticker := time.NewTicker(ttl)
+	defer ticker.Stop()
 	for {
 		select {
-		case now := <-time.After(ttl):
+		case now := <-ticker.C:
 			num := 0
-			r.visitors.Range(func(any, any) bool {
+			s.entries.Range(func(any, any) bool {
 				num++
 				return true
 			})
@@ -154,14 +264,14 @@ func (r *rate) cleanupVisitors(ctx context.Context, ttl time.Duration, less int)
 				break
 			}
 
-			dead := now.UnixNano() - int64(interval)
-			r.visitors.Range(func(key, value any) bool {
-				v := value.(*rateBucket)
+			dead := now.UnixNano()
+			s.entries.Range(func(key, value any) bool {
+				v := value.(*rateStoreEntry)
 				v.Lock()
-				last := v.last
+				expire := v.expire
 				v.Unlock()
-				if last < dead {
-					r.visitors.Delete(key)
+				if expire < dead {
+					s.entries.Delete(key)
 				}
 				return true
 			})
@@ -171,79 +281,266 @@ func (r *rate) cleanupVisitors(ctx context.Context, ttl time.Duration, less int)
 	}
 }
 
-func (r *rateBucket) Put(n int64) {
-	r.Lock()
-	r.last -= n * r.speed
-	r.Unlock()
+type rateStoreShared struct {
+	store RateSharedStore
+	retry int
+}
+
+// NewRateStoreShared function creates [RateStore] using [RateSharedStore],
+// the state is shared by the cluster.
+//
+// Update retries compare and swap up to retry times when conflicting.
+func NewRateStoreShared(store RateSharedStore, retry int) RateStore {
+	if retry < 1 {
+		retry = 1
+	}
+	return &rateStoreShared{store: store, retry: retry}
+}
+
+func (s *rateStoreShared) Update(key string, ttl time.Duration,
+	fn func([]byte) []byte,
+) error {
+	for i := 0; i < s.retry; i++ {
+		state, err := s.store.Get(key)
+		if err != nil {
+			return err
+		}
+
+		ok, err := s.store.CompareAndSwap(key, state, fn(state), ttl)
+		if err != nil || ok {
+			return err
+		}
+	}
+	return fmt.Errorf(ErrRateStoreConflict, key, s.retry)
+}
+
+// rateState defines the fixed-length int64 state encoding.
+type rateState []byte
+
+func (s rateState) Get(i int) int64 {
+	if len(s) < i*8+8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(s[i*8:]))
+}
+
+func newRateState(vals ...int64) []byte {
+	state := make([]byte, 0, len(vals)*8)
+	for _, v := range vals {
+		state = binary.BigEndian.AppendUint64(state, uint64(v))
+	}
+	return state
+}
+
+type rateGCRA struct {
+	interval  int64
+	tolerance int64
+	total     int64
 }
 
-func (r *rateBucket) Allow() (int64, int64, bool) {
-	r.Lock()
-	defer r.Unlock()
-	now := time.Now().UnixNano()
-	next := r.last + r.speed
-	if next < now {
-		r.last = next
-		if r.last < now-r.max {
-			r.last = now - r.max
+// NewRateAlgorithmGCRA function creates the Generic Cell Rate Algorithm,
+// the state saves the theoretical arrival time.
+//
+// speed is tokens per second, with a maximum of total tokens.
+func NewRateAlgorithmGCRA(speed, total int64) RateAlgorithm {
+	interval := int64(time.Second) / speed
+	return &rateGCRA{
+		interval:  interval,
+		tolerance: interval * total,
+		total:     total,
+	}
+}
+
+func (r *rateGCRA) Limit() (int64, time.Duration) {
+	return r.total, time.Duration(r.tolerance)
+}
+
+func (r *rateGCRA) Take(state []byte, now time.Time, n int64,
+) ([]byte, RateResult) {
+	t := now.UnixNano()
+	tat := rateState(state).Get(0)
+	if tat < t {
+		tat = t
+	}
+	next := tat + n*r.interval
+	if next < t {
+		next = t
+	}
+
+	allow := next - r.tolerance
+	if allow > t {
+		return newRateState(tat), RateResult{
+			Remaining:  (r.tolerance - tat + t) / r.interval,
+			Reset:      time.Duration(tat - t),
+			RetryAfter: time.Duration(allow - t),
 		}
-		return now, r.last, true
 	}
-	return now, next, false
+	return newRateState(next), RateResult{
+		Allow:     true,
+		Remaining: (r.tolerance - next + t) / r.interval,
+		Reset:     time.Duration(next - t),
+	}
+}
+
+type rateTokenBucket struct {
+	interval int64
+	capacity int64
+	total    int64
+}
+
+// NewRateAlgorithmTokenBucket function creates the token bucket algorithm,
+// the state saves the tokens and the last update time.
+//
+// speed is tokens per second, with a maximum of total tokens.
+func NewRateAlgorithmTokenBucket(speed, total int64) RateAlgorithm {
+	interval := int64(time.Second) / speed
+	return &rateTokenBucket{
+		interval: interval,
+		capacity: interval * total,
+		total:    total,
+	}
 }
 
-func (r *rateBucket) WaitN(ctx context.Context, n int64) bool {
-	r.Lock()
-	now := time.Now().UnixNano()
-	n = r.last + n*r.speed
-	if n < now {
-		r.last = n
-		now -= r.max
-		if r.last < now {
-			r.last = now
+func (r *rateTokenBucket) Limit() (int64, time.Duration) {
+	return r.total, time.Duration(r.capacity)
+}
+
+func (r *rateTokenBucket) Take(state []byte, now time.Time, n int64,
+) ([]byte, RateResult) {
+	// tokens are saved as the recovery time of tokens.
+	t := now.UnixNano()
+	tokens := r.capacity
+	if state != nil {
+		tokens = rateState(state).Get(0) + t - rateState(state).Get(1)
+		if tokens > r.capacity {
+			tokens = r.capacity
 		}
-		r.Unlock()
-		return true
 	}
 
-	dead, ok := ctx.Deadline()
-	if ok && dead.UnixNano() < n {
-		r.Unlock()
-		return false
+	cost := n * r.interval
+	if cost > tokens {
+		return newRateState(tokens, t), RateResult{
+			Remaining:  tokens / r.interval,
+			Reset:      time.Duration(r.capacity - tokens),
+			RetryAfter: time.Duration(cost - tokens),
+		}
+	}
+	tokens -= cost
+	if tokens > r.capacity {
+		tokens = r.capacity
 	}
+	return newRateState(tokens, t), RateResult{
+		Allow:     true,
+		Remaining: tokens / r.interval,
+		Reset:     time.Duration(r.capacity - tokens),
+	}
+}
 
-	// prepay token and wait for it to become available
-	ticker := time.NewTimer(time.Duration(n - now))
-	defer ticker.Stop()
-	r.last = n
-	r.Unlock()
-	select {
-	case <-ticker.C:
-		return true
-	case <-ctx.Done():
-		// cancelling the context does not return the token
-		return false
+type rateSlidingWindow struct {
+	limit  int64
+	window int64
+}
+
+// NewRateAlgorithmSlidingWindow function creates the sliding window counter
+// algorithm, the state saves the start time of current window and
+// the counts of current and previous windows.
+//
+// The count of previous window is weighted by the overlap of sliding window.
+//
+// The limit and window must be greater than 0,
+// and n greater than limit is never allowed.
+func NewRateAlgorithmSlidingWindow(limit int64, window time.Duration,
+) RateAlgorithm {
+	if limit < 1 || window < 1 {
+		panic(fmt.Errorf(ErrRateSlidingWindowInvalid, limit, window))
+	}
+	return &rateSlidingWindow{limit: limit, window: int64(window)}
+}
+
+func (r *rateSlidingWindow) Limit() (int64, time.Duration) {
+	return r.limit, time.Duration(r.window)
+}
+
+func (r *rateSlidingWindow) Take(state []byte, now time.Time, n int64,
+) ([]byte, RateResult) {
+	t := now.UnixNano()
+	start := t - t%r.window
+	prev, curr := int64(0), int64(0)
+	switch rateState(state).Get(0) {
+	case start:
+		prev, curr = rateState(state).Get(1), rateState(state).Get(2)
+	case start - r.window:
+		prev = rateState(state).Get(2)
+	}
+
+	elapsed := t - start
+	count := prev*(r.window-elapsed)/r.window + curr
+	remaining := r.limit - count
+	if remaining < 0 {
+		remaining = 0
+	}
+	if n > r.limit {
+		// wait for both windows to be empty.
+		return newRateState(start, prev, curr), RateResult{
+			Remaining:  remaining,
+			Reset:      time.Duration(r.window - elapsed),
+			RetryAfter: time.Duration(2*r.window - elapsed),
+		}
+	}
+	if n > 0 && count+n > r.limit {
+		// wait for the weight of the previous window to decrease,
+		// the current window becomes the previous window if full.
+		var retry int64
+		if curr+n <= r.limit {
+			retry = r.window - (r.limit-curr-n)*r.window/prev - elapsed + 1
+		} else {
+			retry = 2*r.window - (r.limit-n)*r.window/curr - elapsed + 1
+		}
+		return newRateState(start, prev, curr), RateResult{
+			Remaining:  0,
+			Reset:      time.Duration(r.window - elapsed),
+			RetryAfter: time.Duration(retry),
+		}
+	}
+
+	curr += n
+	if curr < 0 {
+		curr = 0
+	}
+	remaining = r.limit - count - n
+	if remaining < 0 {
+		remaining = 0
+	}
+	return newRateState(start, prev, curr), RateResult{
+		Allow:     true,
+		Remaining: remaining,
+		Reset:     time.Duration(r.window - elapsed),
 	}
 }
 
 type requqestReaderRate struct {
 	io.ReadCloser
 	context.Context
-	*rateBucket
+	rate *rate
+	key  string
 }
 
 type responseWriterRate struct {
 	eudore.ResponseWriter
 	context.Context
-	*rateBucket
+	rate *rate
+	key  string
 }
 
 func (r *requqestReaderRate) Read(body []byte) (int, error) {
+	if int64(len(body)) > r.rate.limit {
+		body = body[:r.rate.limit]
+	}
 	length := len(body)
-	if r.WaitN(r.Context, int64(length)) {
+	if r.rate.WaitN(r.Context, r.key, int64(length)) {
 		n, err := r.ReadCloser.Read(body)
 		if length != n {
-			r.Put(int64(length - n))
+			_, _ = r.rate.Take(r.key, int64(n-length))
 		}
 		return n, err
 	}
@@ -251,15 +548,41 @@ func (r *requqestReaderRate) Read(body []byte) (int, error) {
 }
 
 func (r *responseWriterRate) Write(data []byte) (int, error) {
-	if r.WaitN(r.Context, int64(len(data))) {
-		return r.ResponseWriter.Write(data)
+	var size int
+	for len(data) > 0 {
+		length := len(data)
+		if int64(length) > r.rate.limit {
+			length = int(r.rate.limit)
+		}
+		if !r.rate.WaitN(r.Context, r.key, int64(length)) {
+			return size, r.Err()
+		}
+		n, err := r.ResponseWriter.Write(data[:length])
+		size += n
+		if err != nil {
+			return size, err
+		}
+		data = data[length:]
 	}
-	return 0, r.Err()
+	return size, nil
 }
 
 func (r *responseWriterRate) WriteString(data string) (int, error) {
-	if r.WaitN(r.Context, int64(len(data))) {
-		return r.ResponseWriter.WriteString(data)
+	var size int
+	for len(data) > 0 {
+		length := len(data)
+		if int64(length) > r.rate.limit {
+			length = int(r.rate.limit)
+		}
+		if !r.rate.WaitN(r.Context, r.key, int64(length)) {
+			return size, r.Err()
+		}
+		n, err := r.ResponseWriter.WriteString(data[:length])
+		size += n
+		if err != nil {
+			return size, err
+		}
+		data = data[length:]
 	}
-	return 0, r.Err()
+	return size, nil
 }
@@ -0,0 +1,542 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eudore/eudore"
+)
+
+// Session defines the session data of [NewSessionFunc].
+//
+// The Values and Flashes are encoded by [encoding/gob],
+// custom types must be registered with [gob.Register].
+type Session struct {
+	mu       sync.Mutex
+	ID       string
+	Values   map[string]any
+	Flashes  []any
+	Created  time.Time
+	Accessed time.Time
+	changed  bool
+	renewed  bool
+	destroy  bool
+}
+
+// SessionStore defines the storage of [NewSessionFunc].
+//
+// Implementations: [NewSessionStoreCookie] [NewSessionStoreMap]
+// [NewSessionStoreFile].
+type SessionStore interface {
+	// Load method returns the session of the cookie value,
+	// or nil if not found or invalid.
+	Load(value string) *Session
+	// Save method saves the session until ttl and returns the cookie value.
+	Save(session *Session, ttl time.Duration) (string, error)
+	// Delete method deletes the session.
+	Delete(session *Session) error
+}
+
+type session struct {
+	Store   SessionStore
+	Cookie  http.Cookie
+	MaxIdle time.Duration
+	MaxAge  time.Duration
+}
+
+// NewSessionFunc function creates middleware to implement session.
+//
+// Load the session from the cookie and save it to
+// [eudore.ContextKeySession], use [GetSession] to get it.
+// The session is saved before the response is written,
+// an empty new session is not saved.
+//
+// The session expires after [DefaultSessionMaxIdle] without access,
+// or [DefaultSessionMaxAge] after creation,
+// the access time is updated when the session changes or more than 1/10 of
+// the idle time has passed.
+//
+// The default cookie is named 'sessionid' with Path=/, HttpOnly and
+// SameSite=Lax, and it is set by [eudore.Context.SetCookie].
+// [NewOptionCSRFCookie] shares the Domain, Path, Secure and SameSite of the
+// CSRF cookie.
+//
+// options: [NewOptionSessionCookie] [NewOptionSessionExpiry]
+// [NewOptionCSRFCookie].
+func NewSessionFunc(store SessionStore, options ...Option) Middleware {
+	s := &session{
+		Store: store,
+		Cookie: http.Cookie{
+			Name:     "sessionid",
+			Path:     "/",
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		},
+		MaxIdle: DefaultSessionMaxIdle,
+		MaxAge:  DefaultSessionMaxAge,
+	}
+	applyOption(s, options)
+	return func(ctx eudore.Context) {
+		now := time.Now()
+		value := ctx.GetCookie(s.Cookie.Name)
+		var sess *Session
+		if value != "" {
+			sess = s.Store.Load(value)
+			if sess != nil && s.expired(sess, now) {
+				_ = s.Store.Delete(sess)
+				sess = nil
+			}
+		}
+		if sess == nil {
+			sess = &Session{Created: now, Accessed: now}
+			value = ""
+		}
+
+		ctx.SetValue(eudore.ContextKeySession, sess)
+		w := &responseWriterSession{
+			ResponseWriter: ctx.Response(),
+			save: func() {
+				s.save(ctx, sess, value, now)
+			},
+		}
+		ctx.SetResponse(w)
+		ctx.Next()
+		w.writeSession()
+	}
+}
+
+func (s *session) expired(sess *Session, now time.Time) bool {
+	return now.Sub(sess.Accessed) > s.MaxIdle || now.Sub(sess.Created) > s.MaxAge
+}
+
+func (s *session) save(ctx eudore.Context, sess *Session, value string, now time.Time) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.destroy {
+		if value != "" {
+			err := s.Store.Delete(sess)
+			if err != nil {
+				ctx.Error(err)
+			}
+			cookie := s.Cookie
+			cookie.MaxAge = -1
+			ctx.SetCookie(&cookie)
+		}
+		return
+	}
+	if value == "" && !sess.changed {
+		return
+	}
+	if !sess.changed && !sess.renewed &&
+		now.Sub(sess.Accessed) < s.MaxIdle/10 {
+		return
+	}
+
+	if sess.renewed && sess.ID != "" {
+		err := s.Store.Delete(sess)
+		if err != nil {
+			ctx.Error(err)
+		}
+		sess.ID = ""
+	}
+	if sess.ID == "" {
+		sess.ID = eudore.GetStringRandom(16)
+	}
+	sess.Accessed = now
+	ttl := s.MaxIdle
+	if sess.Created.Add(s.MaxAge).Sub(now) < ttl {
+		ttl = sess.Created.Add(s.MaxAge).Sub(now)
+	}
+	newvalue, err := s.Store.Save(sess, ttl)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if newvalue != value {
+		cookie := s.Cookie
+		cookie.Value = newvalue
+		ctx.SetCookie(&cookie)
+	}
+}
+
+// GetSession function returns the [Session] of [NewSessionFunc],
+// or nil if not used.
+func GetSession(ctx eudore.Context) *Session {
+	sess, _ := ctx.Value(eudore.ContextKeySession).(*Session)
+	return sess
+}
+
+// GetSessionValue function returns the typed value of the [Session] key,
+// or zero value if not found or the type is different.
+func GetSessionValue[T any](ctx eudore.Context, key string) T {
+	var val T
+	sess := GetSession(ctx)
+	if sess != nil {
+		val, _ = sess.Get(key).(T)
+	}
+	return val
+}
+
+// The Get method returns the value of the key.
+func (sess *Session) Get(key string) any {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	return sess.Values[key]
+}
+
+// The Set method sets the value of the key.
+func (sess *Session) Set(key string, val any) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.Values == nil {
+		sess.Values = make(map[string]any)
+	}
+	sess.Values[key] = val
+	sess.changed = true
+}
+
+// The Delete method deletes the value of the key.
+func (sess *Session) Delete(key string) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	delete(sess.Values, key)
+	sess.changed = true
+}
+
+// The AddFlash method adds a flash message,
+// which is deleted after being read by [Session.GetFlashes].
+func (sess *Session) AddFlash(val any) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.Flashes = append(sess.Flashes, val)
+	sess.changed = true
+}
+
+// The GetFlashes method returns and deletes the flash messages.
+func (sess *Session) GetFlashes() []any {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	flashes := sess.Flashes
+	if flashes != nil {
+		sess.Flashes = nil
+		sess.changed = true
+	}
+	return flashes
+}
+
+// The Renew method rotates the session id and keeps the values,
+// it should be called after login to prevent session fixation.
+func (sess *Session) Renew() {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.renewed = true
+	sess.changed = true
+}
+
+// The Destroy method deletes the session and the cookie.
+func (sess *Session) Destroy() {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.destroy = true
+}
+
+func encodeSession(sess *Session) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	err := gob.NewEncoder(buf).Encode(sess)
+	return buf.Bytes(), err
+}
+
+func decodeSession(data []byte) *Session {
+	sess := &Session{}
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(sess)
+	if err != nil {
+		return nil
+	}
+	return sess
+}
+
+type sessionCookie struct {
+	hashKey []byte
+	block   cipher.AEAD
+}
+
+// NewSessionStoreCookie function creates [SessionStore] that saves the
+// session in the cookie value, signed by HMAC-SHA256 of hashKey.
+//
+// If blockKey is not empty, the data is encrypted by AES-256-GCM using the
+// sha256 of blockKey.
+//
+// The Delete method cannot revoke the issued cookie, the cookie value
+// must be less than 4096 bytes.
+//
+// If hashKey is empty or the cipher creation fails, it will panic.
+func NewSessionStoreCookie(hashKey, blockKey []byte) SessionStore {
+	if len(hashKey) == 0 {
+		panic(ErrSessionCookieHashKeyEmpty)
+	}
+	s := &sessionCookie{hashKey: hashKey}
+	if len(blockKey) > 0 {
+		key := sha256.Sum256(blockKey)
+		block, err := aes.NewCipher(key[:])
+		if err != nil {
+			panic(err)
+		}
+		s.block, err = cipher.NewGCM(block)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return s
+}
+
+func (s *sessionCookie) Load(value string) *Session {
+	data, sign, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil
+	}
+	have, err := base64Encoding.DecodeString(sign)
+	if err != nil || !hmac.Equal(have, s.sign(data)) {
+		return nil
+	}
+	body, err := base64Encoding.DecodeString(data)
+	if err != nil {
+		return nil
+	}
+	if s.block != nil {
+		size := s.block.NonceSize()
+		if len(body) < size {
+			return nil
+		}
+		body, err = s.block.Open(nil, body[:size], body[size:], nil)
+		if err != nil {
+			return nil
+		}
+	}
+	return decodeSession(body)
+}
+
+func (s *sessionCookie) Save(sess *Session, _ time.Duration) (string, error) {
+	body, err := encodeSession(sess)
+	if err != nil {
+		return "", err
+	}
+	if s.block != nil {
+		nonce := make([]byte, s.block.NonceSize())
+		_, err = io.ReadFull(rand.Reader, nonce)
+		if err != nil {
+			return "", err
+		}
+		body = s.block.Seal(nonce, nonce, body, nil)
+	}
+	data := base64Encoding.EncodeToString(body)
+	value := data + "." + base64Encoding.EncodeToString(s.sign(data))
+	if len(value) > 4096 {
+		return "", fmt.Errorf(ErrSessionCookieTooLarge, len(value))
+	}
+	return value, nil
+}
+
+func (s *sessionCookie) Delete(*Session) error {
+	return nil
+}
+
+func (s *sessionCookie) sign(data string) []byte {
+	h := hmac.New(sha256.New, s.hashKey)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+type sessionMap struct {
+	sync.Map
+}
+
+type sessionMapItem struct {
+	expired time.Time
+	data    []byte
+}
+
+// NewSessionStoreMap function creates [SessionStore] using [sync.Map],
+// the cookie value is the session id.
+func NewSessionStoreMap() SessionStore {
+	return &sessionMap{}
+}
+
+func (s *sessionMap) Load(value string) *Session {
+	data, ok := s.Map.Load(value)
+	if !ok {
+		return nil
+	}
+	item := data.(*sessionMapItem)
+	if time.Now().After(item.expired) {
+		s.Map.Delete(value)
+		return nil
+	}
+	return decodeSession(item.data)
+}
+
+func (s *sessionMap) Save(sess *Session, ttl time.Duration) (string, error) {
+	data, err := encodeSession(sess)
+	if err != nil {
+		return "", err
+	}
+	s.Map.Store(sess.ID, &sessionMapItem{time.Now().Add(ttl), data})
+	return sess.ID, nil
+}
+
+func (s *sessionMap) Delete(sess *Session) error {
+	s.Map.Delete(sess.ID)
+	return nil
+}
+
+func (s *sessionMap) cleanupExpired(ctx context.Context, ttl time.Duration) {
+	for {
+		select {
+		case now := <-time.After(ttl):
+			s.Map.Range(func(key, value any) bool {
+				if now.After(value.(*sessionMapItem).expired) {
+					s.Map.Delete(key)
+				}
+				return true
+			})
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type sessionFile struct {
+	dir string
+}
+
+// NewSessionStoreFile function creates [SessionStore] that saves the
+// session to the file in the dir, the file name is the session id.
+//
+// If dir is a shared file system, multiple instances can share sessions.
+func NewSessionStoreFile(dir string) SessionStore {
+	return &sessionFile{dir}
+}
+
+// The Load method only allows the hex session id as the file name.
+func (s *sessionFile) Load(value string) *Session {
+	if value == "" || strings.Trim(value, "0123456789abcdef") != "" {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, value))
+	if err != nil {
+		return nil
+	}
+	line, data, _ := bytes.Cut(data, []byte{'\n'})
+	expired, err := time.Parse(time.RFC3339Nano, string(line))
+	if err != nil || time.Now().After(expired) {
+		return nil
+	}
+	return decodeSession(data)
+}
+
+// The Save method writes the expiration time in the first line.
+func (s *sessionFile) Save(sess *Session, ttl time.Duration) (string, error) {
+	data, err := encodeSession(sess)
+	if err != nil {
+		return "", err
+	}
+	err = os.MkdirAll(s.dir, 0o700)
+	if err != nil {
+		return "", err
+	}
+	expired := time.Now().Add(ttl).Format(time.RFC3339Nano)
+	data = append([]byte(expired+"\n"), data...)
+	err = os.WriteFile(filepath.Join(s.dir, sess.ID), data, 0o600)
+	if err != nil {
+		return "", err
+	}
+	return sess.ID, nil
+}
+
+func (s *sessionFile) Delete(sess *Session) error {
+	err := os.Remove(filepath.Join(s.dir, sess.ID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *sessionFile) cleanupExpired(ctx context.Context, ttl time.Duration) {
+	for {
+		select {
+		case now := <-time.After(ttl):
+			entries, _ := os.ReadDir(s.dir)
+			for _, entry := range entries {
+				name := filepath.Join(s.dir, entry.Name())
+				if s.isExpired(name, now) {
+					_ = os.Remove(name)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// The isExpired method only reads the first line of the file.
+func (s *sessionFile) isExpired(name string, now time.Time) bool {
+	file, err := os.Open(name)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+	line, _ := bufio.NewReader(file).ReadString('\n')
+	expired, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(line))
+	return err == nil && now.After(expired)
+}
+
+// responseWriterSession saves the session before writing the header.
+type responseWriterSession struct {
+	eudore.ResponseWriter
+	save func()
+}
+
+func (w *responseWriterSession) Write(p []byte) (int, error) {
+	w.writeSession()
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *responseWriterSession) WriteString(p string) (int, error) {
+	w.writeSession()
+	return w.ResponseWriter.WriteString(p)
+}
+
+func (w *responseWriterSession) WriteHeader(code int) {
+	w.writeSession()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriterSession) Flush() {
+	w.writeSession()
+	w.ResponseWriter.Flush()
+}
+
+func (w *responseWriterSession) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriterSession) writeSession() {
+	if w.save != nil {
+		w.save()
+		w.save = nil
+	}
+}
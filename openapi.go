@@ -0,0 +1,741 @@
+package eudore
+
+// Generate the OpenAPI 3.1 document from the Router metadata.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OpenAPIConfig defines the document information used by [NewOpenAPIDocument].
+type OpenAPIConfig struct {
+	Title       string   `alias:"title" json:"title" yaml:"title"`
+	Description string   `alias:"description" json:"description" yaml:"description"`
+	Version     string   `alias:"version" json:"version" yaml:"version"`
+	Servers     []string `alias:"servers" json:"servers" yaml:"servers"`
+}
+
+// OpenAPIDocument defines the OpenAPI 3.1 root object.
+type OpenAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       OpenAPIInfo                             `json:"info"`
+	Servers    []OpenAPIServer                         `json:"servers,omitempty"`
+	Paths      map[string]map[string]*OpenAPIOperation `json:"paths"`
+	Components *OpenAPIComponents                      `json:"components,omitempty"`
+}
+
+// OpenAPIInfo defines the OpenAPI Info object.
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIServer defines the OpenAPI Server object.
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+// OpenAPIComponents defines the OpenAPI Components object.
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas,omitempty"`
+}
+
+// OpenAPIOperation defines the OpenAPI Operation object.
+type OpenAPIOperation struct {
+	Tags        []string                    `json:"tags,omitempty"`
+	Summary     string                      `json:"summary,omitempty"`
+	OperationID string                      `json:"operationId,omitempty"`
+	Parameters  []*OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter defines the OpenAPI Parameter object.
+type OpenAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required,omitempty"`
+	Schema   *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIRequestBody defines the OpenAPI Request Body object.
+type OpenAPIRequestBody struct {
+	Content map[string]*OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIResponse defines the OpenAPI Response object.
+type OpenAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*OpenAPIMediaType `json:"content,omitempty"`
+}
+
+// OpenAPIMediaType defines the OpenAPI Media Type object.
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPISchema defines the JSON Schema subset used by OpenAPI.
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Pattern              string                    `json:"pattern,omitempty"`
+	Enum                 []any                     `json:"enum,omitempty"`
+	Minimum              *float64                  `json:"minimum,omitempty"`
+	Maximum              *float64                  `json:"maximum,omitempty"`
+	MinLength            *int                      `json:"minLength,omitempty"`
+	MaxLength            *int                      `json:"maxLength,omitempty"`
+	MinItems             *int                      `json:"minItems,omitempty"`
+	MaxItems             *int                      `json:"maxItems,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+}
+
+// NewOpenAPIDocument function uses [MetadataRouter] of [Router] to create
+// an OpenAPI 3.1 document.
+//
+// The request type is the second parameter of func(Context, Request) which is
+// converted by [HandlerExtender], such as
+// [NewHandlerFuncContextTypeAnyError];
+// the response type is the first non-error return value.
+//
+// Methods GET HEAD DELETE use [DefaultHandlerDataBindURLTags] to generate
+// query parameters, other methods generate json and form request body.
+// [DefaultHandlerValidateTag] generates schema constraints.
+//
+// Router must record metadata, LoggerKind must include 'metadata'.
+func NewOpenAPIDocument(router Router, config *OpenAPIConfig) *OpenAPIDocument {
+	if config == nil {
+		config = &OpenAPIConfig{}
+	}
+	doc := &OpenAPIDocument{
+		OpenAPI: "3.1.0",
+		Info: OpenAPIInfo{
+			Title:       config.Title,
+			Description: config.Description,
+			Version:     config.Version,
+		},
+		Paths:      make(map[string]map[string]*OpenAPIOperation),
+		Components: &OpenAPIComponents{Schemas: make(map[string]*OpenAPISchema)},
+	}
+	if doc.Info.Title == "" {
+		doc.Info.Title = "eudore"
+	}
+	if doc.Info.Version == "" {
+		doc.Info.Version = "0.0.0"
+	}
+	for _, server := range config.Servers {
+		doc.Servers = append(doc.Servers, OpenAPIServer{URL: server})
+	}
+
+	meta, ok := anyMetadata(router).(MetadataRouter)
+	if ok {
+		b := &openapiBuilder{
+			doc:   doc,
+			types: make(map[reflect.Type]string),
+		}
+		for i := range meta.Methods {
+			b.addOperation(meta.Methods[i], meta.Params[i], meta.handlers[i])
+		}
+	}
+	if len(doc.Components.Schemas) == 0 {
+		doc.Components = nil
+	}
+	return doc
+}
+
+// NewHandlerOpenAPI function creates [HandlerFunc] to render the OpenAPI
+// document of [ContextKeyRouter].
+//
+// If the path suffix is '.yaml' or '.yml' or [HeaderAccept] is
+// [MimeApplicationYAML], render yaml; otherwise render json.
+//
+// The document is regenerated when the number of routes changes.
+func NewHandlerOpenAPI(app context.Context, config *OpenAPIConfig) HandlerFunc {
+	var mu sync.Mutex
+	var size int
+	var data []byte
+	h := func(ctx Context) {
+		router, ok := app.Value(ContextKeyRouter).(Router)
+		if !ok {
+			HandlerRouter404(ctx)
+			return
+		}
+
+		mu.Lock()
+		meta, _ := anyMetadata(router).(MetadataRouter)
+		if data == nil || size != len(meta.Methods) {
+			size = len(meta.Methods)
+			data, _ = json.MarshalIndent(NewOpenAPIDocument(router, config), "", "\t")
+		}
+		body := data
+		mu.Unlock()
+
+		path := ctx.Path()
+		if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") ||
+			strings.Contains(ctx.GetHeader(HeaderAccept), MimeApplicationYAML) {
+			var buf bytes.Buffer
+			err := openapiWriteYAML(&buf, json.NewDecoder(bytes.NewReader(body)))
+			if err != nil {
+				ctx.Fatal(err)
+				return
+			}
+			ctx.SetHeader(HeaderContentType, MimeApplicationYAMLCharsetUtf8)
+			_, _ = ctx.Write(buf.Bytes())
+			return
+		}
+		ctx.SetHeader(HeaderContentType, MimeApplicationJSONCharsetUtf8)
+		_, _ = ctx.Write(body)
+	}
+	// mark the route to skip itself.
+	setHandlerFuncType(h, typeOpenAPIHandler)
+	return h
+}
+
+type openapiBuilder struct {
+	doc   *OpenAPIDocument
+	types map[reflect.Type]string
+}
+
+func (b *openapiBuilder) addOperation(method string, params Params,
+	hs []HandlerFunc,
+) {
+	var methods []string
+	switch method {
+	case MethodNotFound, MethodNotAllowed:
+		return
+	case MethodAny:
+		methods = DefaultRouterAnyMethod
+	default:
+		methods = []string{method}
+	}
+
+	var handler reflect.Type
+	for i := len(hs) - 1; i >= 0 && handler == nil; i-- {
+		handler = getHandlerFuncType(hs[i])
+	}
+	for i := range hs {
+		if getHandlerFuncType(hs[i]) == typeOpenAPIHandler {
+			return
+		}
+	}
+
+	path, pathParams := openapiPath(params.Get(ParamRoute))
+	for _, m := range methods {
+		op := &OpenAPIOperation{
+			Parameters: pathParams,
+			Responses: map[string]*OpenAPIResponse{
+				"default": {
+					Description: "Error",
+					Content:     b.newContent(typeOpenAPIError, MimeApplicationJSON),
+				},
+			},
+		}
+		if group := params.Get(ParamControllerGroup); group != "" {
+			op.Tags = []string{group}
+		}
+		if name := params.Get(ParamRouteName); name != "" {
+			op.OperationID = name
+			if len(methods) > 1 {
+				op.OperationID += "_" + strings.ToLower(m)
+			}
+		}
+		b.setHandler(op, m, handler)
+
+		item := b.doc.Paths[path]
+		if item == nil {
+			item = make(map[string]*OpenAPIOperation)
+			b.doc.Paths[path] = item
+		}
+		item[strings.ToLower(m)] = op
+	}
+}
+
+func (b *openapiBuilder) setHandler(op *OpenAPIOperation, method string,
+	handler reflect.Type,
+) {
+	resp := &OpenAPIResponse{Description: "OK"}
+	op.Responses["200"] = resp
+	if handler == nil || handler.Kind() != reflect.Func {
+		return
+	}
+
+	if handler.NumOut() > 0 && handler.Out(0) != typeError {
+		resp.Content = b.newContent(handler.Out(0), MimeApplicationJSON)
+	}
+	if handler.NumIn() != 2 || handler.In(0) != typeContext {
+		return
+	}
+
+	req := handler.In(1)
+	for req.Kind() == reflect.Ptr {
+		req = req.Elem()
+	}
+	switch method {
+	case MethodGet, MethodHead, MethodDelete, MethodOptions:
+		if req.Kind() == reflect.Struct {
+			op.Parameters = append(op.Parameters,
+				b.newQuery(req, "", DefaultHandlerDataBindURLTags, 0)...,
+			)
+		}
+	default:
+		op.RequestBody = &OpenAPIRequestBody{
+			Content: b.newContent(req, MimeApplicationJSON),
+		}
+		if req.Kind() == reflect.Struct || req.Kind() == reflect.Map {
+			form := &OpenAPIMediaType{Schema: b.newSchema(req,
+				DefaultHandlerDataBindFormTags, 0),
+			}
+			op.RequestBody.Content[MimeApplicationForm] = form
+			op.RequestBody.Content[MimeMultipartForm] = form
+		}
+	}
+}
+
+func (b *openapiBuilder) newContent(t reflect.Type, mime string,
+) map[string]*OpenAPIMediaType {
+	return map[string]*OpenAPIMediaType{
+		mime: {Schema: b.newSchema(t, nil, 0)},
+	}
+}
+
+// The newQuery method flattens struct fields into query parameters.
+func (b *openapiBuilder) newQuery(t reflect.Type, prefix string, tags []string,
+	depth int,
+) []*OpenAPIParameter {
+	var params []*OpenAPIParameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := openapiFieldName(field, tags)
+		if !ok {
+			continue
+		}
+		ft := field.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != typeTimeTime &&
+			!ft.Implements(typeTextMarshaler) && depth < 3 {
+			if !field.Anonymous || name != "" {
+				name = prefix + name + "."
+			} else {
+				name = prefix
+			}
+			params = append(params, b.newQuery(ft, name, tags, depth+1)...)
+			continue
+		}
+		if name == "" {
+			continue
+		}
+
+		schema := b.newSchema(field.Type, tags, depth+1)
+		params = append(params, &OpenAPIParameter{
+			Name:     prefix + name,
+			In:       "query",
+			Required: openapiSetValidate(schema, field),
+			Schema:   schema,
+		})
+	}
+	return params
+}
+
+//nolint:cyclop,gocyclo
+func (b *openapiBuilder) newSchema(t reflect.Type, tags []string, depth int,
+) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == typeTimeTime:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case t == typeTimeDuration:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.PointerTo(t).Implements(typeJSONMarshaler):
+		return &OpenAPISchema{}
+	case reflect.PointerTo(t).Implements(typeTextMarshaler):
+		return &OpenAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: b.newSchema(t.Elem(), tags, depth+1)}
+	case reflect.Map:
+		return &OpenAPISchema{
+			Type:                 "object",
+			AdditionalProperties: b.newSchema(t.Elem(), tags, depth+1),
+		}
+	case reflect.Struct:
+		// json schema uses components, form schema is inline.
+		if tags == nil && t.Name() != "" {
+			return b.newSchemaRef(t)
+		}
+		if depth > 3 {
+			return &OpenAPISchema{Type: "object"}
+		}
+		return b.newSchemaStruct(t, tags, depth)
+	default:
+		return &OpenAPISchema{}
+	}
+}
+
+func (b *openapiBuilder) newSchemaRef(t reflect.Type) *OpenAPISchema {
+	name, ok := b.types[t]
+	if !ok {
+		name = openapiTypeName(t)
+		if t == typeOpenAPIError {
+			name = "Error"
+		}
+		base := name
+		for i := 2; b.doc.Components.Schemas[name] != nil; i++ {
+			name = base + strconv.Itoa(i)
+		}
+		// placeholder for recursive type
+		b.types[t] = name
+		b.doc.Components.Schemas[name] = &OpenAPISchema{}
+		*b.doc.Components.Schemas[name] = *b.newSchemaStruct(t, nil, 0)
+	}
+	return &OpenAPISchema{Ref: "#/components/schemas/" + name}
+}
+
+func (b *openapiBuilder) newSchemaStruct(t reflect.Type, tags []string,
+	depth int,
+) *OpenAPISchema {
+	schema := &OpenAPISchema{
+		Type:       "object",
+		Properties: make(map[string]*OpenAPISchema),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := openapiFieldName(field, tags)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embed := b.newSchemaStruct(ft, tags, depth)
+				for k, v := range embed.Properties {
+					if schema.Properties[k] == nil {
+						schema.Properties[k] = v
+					}
+				}
+				schema.Required = append(schema.Required, embed.Required...)
+				continue
+			}
+			name = ft.Name()
+		}
+
+		prop := b.newSchema(field.Type, tags, depth+1)
+		if prop.Ref != "" {
+			if openapiSetValidate(&OpenAPISchema{}, field) {
+				schema.Required = append(schema.Required, name)
+			}
+		} else if openapiSetValidate(prop, field) {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = prop
+	}
+	return schema
+}
+
+// The openapiFieldName function returns the field name using tags,
+// nil tags use json tag.
+//
+// Embedded struct without tag returns an empty name.
+func openapiFieldName(field reflect.StructField, tags []string) (string, bool) {
+	if !field.IsExported() && !field.Anonymous {
+		return "", false
+	}
+	if tags == nil {
+		tags = []string{"json"}
+	}
+	for _, tag := range tags {
+		name, ok := field.Tag.Lookup(tag)
+		if ok {
+			name, _, _ = strings.Cut(name, ",")
+			switch name {
+			case "-":
+				return "", false
+			case "":
+				continue
+			}
+			return name, true
+		}
+	}
+	if field.Anonymous {
+		return "", true
+	}
+	return field.Name, true
+}
+
+// The openapiSetValidate function converts [DefaultHandlerValidateTag] to
+// schema constraints and returns whether the field is required.
+//
+//nolint:cyclop,gocyclo
+func openapiSetValidate(schema *OpenAPISchema, field reflect.StructField) bool {
+	tags, omit := cutOmit(field.Tag.Get(DefaultHandlerValidateTag))
+	if tags == "" || tags == "-" {
+		return false
+	}
+
+	var required bool
+	for _, tag := range splitValidateTag(tags) {
+		pos := strings.IndexAny(tag, "!<>=:")
+		if pos == -1 {
+			pos = len(tag)
+		}
+		name, arg := tag[:pos], tag[pos:]
+		val := trimFuncOperate(arg)
+		switch name {
+		case "nozero", "must":
+			required = !omit
+		case "min", "max":
+			if schema.Type != "integer" && schema.Type != "number" {
+				continue
+			}
+			num, err := strconv.ParseFloat(val, 64)
+			if err == nil {
+				if name == "min" {
+					schema.Minimum = &num
+				} else {
+					schema.Maximum = &num
+				}
+			}
+		case "len":
+			length, err := strconv.Atoi(val)
+			if err != nil || arg == "" {
+				continue
+			}
+			min, max := &schema.MinLength, &schema.MaxLength
+			if schema.Type == "array" {
+				min, max = &schema.MinItems, &schema.MaxItems
+			}
+			switch arg[0] {
+			case '>':
+				*min = openapiInt(length + 1)
+			case '<':
+				*max = openapiInt(length - 1)
+			case '=':
+				*min, *max = openapiInt(length), openapiInt(length)
+			}
+		case "enum":
+			if strings.HasPrefix(arg, "!") {
+				continue
+			}
+			for _, s := range strings.Split(val, ",") {
+				switch schema.Type {
+				case "integer":
+					num, err := strconv.ParseInt(s, 10, 64)
+					if err == nil {
+						schema.Enum = append(schema.Enum, num)
+					}
+				default:
+					schema.Enum = append(schema.Enum, s)
+				}
+			}
+		case "regexp":
+			if !strings.HasPrefix(arg, "!") {
+				schema.Pattern = val
+			}
+		case "num":
+			schema.Pattern = "^[0-9]+$"
+		case "integer":
+			schema.Pattern = "^-?[0-9]+$"
+		case "mail":
+			schema.Format = "email"
+		case "domain":
+			schema.Format = "hostname"
+		}
+	}
+	return required
+}
+
+func openapiInt(i int) *int {
+	return &i
+}
+
+// The openapiPath function converts route path to OpenAPI path template and
+// creates path parameters.
+func openapiPath(route string) (string, []*OpenAPIParameter) {
+	var params []*OpenAPIParameter
+	paths := getSplitPath(route)
+	for i, path := range paths {
+		if path[0] != ':' && path[0] != '*' {
+			continue
+		}
+
+		name, check, _ := strings.Cut(path[1:], "|")
+		if name == "" {
+			name = path[:1]
+		}
+		schema := &OpenAPISchema{Type: "string"}
+		switch {
+		case check == "num" || check == "integer":
+			schema.Type = "integer"
+		case strings.HasPrefix(check, "^") && strings.HasSuffix(check, "$"):
+			schema.Pattern = check
+		case strings.HasPrefix(check, "regexp:"):
+			schema.Pattern = check[7:]
+		}
+		paths[i] = "{" + name + "}"
+		params = append(params, &OpenAPIParameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+	return strings.Join(paths, ""), params
+}
+
+func openapiTypeName(t reflect.Type) string {
+	name := t.Name()
+	start := strings.IndexByte(name, '[')
+	if start == -1 {
+		return name
+	}
+
+	args := strings.Split(strings.TrimSuffix(name[start+1:], "]"), ",")
+	for i, arg := range args {
+		args[i] = arg[strings.LastIndexAny(arg, "./*[]")+1:]
+	}
+	return name[:start] + "_" + strings.Join(args, "_")
+}
+
+// The openapiWriteYAML function converts json tokens to yaml.
+func openapiWriteYAML(w *bytes.Buffer, dec *json.Decoder) error {
+	dec.UseNumber()
+	node, err := openapiReadYAML(dec)
+	if err != nil {
+		return err
+	}
+	node.write(w, 0, true)
+	w.WriteByte('\n')
+	return nil
+}
+
+type openapiYAML struct {
+	kind   json.Delim
+	scalar string
+	keys   []string
+	vals   []*openapiYAML
+}
+
+func openapiReadYAML(dec *json.Decoder) (*openapiYAML, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch val := token.(type) {
+	case json.Delim:
+		node := &openapiYAML{kind: val}
+		for dec.More() {
+			if val == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.keys = append(node.keys, openapiYAMLString(key.(string)))
+			}
+			child, err := openapiReadYAML(dec)
+			if err != nil {
+				return nil, err
+			}
+			node.vals = append(node.vals, child)
+		}
+		_, err = dec.Token()
+		return node, err
+	case string:
+		return &openapiYAML{scalar: strconv.Quote(val)}, nil
+	case nil:
+		return &openapiYAML{scalar: "null"}, nil
+	default:
+		return &openapiYAML{scalar: openapiFormat(val)}, nil
+	}
+}
+
+func openapiFormat(val any) string {
+	switch v := val.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
+
+func openapiYAMLString(s string) string {
+	switch strings.ToLower(s) {
+	case "", "true", "false", "null", "yes", "no", "on", "off", "y", "n", "~":
+		return strconv.Quote(s)
+	}
+	for i, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '_' ||
+			i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '.')) {
+			return strconv.Quote(s)
+		}
+	}
+	return s
+}
+
+func (node *openapiYAML) write(w io.StringWriter, indent int, inline bool) {
+	prefix := "\n" + strings.Repeat("  ", indent)
+	switch {
+	case node.kind == 0:
+		_, _ = w.WriteString(" " + node.scalar)
+	case len(node.vals) == 0 && node.kind == '{':
+		_, _ = w.WriteString(" {}")
+	case len(node.vals) == 0:
+		_, _ = w.WriteString(" []")
+	default:
+		for i, val := range node.vals {
+			if i > 0 || !inline {
+				_, _ = w.WriteString(prefix)
+			}
+			if node.kind == '[' {
+				_, _ = w.WriteString("-")
+				if val.kind != 0 && len(val.vals) > 0 {
+					_, _ = w.WriteString(" ")
+				}
+				val.write(w, indent+1, true)
+				continue
+			}
+			_, _ = w.WriteString(node.keys[i] + ":")
+			val.write(w, indent+1, false)
+		}
+	}
+}
+
+var (
+	typeOpenAPIError   = reflect.TypeOf(contextMessage{})
+	typeOpenAPIHandler = reflect.TypeOf((*OpenAPIDocument)(nil))
+)
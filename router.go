@@ -92,6 +92,16 @@ type Router interface {
 	DeleteFunc(path string, fn ...any)
 	HeadFunc(path string, fn ...any)
 	PatchFunc(path string, fn ...any)
+
+	// URL method uses the route registered with param 'name' to build a url,
+	// params are key-value pairs, the value is formatted by [fmt.Sprint].
+	//
+	// The param and wildcard values are validated by check function
+	// and escaped,
+	// redundant params are appended as the query string.
+	//
+	// The method signature can be used as template func.
+	URL(name string, params ...any) (string, error)
 }
 
 // RouterCore interface implements registration and matching of routes
@@ -120,6 +130,7 @@ type routerStd struct {
 	LoggerKind      int             `alias:"loggerkind"`
 	MethodAll       []string        `alias:"methodall"`
 	Meta            *MetadataRouter `alias:"meta"`
+	URLs            *routerURLs     `alias:"urls"`
 }
 
 // MetadataRouter records all methods and [HandlerFuncs] of [Router] registration.
@@ -133,6 +144,7 @@ type MetadataRouter struct {
 	Paths        []string   `json:"paths" protobuf:"7,name=paths" yaml:"paths"`
 	Params       []Params   `json:"params" protobuf:"8,name=params" yaml:"params"`
 	HandlerNames [][]string `json:"handlerNames" protobuf:"9,name=handlerNames" yaml:"handlerNames"`
+	handlers     [][]HandlerFunc
 }
 
 // NewRouter method uses a [RouterCore] to create a [Router] object,
@@ -153,13 +165,15 @@ func NewRouter(core RouterCore) Router {
 		LoggerKind:  getRouterLoggerKind(0, DefaultRouterLoggerKind),
 		MethodAll:   append([]string{}, DefaultRouterAllMethod...),
 		Meta:        &MetadataRouter{},
+		URLs:        newRouterURLs(),
 	}
 }
 
 // Mount method causes routerStd to mount the [context.Context].
 //
 // Get [ContextKeyApp] or [ContextKeyLogger] from [context.Context] as [Logger];
-// Get [ContextKeyHandlerExtender] from [context.Context] as [HandlerExtender].
+// Get [ContextKeyHandlerExtender] from [context.Context] as [HandlerExtender];
+// Get [ContextKeyFuncCreator] from [context.Context] to create URL check func.
 func (r *routerStd) Mount(ctx context.Context) {
 	for _, key := range [...]any{ContextKeyApp, ContextKeyLogger} {
 		log, ok := ctx.Value(key).(Logger)
@@ -173,6 +187,10 @@ func (r *routerStd) Mount(ctx context.Context) {
 	if ok {
 		r.HandlerExtender = NewHandlerExtenderWrap(NewHandlerExtenderTree(), he)
 	}
+	fc, ok := ctx.Value(ContextKeyFuncCreator).(FuncCreator)
+	if ok {
+		r.URLs.funcCreator = fc
+	}
 	anyMount(ctx, r.RouterCore)
 }
 
@@ -198,6 +216,7 @@ func (r *routerStd) Metadata() any {
 		Paths:        r.Meta.Paths,
 		Params:       r.Meta.Params,
 		HandlerNames: r.Meta.HandlerNames,
+		handlers:     r.Meta.handlers,
 	}
 }
 
@@ -207,6 +226,8 @@ func (r *routerStd) Group(path string) Router {
 	if kind != "" {
 		params.Del(ParamLoggerKind)
 	}
+	// route name is not inherited.
+	params.Del(ParamRouteName)
 
 	// Copy the data and build a new router
 	return &routerStd{
@@ -220,6 +241,7 @@ func (r *routerStd) Group(path string) Router {
 		MethodAll:   r.MethodAll,
 		GroupParams: combineParams(r.GroupParams.Clone(), params),
 		Meta:        r.Meta,
+		URLs:        r.URLs,
 	}
 }
 
@@ -288,6 +310,16 @@ func (r *routerStd) addHandler(method, path string, handler ...any) (err error)
 		hs = NewHandlerFuncsCombine(r.Middlewares.Lookup(path), hs)
 	}
 
+	// the rejected route name does not register the handler.
+	name := params.Get(ParamRouteName)
+	if name != "" && hs != nil {
+		err = r.URLs.insert(name, path)
+		if err != nil {
+			r.getLoggerError(err, depth).Error(err)
+			return err
+		}
+	}
+
 	// Handle multiple methods
 	var errs mulitError
 	for _, m := range strings.Split(method, ",") {
@@ -306,9 +338,15 @@ func (r *routerStd) addHandler(method, path string, handler ...any) (err error)
 	if errs.errs != nil {
 		return &errs
 	}
+
 	return nil
 }
 
+// URL method builds the url of the route name.
+func (r *routerStd) URL(name string, params ...any) (string, error) {
+	return r.URLs.build(name, params)
+}
+
 func checkMethod(all []string, method string) bool {
 	switch method {
 	case MethodAny, MethodNotFound, MethodNotAllowed:
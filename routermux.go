@@ -18,7 +18,24 @@ type routerCoreMux struct {
 	Params405   Params
 	Handler404  []HandlerFunc
 	Handler405  []HandlerFunc
+	Conflicts   []string
 	funcCreator FuncCreator
+	logger      Logger
+	// the registered routes grouped by the shape of route.
+	routes map[string][]routerMuxRoute
+}
+
+type routerMuxRoute struct {
+	method string
+	route  string
+	paths  []string
+}
+
+// MetadataRouterCoreMux records the route conflicts of [NewRouterCoreMux].
+type MetadataRouterCoreMux struct {
+	Health    bool     `json:"health" protobuf:"1,name=health" yaml:"health"`
+	Name      string   `json:"name" protobuf:"2,name=name" yaml:"name"`
+	Conflicts []string `json:"conflicts,omitempty" protobuf:"3,name=conflicts" yaml:"conflicts,omitempty"`
 }
 
 type nodeMux struct {
@@ -31,6 +48,8 @@ type nodeMux struct {
 	childwv []*nodeMux
 	childw  *nodeMux
 	check   func(string) bool
+	// the param is followed by a constant in the same segment.
+	mixed bool
 	// handlers
 	handlers   []nodeMuxHandler
 	anyHandler []HandlerFunc
@@ -55,6 +74,8 @@ func NewRouterCoreMux() RouterCore {
 		Handler404:  []HandlerFunc{HandlerRouter404},
 		Handler405:  []HandlerFunc{HandlerRouter405},
 		funcCreator: DefaultFuncCreator,
+		logger:      DefaultLoggerNull,
+		routes:      make(map[string][]routerMuxRoute),
 	}
 }
 
@@ -63,11 +84,34 @@ func NewRouterCoreMux() RouterCore {
 //
 // You can make the validation constructor implement the CreateFunc method
 // of [FuncCreator].
+//
+// Get [ContextKeyApp] or [ContextKeyLogger] from [context.Context] as
+// [Logger] to output route conflicts.
 func (mux *routerCoreMux) Mount(ctx context.Context) {
 	fc, ok := ctx.Value(ContextKeyFuncCreator).(FuncCreator)
 	if ok {
 		mux.funcCreator = fc
 	}
+	for _, key := range [...]any{ContextKeyApp, ContextKeyLogger} {
+		log, ok := ctx.Value(key).(Logger)
+		if ok {
+			mux.logger = log
+			break
+		}
+	}
+}
+
+// Unmount method causes routerCoreMux to unload the [context.Context].
+func (mux *routerCoreMux) Unmount(context.Context) {
+	mux.logger = DefaultLoggerNull
+}
+
+func (mux *routerCoreMux) Metadata() any {
+	return MetadataRouterCoreMux{
+		Health:    true,
+		Name:      "eudore.routerCoreMux",
+		Conflicts: mux.Conflicts,
+	}
 }
 
 // HandleFunc method register a new route to the router
@@ -148,11 +192,15 @@ func (mux *routerCoreMux) insertRoute(method, path string, val []HandlerFunc) {
 	params := NewParamsRoute(path)
 	paths := getSplitPath(params.Get(ParamRoute))
 	// create a node
-	for _, route := range paths {
+	for i, route := range paths {
 		next := &nodeMux{path: route}
 		switch route[0] {
 		case ':', '*':
 			next.name, next.check = mux.loadCheck(route)
+		default:
+			if i > 0 && route[0] != '/' && paths[i-1][0] == ':' {
+				node.mixed = true
+			}
 		}
 		node = node.insertNode(next)
 		if route[0] == '*' {
@@ -160,19 +208,116 @@ func (mux *routerCoreMux) insertRoute(method, path string, val []HandlerFunc) {
 		}
 	}
 	node.route = strings.Join(paths, "")
+	mux.checkConflict(method, node.route, paths)
 	node.setHandler(method, params[2:], val)
 }
 
+// The checkConflict method compares the new route with the registered routes
+// of the same shape, outputs the overwritten, shadowed, overlapped and
+// ambiguous routes.
+//
+// The shape replaces each param with its kind,
+// so only the routes that may match the same path are compared.
+func (mux *routerCoreMux) checkConflict(method, route string, paths []string) {
+	shape := getRouterMuxShape(paths)
+	for _, r := range mux.routes[shape] {
+		if r.method != method && r.method != MethodAny && method != MethodAny {
+			continue
+		}
+
+		format := getRouterMuxConflict(paths, r.paths)
+		if format == ErrRouterMuxConflictOverwrite && r.method == method {
+			msg := fmt.Sprintf(format, method, route, r.method, r.route)
+			mux.Conflicts = append(mux.Conflicts, msg)
+			mux.logger.Warning(msg)
+			return
+		}
+		if format != "" && format != ErrRouterMuxConflictOverwrite {
+			msg := fmt.Sprintf(format, method, route, r.method, r.route)
+			mux.Conflicts = append(mux.Conflicts, msg)
+			mux.logger.Warning(msg)
+		}
+	}
+	mux.routes[shape] = append(mux.routes[shape],
+		routerMuxRoute{method, route, paths},
+	)
+}
+
+func getRouterMuxShape(paths []string) string {
+	var b strings.Builder
+	for _, path := range paths {
+		switch path[0] {
+		case ':', '*':
+			b.WriteByte(0)
+			b.WriteByte(path[0])
+		default:
+			b.WriteString(path)
+		}
+	}
+	return b.String()
+}
+
+// The getRouterMuxConflict function compares the params of two routes with
+// the same shape.
+//
+// If the params have the same check, the routes are the same or shadowed.
+// A param check that only one route has makes the routes overlap,
+// the route without check matches the rest of values.
+// If each route has such a check, or the params have different checks,
+// the routes are ambiguous.
+func getRouterMuxConflict(p1, p2 []string) string {
+	var names, check1, check2, checks bool
+	for i := range p1 {
+		if p1[i] == p2[i] {
+			continue
+		}
+		_, c1, _ := strings.Cut(p1[i], "|")
+		_, c2, _ := strings.Cut(p2[i], "|")
+		switch {
+		case c1 == c2:
+			names = true
+		case c2 == "":
+			check1 = true
+		case c1 == "":
+			check2 = true
+		default:
+			checks = true
+		}
+	}
+
+	switch {
+	case checks || check1 && check2:
+		return ErrRouterMuxConflictAmbiguous
+	case check1 || check2:
+		return ErrRouterMuxConflictOverlap
+	case names:
+		return ErrRouterMuxConflictShadowed
+	default:
+		return ErrRouterMuxConflictOverwrite
+	}
+}
+
 // Load the checksum function by name.
 func (mux *routerCoreMux) loadCheck(path string) (string, func(string) bool) {
+	name, fn, err := loadRouterCheck(mux.funcCreator, path)
+	if err != nil {
+		panic(err)
+	}
+	return name, fn
+}
+
+// The loadRouterCheck function uses [FuncCreator] to create the check function
+// of the param or wildcard path.
+func loadRouterCheck(fc FuncCreator, path string,
+) (string, func(string) bool, error) {
 	if len(path) == 1 {
-		return path, nil
+		return path, nil, nil
 	}
 	path = path[1:]
 	// Cutting verification function name and parameter
 	name, fname, _ := strings.Cut(path, "|")
 	if name == "" || fname == "" {
-		return path, nil
+		return path, nil, nil
 	}
 	// If the prefix is '^', add the regular check function name
 	if fname[0] == '^' && fname[len(fname)-1] == '$' {
@@ -180,11 +325,11 @@ func (mux *routerCoreMux) loadCheck(path string) (string, func(string) bool) {
 	}
 
 	// use [FuncCreator] to create a check function
-	fn, err := mux.funcCreator.CreateFunc(FuncCreateString, fname)
+	fn, err := fc.CreateFunc(FuncCreateString, fname)
 	if err != nil {
-		panic(fmt.Errorf(ErrRouterMuxLoadInvalidFunc, path, err))
+		return "", nil, fmt.Errorf(ErrRouterMuxLoadInvalidFunc, path, err)
 	}
-	return name, fn.(func(string) bool)
+	return name, fn.(func(string) bool), nil
 }
 
 func (node *nodeMux) setHandler(method string, p Params, hs []HandlerFunc) {
@@ -306,6 +451,11 @@ func (node *nodeMux) lookNode(path string, params *Params) *nodeMux {
 
 			// check parameter matching
 			for _, child := range node.childpv {
+				if child.mixed {
+					if n := child.lookMixed(path, pos, params); n != nil {
+						return n
+					}
+				}
 				if child.check(current) {
 					if n := child.lookNode(next, params); n != nil {
 						*params = params.Add(child.name, current)
@@ -314,6 +464,11 @@ func (node *nodeMux) lookNode(path string, params *Params) *nodeMux {
 				}
 			}
 			for _, child := range node.childp {
+				if child.mixed {
+					if n := child.lookMixed(path, pos, params); n != nil {
+						return n
+					}
+				}
 				if n := child.lookNode(next, params); n != nil {
 					*params = params.Add(child.name, current)
 					return n
@@ -341,6 +496,31 @@ func (node *nodeMux) lookNode(path string, params *Params) *nodeMux {
 	return nil
 }
 
+// The lookMixed method matches the param followed by a constant in the same
+// segment, the longest param value is matched first.
+func (node *nodeMux) lookMixed(path string, pos int, params *Params) *nodeMux {
+	for i := pos - 1; i > 0; i-- {
+		if !node.hasConstPrefix(path[i]) ||
+			(node.check != nil && !node.check(path[:i])) {
+			continue
+		}
+		if n := node.lookNode(path[i:], params); n != nil {
+			*params = params.Add(node.name, path[:i])
+			return n
+		}
+	}
+	return nil
+}
+
+func (node *nodeMux) hasConstPrefix(b byte) bool {
+	for _, child := range node.childc {
+		if child.path[0] == b {
+			return true
+		}
+	}
+	return false
+}
+
 /*
 The string is cut according to the Node type, String path cutting example:
 
@@ -357,17 +537,26 @@ The string is cut according to the Node type, String path cutting example:
 	/api/:name|^\\d+$/info	[/api/ :name|^\d+$ /info]
 	/api/*|{^0/api\\S+$}	[/api/ *|^0/api\S+$]
 	/api/*|^\\$\\d+$		[/api/ *|^\$\d+$]
+	/api/:user-id		[/api/ :user-id]
+	/api/:{name}.:ext	[/api/ :name . :ext]
+	/api/:id|{num}.json	[/api/ :id|num .json]
+
+The param ends with '/',
+the param name or check that ends with block can be followed by a constant
+in the same segment.
 */
 func getSplitPath(path string) []string {
 	var strs []string
 	bytes := make([]byte, 0, 64)
-	var isblock, isconst bool
+	var isblock, isconst, ischeck, isend bool
 	for _, b := range path {
 		// block pattern
 		if isblock {
 			if b == '}' {
 				if len(bytes) != 0 && bytes[len(bytes)-1] != '\\' {
 					isblock = false
+					// block ends the param
+					isend = !isconst && bytes[0] == ':'
 					continue
 				}
 				// escaping }
@@ -380,18 +569,30 @@ func getSplitPath(path string) []string {
 		case '/':
 			// constant mode, creates a new string in non-constant mode
 			if !isconst {
-				isconst = true
+				isconst, isend = true, false
 				strs = append(strs, string(bytes))
 				bytes = bytes[:0]
 			}
 		case ':', '*':
 			// variable pattern or wildcard pattern
-			isconst = false
+			isconst, ischeck, isend = false, false, false
 			strs = append(strs, string(bytes))
 			bytes = bytes[:0]
 		case '{':
 			isblock = true
 			continue
+		default:
+			// mixed param and constant in a segment,
+			// the param name block can be followed by check.
+			if isend && (ischeck || b != '|') {
+				isconst = true
+				strs = append(strs, string(bytes))
+				bytes = bytes[:0]
+			}
+			isend = false
+			if b == '|' && !isconst {
+				ischeck = true
+			}
 		}
 		bytes = append(bytes, string(b)...)
 	}
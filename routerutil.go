@@ -3,6 +3,7 @@ package eudore
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"runtime"
 	"sort"
 	"strings"
@@ -70,6 +71,7 @@ func addMetadataRouter(r *MetadataRouter, method, path string,
 	r.Paths = append(r.Paths, getRoutePath(path))
 	r.Params = append(r.Params, NewParamsRoute(path))
 	r.HandlerNames = append(r.HandlerNames, names)
+	r.handlers = append(r.handlers, handlers)
 }
 
 // middlewareTree defines the middleware storage tree.
@@ -301,3 +303,120 @@ func indexBytes(path string) int {
 	}
 	return pos
 }
+
+// routerURLs saves the route path of the route name.
+type routerURLs struct {
+	funcCreator FuncCreator
+	routes      map[string]routerURL
+}
+
+type routerURL struct {
+	path   string
+	paths  []string
+	names  []string
+	checks []func(string) bool
+}
+
+func newRouterURLs() *routerURLs {
+	return &routerURLs{
+		funcCreator: DefaultFuncCreator,
+		routes:      make(map[string]routerURL),
+	}
+}
+
+// The insert method splits the route path and creates check functions,
+// returns an error if the name is already used by another path.
+func (r *routerURLs) insert(name, path string) error {
+	if route, ok := r.routes[name]; ok {
+		if route.path != path {
+			return fmt.Errorf(ErrRouterURLDuplicate, name, path, route.path)
+		}
+		return nil
+	}
+
+	route := routerURL{path: path, paths: getSplitPath(path)}
+	route.names = make([]string, len(route.paths))
+	route.checks = make([]func(string) bool, len(route.paths))
+	for i, path := range route.paths {
+		switch path[0] {
+		case ':', '*':
+			var err error
+			route.names[i], route.checks[i], err = loadRouterCheck(
+				r.funcCreator, path,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		if path[0] == '*' {
+			route.paths = route.paths[:i+1]
+			break
+		}
+	}
+	r.routes[name] = route
+	return nil
+}
+
+func (r *routerURLs) build(name string, params []any) (string, error) {
+	route, ok := r.routes[name]
+	if !ok {
+		return "", fmt.Errorf(ErrRouterURLNotFound, name)
+	}
+
+	var keys []string
+	vals := make(map[string]string, len(params)/2)
+	for i := 0; i < len(params); i += 2 {
+		key, val := fmt.Sprint(params[i]), ""
+		if i+1 < len(params) {
+			val = fmt.Sprint(params[i+1])
+		}
+		if _, ok := vals[key]; !ok {
+			keys = append(keys, key)
+			vals[key] = val
+		}
+	}
+
+	var b strings.Builder
+	for i, path := range route.paths {
+		key := route.names[i]
+		if key == "" {
+			b.WriteString(path)
+			continue
+		}
+
+		val, ok := vals[key]
+		if !ok && path[0] == ':' {
+			return "", fmt.Errorf(ErrRouterURLParamMissing, name, key)
+		}
+		delete(vals, key)
+		if (path[0] == ':' && (val == "" || strings.Contains(val, "/"))) ||
+			(route.checks[i] != nil && !route.checks[i](val)) {
+			return "", fmt.Errorf(ErrRouterURLParamInvalid, name, key, val)
+		}
+
+		if path[0] == ':' {
+			b.WriteString(url.PathEscape(val))
+			continue
+		}
+		for j, s := range strings.Split(val, "/") {
+			if j > 0 {
+				b.WriteByte('/')
+			}
+			b.WriteString(url.PathEscape(s))
+		}
+	}
+
+	// redundant params are used as query
+	query := make(url.Values)
+	for _, key := range keys {
+		val, ok := vals[key]
+		if ok {
+			query.Add(key, val)
+		}
+	}
+	if len(query) > 0 {
+		b.WriteByte('?')
+		b.WriteString(query.Encode())
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,291 @@
+package eudore
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Define the kind of [TraceSpan].
+const (
+	TraceKindServer   = "server"
+	TraceKindClient   = "client"
+	TraceKindInternal = "internal"
+	// TraceFlagSampled defines the sampled flag of traceparent.
+	TraceFlagSampled byte = 0x01
+)
+
+// TraceSpan defines the span of W3C Trace Context.
+//
+// W3C: Trace Context https://www.w3.org/TR/trace-context/
+//
+// W3C: Propagation format for distributed context: Baggage
+// https://www.w3.org/TR/baggage/
+type TraceSpan struct {
+	TraceID    string            `json:"traceId" protobuf:"1,name=traceId" yaml:"traceId"`
+	SpanID     string            `json:"spanId" protobuf:"2,name=spanId" yaml:"spanId"`
+	ParentID   string            `json:"parentSpanId,omitempty" protobuf:"3,name=parentSpanId" yaml:"parentSpanId,omitempty"`
+	Flags      byte              `json:"flags" protobuf:"4,name=flags" yaml:"flags"`
+	State      string            `json:"traceState,omitempty" protobuf:"5,name=traceState" yaml:"traceState,omitempty"`
+	Baggage    map[string]string `json:"baggage,omitempty" protobuf:"6,name=baggage" yaml:"baggage,omitempty"`
+	Name       string            `json:"name" protobuf:"7,name=name" yaml:"name"`
+	Kind       string            `json:"kind" protobuf:"8,name=kind" yaml:"kind"`
+	StartTime  time.Time         `json:"startTime" protobuf:"9,name=startTime" yaml:"startTime"`
+	EndTime    time.Time         `json:"endTime" protobuf:"10,name=endTime" yaml:"endTime"`
+	Attributes map[string]any    `json:"attributes,omitempty" protobuf:"11,name=attributes" yaml:"attributes,omitempty"`
+	Error      string            `json:"error,omitempty" protobuf:"12,name=error" yaml:"error,omitempty"`
+	exporter   TraceExporter
+	mu         sync.Mutex
+}
+
+// TraceExporter defines the exporter that receives the ended [TraceSpan].
+type TraceExporter interface {
+	ExportSpan(span *TraceSpan)
+}
+
+// TraceExporterMemory defines the [TraceExporter] that saves spans in
+// memory, used for tests.
+type TraceExporterMemory struct {
+	mu    sync.Mutex
+	spans []*TraceSpan
+}
+
+// NewTraceSpan function creates a [TraceSpan], if the ctx has a span, it is
+// the parent span, otherwise start a new trace.
+//
+// The span is exported by exporter when [TraceSpan.End] if it is sampled,
+// the exporter can be nil.
+func NewTraceSpan(ctx context.Context, name, kind string, exporter TraceExporter) *TraceSpan {
+	span := &TraceSpan{
+		SpanID:    newTraceID(8),
+		Flags:     TraceFlagSampled,
+		Name:      name,
+		Kind:      kind,
+		StartTime: time.Now(),
+		exporter:  exporter,
+	}
+	parent := GetTraceSpan(ctx)
+	if parent != nil {
+		span.TraceID = parent.TraceID
+		span.ParentID = parent.SpanID
+		span.Flags = parent.Flags
+		span.State = parent.State
+		span.Baggage = parent.Baggage
+	} else {
+		span.TraceID = newTraceID(16)
+	}
+	return span
+}
+
+// NewTraceSpanWithHeader function creates a [TraceSpan] using
+// [HeaderTraceparent] [HeaderTracestate] [HeaderBaggage] as the remote
+// parent, if traceparent is invalid, start a new trace.
+func NewTraceSpanWithHeader(h http.Header, name, kind string, exporter TraceExporter) *TraceSpan {
+	span := NewTraceSpan(context.Background(), name, kind, exporter)
+	traceid, spanid, flags, ok := parseTraceparent(h.Get(HeaderTraceparent))
+	if ok {
+		span.TraceID = traceid
+		span.ParentID = spanid
+		span.Flags = flags
+		span.State = strings.Join(h.Values(HeaderTracestate), ",")
+	}
+	span.Baggage = parseTraceBaggage(h.Values(HeaderBaggage))
+	return span
+}
+
+// GetTraceSpan function returns the [TraceSpan] saved in
+// [ContextKeyTraceSpan] of ctx.
+func GetTraceSpan(ctx context.Context) *TraceSpan {
+	span, _ := ctx.Value(ContextKeyTraceSpan).(*TraceSpan)
+	return span
+}
+
+// The WithContext method returns the ctx that saves the span.
+func (span *TraceSpan) WithContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ContextKeyTraceSpan, span)
+}
+
+// The Traceparent method returns the traceparent header value.
+func (span *TraceSpan) Traceparent() string {
+	return "00-" + span.TraceID + "-" + span.SpanID + "-" +
+		hex.EncodeToString([]byte{span.Flags})
+}
+
+// The Inject method sets the span to [HeaderTraceparent] [HeaderTracestate]
+// [HeaderBaggage].
+func (span *TraceSpan) Inject(h http.Header) {
+	h.Set(HeaderTraceparent, span.Traceparent())
+	if span.State != "" {
+		h.Set(HeaderTracestate, span.State)
+	} else {
+		h.Del(HeaderTracestate)
+	}
+	if len(span.Baggage) > 0 {
+		keys := make([]string, 0, len(span.Baggage))
+		for key := range span.Baggage {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for i, key := range keys {
+			keys[i] = key + "=" + url.PathEscape(span.Baggage[key])
+		}
+		h.Set(HeaderBaggage, strings.Join(keys, ","))
+	}
+}
+
+// The SetAttribute method sets the span attribute.
+func (span *TraceSpan) SetAttribute(key string, val any) {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+	if span.Attributes == nil {
+		span.Attributes = make(map[string]any)
+	}
+	span.Attributes[key] = val
+}
+
+// The SetError method sets the span error.
+func (span *TraceSpan) SetError(err error) {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+	span.Error = err.Error()
+}
+
+// The End method sets the end time and exports the sampled span.
+func (span *TraceSpan) End() {
+	span.EndTime = time.Now()
+	if span.exporter != nil && span.Flags&TraceFlagSampled != 0 {
+		span.exporter.ExportSpan(span)
+	}
+}
+
+// NewTraceExporterMemory function creates [TraceExporterMemory].
+func NewTraceExporterMemory() *TraceExporterMemory {
+	return &TraceExporterMemory{}
+}
+
+func (exp *TraceExporterMemory) ExportSpan(span *TraceSpan) {
+	exp.mu.Lock()
+	defer exp.mu.Unlock()
+	exp.spans = append(exp.spans, span)
+}
+
+// The Spans method returns the exported spans.
+func (exp *TraceExporterMemory) Spans() []*TraceSpan {
+	exp.mu.Lock()
+	defer exp.mu.Unlock()
+	return append([]*TraceSpan(nil), exp.spans...)
+}
+
+// The Reset method removes the exported spans.
+func (exp *TraceExporterMemory) Reset() {
+	exp.mu.Lock()
+	defer exp.mu.Unlock()
+	exp.spans = nil
+}
+
+type clientHookTrace struct {
+	next     http.RoundTripper
+	exporter TraceExporter
+}
+
+// NewClientHookTrace function creates [ClientHook] to start a client
+// [TraceSpan] for each request, the parent span is the span of the request
+// context, and inject the span into the request header.
+func NewClientHookTrace(exporter TraceExporter) ClientHook {
+	return &clientHookTrace{exporter: exporter}
+}
+
+func (*clientHookTrace) Name() string { return "trace" }
+func (hook *clientHookTrace) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &clientHookTrace{
+		next:     rt,
+		exporter: hook.exporter,
+	}
+}
+
+func (hook *clientHookTrace) RoundTrip(req *http.Request) (*http.Response, error) {
+	span := NewTraceSpan(req.Context(), req.Method, TraceKindClient, hook.exporter)
+	span.SetAttribute("http.request.method", req.Method)
+	span.SetAttribute("url.full", stripPassword(req.URL))
+	span.SetAttribute("server.address", GetAnyDefault(req.Host, req.URL.Host))
+	defer span.End()
+
+	// the caller's request must not be modified.
+	r := new(http.Request)
+	*r = *req
+	r.Header = req.Header.Clone()
+	if r.Header == nil {
+		r.Header = make(http.Header)
+	}
+	span.Inject(r.Header)
+
+	resp, err := hook.next.RoundTrip(r)
+	if err != nil {
+		span.SetError(err)
+		return resp, err
+	}
+	span.SetAttribute("http.response.status_code", resp.StatusCode)
+	if resp.StatusCode >= StatusBadRequest {
+		span.SetError(errors.New(resp.Status))
+	}
+	return resp, nil
+}
+
+// The parseTraceparent function parses version-traceid-parentid-flags,
+// the future version may have more fields.
+func parseTraceparent(val string) (string, string, byte, bool) {
+	if len(val) < 55 || (len(val) > 55 && val[55] != '-') || val[2] != '-' ||
+		val[35] != '-' || val[52] != '-' || !isLowerHex(val[:2]) ||
+		val[:2] == "ff" || (val[:2] == "00" && len(val) != 55) {
+		return "", "", 0, false
+	}
+	traceid, spanid := val[3:35], val[36:52]
+	flags, err := strconv.ParseUint(val[53:55], 16, 8)
+	if err != nil || !isLowerHex(traceid) || !isLowerHex(spanid) ||
+		strings.Trim(traceid, "0") == "" || strings.Trim(spanid, "0") == "" {
+		return "", "", 0, false
+	}
+	return traceid, spanid, byte(flags), true
+}
+
+// The parseTraceBaggage function parses the list-members, the properties
+// are ignored.
+func parseTraceBaggage(vals []string) map[string]string {
+	var baggage map[string]string
+	for _, val := range vals {
+		for _, member := range strings.Split(val, ",") {
+			member, _, _ = strings.Cut(member, ";")
+			key, val, ok := strings.Cut(member, "=")
+			key = strings.TrimSpace(key)
+			val, err := url.PathUnescape(strings.TrimSpace(val))
+			if !ok || key == "" || err != nil {
+				continue
+			}
+			if baggage == nil {
+				baggage = make(map[string]string)
+			}
+			baggage[key] = val
+		}
+	}
+	return baggage
+}
+
+func newTraceID(n int) string {
+	id := make([]byte, n)
+	for {
+		_, _ = rand.Read(id)
+		for _, b := range id {
+			if b != 0 {
+				return hex.EncodeToString(id)
+			}
+		}
+	}
+}
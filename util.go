@@ -509,6 +509,18 @@ func sliceLastIndex[T comparable](vals []T, val T) int {
 	return -1
 }
 
+func sliceEqual[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func sliceFilter[T any](s []T, fn func(T) bool) []T {
 	size := 0
 	b := make([]bool, len(s))
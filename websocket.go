@@ -0,0 +1,805 @@
+package eudore
+
+// websocket defines the RFC 6455 websocket server implementation.
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// WebsocketConfig defines the configuration used by [NewWebsocketConn].
+type WebsocketConfig struct {
+	// Subprotocols specifies the server's supported protocols in order of
+	// preference, select the first protocol that the client requests.
+	Subprotocols []string `alias:"subprotocols" json:"subprotocols" yaml:"subprotocols"`
+
+	// CheckOrigin returns true if the request Origin header is acceptable.
+	// If CheckOrigin is nil, the Origin host must be equal to the
+	// request Host.
+	CheckOrigin func(r *http.Request) bool `alias:"checkOrigin" json:"-" yaml:"-"`
+
+	// ReadLimit is the maximum size in bytes of a message read from the
+	// peer, include decompressed data.
+	// If zero, DefaultWebsocketReadLimit is used.
+	ReadLimit int64 `alias:"readLimit" json:"readLimit" yaml:"readLimit"`
+
+	// ReadTimeout is the maximum duration to wait for the next frame,
+	// receiving any frame resets it, usually used with PingInterval.
+	// A zero value means there will be no timeout.
+	ReadTimeout TimeDuration `alias:"readTimeout" json:"readTimeout" yaml:"readTimeout"`
+
+	// WriteTimeout is the maximum duration for writing a message.
+	// A zero value means there will be no timeout.
+	WriteTimeout TimeDuration `alias:"writeTimeout" json:"writeTimeout" yaml:"writeTimeout"`
+
+	// PingInterval is the interval for sending Ping frames.
+	// A zero value means the Ping frame is not sent automatically.
+	PingInterval TimeDuration `alias:"pingInterval" json:"pingInterval" yaml:"pingInterval"`
+
+	// FrameSize is the maximum payload length of the written frame,
+	// longer message will be written as fragmented frames.
+	// If zero, DefaultWebsocketFrameSize is used.
+	FrameSize int `alias:"frameSize" json:"frameSize" yaml:"frameSize"`
+
+	// Compression specifies whether to negotiate the permessage-deflate
+	// extension (RFC 7692) with the client.
+	Compression bool `alias:"compression" json:"compression" yaml:"compression"`
+
+	// CompressionLevel is the [flate] compression level.
+	// If zero or invalid, flate.DefaultCompression is used,
+	// disable Compression to send uncompressed messages.
+	CompressionLevel int `alias:"compressionLevel" json:"compressionLevel" yaml:"compressionLevel"`
+
+	// CompressionThreshold is the minimum length of the message to compress.
+	CompressionThreshold int `alias:"compressionThreshold" json:"compressionThreshold" yaml:"compressionThreshold"`
+}
+
+// WebsocketConn defines the websocket connection on the server side.
+//
+// The connection is bound to [Context.Context],
+// it is closed when the context is canceled or the [http.Server] is
+// shut down, the connection should be used before the [HandlerFunc] returns.
+//
+// ReadMessage supports one concurrent reader,
+// and the write methods support concurrent calls.
+type WebsocketConn struct {
+	conn        net.Conn
+	reader      *bufio.Reader
+	context     context.Context
+	cancel      context.CancelFunc
+	logger      Logger
+	config      WebsocketConfig
+	group       *websocketGroup
+	subprotocol string
+	compress    bool
+	mutex       sync.Mutex
+	closed      bool
+	closeErr    error
+}
+
+// WebsocketCloseError defines the error returned when the websocket is
+// closed, and Code is the websocket close code.
+type WebsocketCloseError struct {
+	Code int
+	Text string
+}
+
+type websocketGroup struct {
+	sync.Mutex
+	conns map[*WebsocketConn]struct{}
+}
+
+type websocketFrame struct {
+	fin     bool
+	rsv1    bool
+	opcode  int
+	payload []byte
+}
+
+const (
+	websocketGUID         = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	websocketDeflateTail  = "\x00\x00\xff\xff\x01\x00\x00\xff\xff"
+	websocketDeflateParam = "permessage-deflate; server_no_context_takeover; client_no_context_takeover"
+	websocketContinuation = 0
+)
+
+var (
+	websocketGroups        sync.Map
+	websocketFlateReaders  sync.Pool
+	websocketFlateWriters  [12]sync.Pool
+	websocketErrorContinue = &WebsocketCloseError{
+		WebsocketCloseProtocolError, "unexpected continuation frame",
+	}
+	websocketErrorInterrupt = &WebsocketCloseError{
+		WebsocketCloseProtocolError, "expected continuation frame",
+	}
+	websocketErrorTooBig = &WebsocketCloseError{
+		WebsocketCloseMessageTooBig, "message too big",
+	}
+	websocketErrorUTF8 = &WebsocketCloseError{
+		WebsocketCloseInvalidFramePayloadData, "invalid utf8 payload",
+	}
+)
+
+// NewWebsocketConn function upgrades the [Context] to [WebsocketConn] by
+// [ResponseWriter.Hijack].
+//
+// If the handshake fails, return an error with the status code.
+// The response headers set before the upgrade are written to the
+// handshake response.
+//
+// If config is nil, use the default configuration.
+func NewWebsocketConn(ctx Context, config *WebsocketConfig) (*WebsocketConn, error) {
+	if config == nil {
+		config = &WebsocketConfig{}
+	}
+	r := ctx.Request()
+	key, err := checkWebsocketRequest(r, config)
+	if err != nil {
+		if errors.Is(err, ErrWebsocketHandshakeVersion) {
+			ctx.SetHeader(HeaderSecWebSocketVersion, "13")
+		}
+		return nil, err
+	}
+
+	h := ctx.Response().Header().Clone()
+	h.Set(HeaderUpgrade, "websocket")
+	h.Set(HeaderConnection, HeaderValueUpgrade)
+	h.Set(HeaderSecWebSocketAccept, getWebsocketAccept(key))
+	h.Del(HeaderSecWebSocketProtocol)
+	h.Del(HeaderSecWebSocketExtensions)
+	subprotocol := getWebsocketSubprotocol(r, config.Subprotocols)
+	if subprotocol != "" {
+		h.Set(HeaderSecWebSocketProtocol, subprotocol)
+	}
+	compress := config.Compression && getWebsocketCompression(r.Header)
+	if compress {
+		h.Set(HeaderSecWebSocketExtensions, websocketDeflateParam)
+	}
+
+	netconn, brw, err := ctx.Response().Hijack()
+	if err != nil {
+		return nil, err
+	}
+	// clear the deadline set by http.Server.
+	_ = netconn.SetDeadline(time.Time{})
+	buf := bytes.NewBufferString("HTTP/1.1 101 Switching Protocols\r\n")
+	_ = h.Write(buf)
+	buf.WriteString("\r\n")
+	_, err = netconn.Write(buf.Bytes())
+	if err != nil {
+		netconn.Close()
+		return nil, err
+	}
+
+	conn := &WebsocketConn{
+		conn:        netconn,
+		reader:      brw.Reader,
+		logger:      NewLoggerWithContext(ctx.Context()),
+		config:      *config,
+		subprotocol: subprotocol,
+		compress:    compress,
+	}
+	if conn.config.ReadLimit == 0 {
+		conn.config.ReadLimit = DefaultWebsocketReadLimit
+	}
+	if conn.config.FrameSize <= 0 {
+		conn.config.FrameSize = DefaultWebsocketFrameSize
+	}
+	if conn.config.CompressionLevel == flate.NoCompression ||
+		conn.config.CompressionLevel < flate.HuffmanOnly ||
+		conn.config.CompressionLevel > flate.BestCompression {
+		conn.config.CompressionLevel = flate.DefaultCompression
+	}
+	conn.context, conn.cancel = context.WithCancel(ctx.Context())
+	conn.group = getWebsocketGroup(r)
+	if conn.group != nil {
+		conn.group.Add(conn)
+	}
+	go conn.keepalive()
+	return conn, nil
+}
+
+func checkWebsocketRequest(r *http.Request, config *WebsocketConfig) (string, error) {
+	switch {
+	case r.Method != MethodGet:
+		return "", NewErrorWithStatus(
+			fmt.Errorf(ErrWebsocketHandshakeInvalid, "method"),
+			StatusMethodNotAllowed,
+		)
+	case !headerContainsToken(r.Header, HeaderConnection, "upgrade"):
+		return "", NewErrorWithStatus(
+			fmt.Errorf(ErrWebsocketHandshakeInvalid, HeaderConnection),
+			StatusBadRequest,
+		)
+	case !headerContainsToken(r.Header, HeaderUpgrade, "websocket"):
+		return "", NewErrorWithStatus(
+			fmt.Errorf(ErrWebsocketHandshakeInvalid, HeaderUpgrade),
+			StatusBadRequest,
+		)
+	case r.Header.Get(HeaderSecWebSocketVersion) != "13":
+		return "", NewErrorWithStatus(
+			ErrWebsocketHandshakeVersion, StatusUpgradeRequired,
+		)
+	}
+
+	key := r.Header.Get(HeaderSecWebSocketKey)
+	nonce, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(nonce) != 16 {
+		return "", NewErrorWithStatus(
+			fmt.Errorf(ErrWebsocketHandshakeInvalid, HeaderSecWebSocketKey),
+			StatusBadRequest,
+		)
+	}
+
+	check := config.CheckOrigin
+	if check == nil {
+		check = checkWebsocketOrigin
+	}
+	if !check(r) {
+		return "", NewErrorWithStatus(
+			ErrWebsocketHandshakeOrigin, StatusForbidden,
+		)
+	}
+	return key, nil
+}
+
+func checkWebsocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get(HeaderOrigin)
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+func headerContainsToken(h http.Header, key, token string) bool {
+	for _, val := range h.Values(key) {
+		for _, v := range strings.Split(val, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getWebsocketAccept(key string) string {
+	h := sha1.New() //nolint:gosec
+	h.Write([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func getWebsocketSubprotocol(r *http.Request, protocols []string) string {
+	for _, val := range r.Header.Values(HeaderSecWebSocketProtocol) {
+		for _, v := range strings.Split(val, ",") {
+			v = strings.TrimSpace(v)
+			for _, protocol := range protocols {
+				if v == protocol {
+					return v
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// getWebsocketCompression function checks whether the client offers
+// permessage-deflate parameters accepted by the server.
+//
+// [flate] uses a fixed 32K window, the offer of server_max_window_bits
+// less than 15 is declined.
+func getWebsocketCompression(h http.Header) bool {
+	for _, val := range h.Values(HeaderSecWebSocketExtensions) {
+	offer:
+		for _, ext := range strings.Split(val, ",") {
+			params := strings.Split(ext, ";")
+			if strings.TrimSpace(params[0]) != "permessage-deflate" {
+				continue
+			}
+			for _, param := range params[1:] {
+				k, v, _ := strings.Cut(strings.TrimSpace(param), "=")
+				switch strings.TrimSpace(k) {
+				case "server_no_context_takeover", "client_no_context_takeover",
+					"client_max_window_bits":
+				case "server_max_window_bits":
+					if strings.Trim(strings.TrimSpace(v), `"`) != "15" {
+						continue offer
+					}
+				default:
+					continue offer
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func getWebsocketGroup(r *http.Request) *websocketGroup {
+	srv, ok := r.Context().Value(http.ServerContextKey).(*http.Server)
+	if !ok {
+		return nil
+	}
+	val, loaded := websocketGroups.LoadOrStore(srv, &websocketGroup{
+		conns: make(map[*WebsocketConn]struct{}),
+	})
+	group := val.(*websocketGroup)
+	if !loaded {
+		srv.RegisterOnShutdown(func() {
+			websocketGroups.Delete(srv)
+			group.Shutdown()
+		})
+	}
+	return group
+}
+
+func (group *websocketGroup) Add(conn *WebsocketConn) {
+	group.Lock()
+	defer group.Unlock()
+	group.conns[conn] = struct{}{}
+}
+
+func (group *websocketGroup) Remove(conn *WebsocketConn) {
+	group.Lock()
+	defer group.Unlock()
+	delete(group.conns, conn)
+}
+
+// The Shutdown method closes all connections when [http.Server] shutdown.
+func (group *websocketGroup) Shutdown() {
+	group.Lock()
+	conns := make([]*WebsocketConn, 0, len(group.conns))
+	for conn := range group.conns {
+		conns = append(conns, conn)
+	}
+	group.Unlock()
+	for _, conn := range conns {
+		_ = conn.CloseWithCode(WebsocketCloseGoingAway, "server shutdown")
+	}
+}
+
+// The Context method returns the [context.Context] of the connection,
+// which is canceled when the connection is closed.
+func (conn *WebsocketConn) Context() context.Context {
+	return conn.context
+}
+
+// The Subprotocol method returns the negotiated protocol.
+func (conn *WebsocketConn) Subprotocol() string {
+	return conn.subprotocol
+}
+
+// The RemoteAddr method returns the remote network address.
+func (conn *WebsocketConn) RemoteAddr() net.Addr {
+	return conn.conn.RemoteAddr()
+}
+
+// The ReadMessage method reads a complete message and returns the message
+// type [WebsocketMessageText] or [WebsocketMessageBinary].
+//
+// Fragmented and compressed messages are merged,
+// Ping frame is automatically replied,
+// and Close frame returns [WebsocketCloseError].
+func (conn *WebsocketConn) ReadMessage() (int, []byte, error) {
+	var messageType int
+	var compressed bool
+	var data []byte
+	for {
+		if conn.config.ReadTimeout > 0 {
+			_ = conn.conn.SetReadDeadline(time.Now().Add(
+				time.Duration(conn.config.ReadTimeout),
+			))
+		}
+		frame, err := conn.readFrame(conn.config.ReadLimit - int64(len(data)))
+		if err != nil {
+			return 0, nil, conn.readError(err)
+		}
+
+		switch frame.opcode {
+		case WebsocketMessagePing:
+			err = conn.writeControl(WebsocketMessagePong, frame.payload)
+			if err != nil {
+				return 0, nil, err
+			}
+			continue
+		case WebsocketMessagePong:
+			continue
+		case WebsocketMessageClose:
+			return 0, nil, conn.readClose(frame.payload)
+		case websocketContinuation:
+			if messageType == 0 || frame.rsv1 {
+				return 0, nil, conn.readError(websocketErrorContinue)
+			}
+		default:
+			if messageType != 0 {
+				return 0, nil, conn.readError(websocketErrorInterrupt)
+			}
+			messageType, compressed = frame.opcode, frame.rsv1
+		}
+
+		if data == nil {
+			data = frame.payload
+		} else {
+			data = append(data, frame.payload...)
+		}
+		if frame.fin {
+			break
+		}
+	}
+
+	if compressed {
+		var err error
+		data, err = websocketDecompress(data, conn.config.ReadLimit)
+		if err != nil {
+			return 0, nil, conn.readError(err)
+		}
+	}
+	if messageType == WebsocketMessageText && !utf8.Valid(data) {
+		return 0, nil, conn.readError(websocketErrorUTF8)
+	}
+	return messageType, data, nil
+}
+
+func (conn *WebsocketConn) readFrame(limit int64) (websocketFrame, error) {
+	var frame websocketFrame
+	head := make([]byte, 8)
+	_, err := io.ReadFull(conn.reader, head[:2])
+	if err != nil {
+		return frame, err
+	}
+
+	frame.fin = head[0]&0x80 != 0
+	frame.rsv1 = head[0]&0x40 != 0
+	frame.opcode = int(head[0] & 0x0f)
+	if head[0]&0x30 != 0 || (frame.rsv1 && !conn.compress) {
+		return frame, &WebsocketCloseError{
+			WebsocketCloseProtocolError, "unexpected reserved bits",
+		}
+	}
+	if head[1]&0x80 == 0 {
+		return frame, &WebsocketCloseError{
+			WebsocketCloseProtocolError, "client frame must be masked",
+		}
+	}
+
+	length := int64(head[1] & 0x7f)
+	switch length {
+	case 126:
+		_, err = io.ReadFull(conn.reader, head[:2])
+		length = int64(binary.BigEndian.Uint16(head))
+	case 127:
+		_, err = io.ReadFull(conn.reader, head)
+		length = int64(binary.BigEndian.Uint64(head))
+	}
+	if err != nil {
+		return frame, err
+	}
+
+	switch frame.opcode {
+	case websocketContinuation, WebsocketMessageText, WebsocketMessageBinary:
+		if length < 0 || length > limit {
+			return frame, websocketErrorTooBig
+		}
+	case WebsocketMessageClose, WebsocketMessagePing, WebsocketMessagePong:
+		if !frame.fin || frame.rsv1 || length > 125 {
+			return frame, &WebsocketCloseError{
+				WebsocketCloseProtocolError, "invalid control frame",
+			}
+		}
+	default:
+		return frame, &WebsocketCloseError{
+			WebsocketCloseProtocolError,
+			fmt.Sprintf("unknown opcode %d", frame.opcode),
+		}
+	}
+
+	mask := head[4:8]
+	_, err = io.ReadFull(conn.reader, mask)
+	if err != nil {
+		return frame, err
+	}
+	frame.payload = make([]byte, length)
+	_, err = io.ReadFull(conn.reader, frame.payload)
+	if err != nil {
+		return frame, err
+	}
+	for i := range frame.payload {
+		frame.payload[i] ^= mask[i&3]
+	}
+	return frame, nil
+}
+
+// The readError method closes the connection,
+// if err is [WebsocketCloseError], send the close code to the peer.
+func (conn *WebsocketConn) readError(err error) error {
+	var closeErr *WebsocketCloseError
+	if errors.As(err, &closeErr) {
+		conn.logger.WithField(FieldError, err).
+			Warningf("websocket read from %s error: %s",
+				conn.conn.RemoteAddr(), err.Error(),
+			)
+		_ = conn.CloseWithCode(closeErr.Code, closeErr.Text)
+		return err
+	}
+
+	conn.mutex.Lock()
+	closed, errClosed := conn.closed, conn.getCloseError()
+	conn.mutex.Unlock()
+	if closed {
+		return errClosed
+	}
+	_ = conn.close(&WebsocketCloseError{WebsocketCloseAbnormalClosure, err.Error()})
+	return err
+}
+
+// The readClose method parses the close frame of the peer and replies.
+func (conn *WebsocketConn) readClose(payload []byte) error {
+	code, text := WebsocketCloseNoStatusReceived, ""
+	if len(payload) > 0 {
+		if len(payload) == 1 {
+			return conn.readError(&WebsocketCloseError{
+				WebsocketCloseProtocolError, "invalid close payload",
+			})
+		}
+		code = int(binary.BigEndian.Uint16(payload))
+		text = string(payload[2:])
+		if !isWebsocketCloseCode(code) {
+			return conn.readError(&WebsocketCloseError{
+				WebsocketCloseProtocolError,
+				fmt.Sprintf("invalid close code %d", code),
+			})
+		}
+		if !utf8.ValidString(text) {
+			return conn.readError(websocketErrorUTF8)
+		}
+	}
+
+	err := &WebsocketCloseError{code, text}
+	_ = conn.CloseWithCode(code, "")
+	return err
+}
+
+func isWebsocketCloseCode(code int) bool {
+	switch code {
+	case WebsocketCloseNoStatusReceived, WebsocketCloseAbnormalClosure,
+		WebsocketCloseTLSHandshake, 1004:
+		return false
+	}
+	return (code >= 1000 && code <= 1014) || (code >= 3000 && code <= 4999)
+}
+
+// The WriteMessage method writes a message of type [WebsocketMessageText]
+// or [WebsocketMessageBinary].
+//
+// If the message length is greater than FrameSize,
+// it will be written as fragmented frames.
+func (conn *WebsocketConn) WriteMessage(messageType int, data []byte) error {
+	if messageType != WebsocketMessageText &&
+		messageType != WebsocketMessageBinary {
+		return fmt.Errorf(ErrWebsocketMessageType, messageType)
+	}
+
+	compressed := conn.compress && len(data) >= conn.config.CompressionThreshold
+	if compressed {
+		data = websocketCompress(data, conn.config.CompressionLevel)
+	}
+
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	if conn.closed {
+		return conn.getCloseError()
+	}
+	// always reset the deadline, it may be set by the control frame.
+	var deadline time.Time
+	if conn.config.WriteTimeout > 0 {
+		deadline = time.Now().Add(time.Duration(conn.config.WriteTimeout))
+	}
+	_ = conn.conn.SetWriteDeadline(deadline)
+
+	opcode := messageType
+	for {
+		size := len(data)
+		if size > conn.config.FrameSize {
+			size = conn.config.FrameSize
+		}
+		fin := size == len(data)
+		err := conn.writeFrame(&websocketFrame{
+			fin, compressed, opcode, data[:size],
+		})
+		if err != nil || fin {
+			return err
+		}
+		data = data[size:]
+		opcode, compressed = websocketContinuation, false
+	}
+}
+
+// The Ping method writes a Ping frame, payload length must not exceed 125.
+func (conn *WebsocketConn) Ping(data []byte) error {
+	return conn.writeControl(WebsocketMessagePing, data)
+}
+
+func (conn *WebsocketConn) writeControl(opcode int, data []byte) error {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	if conn.closed {
+		return conn.getCloseError()
+	}
+	_ = conn.conn.SetWriteDeadline(time.Now().Add(DefaultWebsocketCloseTimeout))
+	err := conn.writeFrame(&websocketFrame{true, false, opcode, data})
+	_ = conn.conn.SetWriteDeadline(time.Time{})
+	return err
+}
+
+// The writeFrame method writes a unmasked server frame, need to hold lock.
+func (conn *WebsocketConn) writeFrame(frame *websocketFrame) error {
+	head := make([]byte, 2, 10)
+	if frame.fin {
+		head[0] |= 0x80
+	}
+	if frame.rsv1 {
+		head[0] |= 0x40
+	}
+	head[0] |= byte(frame.opcode)
+
+	length := len(frame.payload)
+	switch {
+	case length < 126:
+		head[1] = byte(length)
+	case length <= 0xffff:
+		head[1] = 126
+		head = binary.BigEndian.AppendUint16(head, uint16(length))
+	default:
+		head[1] = 127
+		head = binary.BigEndian.AppendUint64(head, uint64(length))
+	}
+
+	buffers := net.Buffers{head, frame.payload}
+	_, err := buffers.WriteTo(conn.conn)
+	return err
+}
+
+// The Close method closes the connection using [WebsocketCloseNormalClosure].
+func (conn *WebsocketConn) Close() error {
+	return conn.CloseWithCode(WebsocketCloseNormalClosure, "")
+}
+
+// The CloseWithCode method writes a Close frame and closes the connection,
+// text length is truncated to 123.
+//
+// If connection has been closed, return nil.
+func (conn *WebsocketConn) CloseWithCode(code int, text string) error {
+	if len(text) > 123 {
+		text = text[:123]
+	}
+	return conn.close(&WebsocketCloseError{code, text})
+}
+
+func (conn *WebsocketConn) close(closeErr *WebsocketCloseError) error {
+	conn.mutex.Lock()
+	if conn.closed {
+		conn.mutex.Unlock()
+		return nil
+	}
+	conn.closed = true
+	conn.closeErr = closeErr
+
+	var err error
+	if closeErr.Code != WebsocketCloseAbnormalClosure {
+		var payload []byte
+		if closeErr.Code != WebsocketCloseNoStatusReceived {
+			payload = binary.BigEndian.AppendUint16(nil, uint16(closeErr.Code))
+			payload = append(payload, closeErr.Text...)
+		}
+		_ = conn.conn.SetWriteDeadline(time.Now().Add(DefaultWebsocketCloseTimeout))
+		err = conn.writeFrame(&websocketFrame{
+			true, false, WebsocketMessageClose, payload,
+		})
+	}
+	conn.mutex.Unlock()
+
+	conn.cancel()
+	if conn.group != nil {
+		conn.group.Remove(conn)
+	}
+	if cerr := conn.conn.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (conn *WebsocketConn) getCloseError() error {
+	if conn.closeErr != nil {
+		return conn.closeErr
+	}
+	return net.ErrClosed
+}
+
+// The keepalive method sends Ping frames and closes the connection when
+// the context is canceled.
+func (conn *WebsocketConn) keepalive() {
+	var ticker <-chan time.Time
+	if conn.config.PingInterval > 0 {
+		t := time.NewTicker(time.Duration(conn.config.PingInterval))
+		defer t.Stop()
+		ticker = t.C
+	}
+	for {
+		select {
+		case <-conn.context.Done():
+			_ = conn.CloseWithCode(WebsocketCloseGoingAway, "")
+			return
+		case <-ticker:
+			_ = conn.Ping(nil)
+		}
+	}
+}
+
+func websocketCompress(data []byte, level int) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)/2+16))
+	pool := &websocketFlateWriters[level-flate.HuffmanOnly]
+	w, ok := pool.Get().(*flate.Writer)
+	if ok {
+		w.Reset(buf)
+	} else {
+		w, _ = flate.NewWriter(buf, level)
+	}
+	_, _ = w.Write(data)
+	_ = w.Flush()
+	pool.Put(w)
+
+	b := buf.Bytes()
+	if bytes.HasSuffix(b, []byte(websocketDeflateTail[:4])) {
+		b = b[:len(b)-4]
+	}
+	return b
+}
+
+func websocketDecompress(data []byte, limit int64) ([]byte, error) {
+	src := io.MultiReader(
+		bytes.NewReader(data),
+		strings.NewReader(websocketDeflateTail),
+	)
+	r, ok := websocketFlateReaders.Get().(io.ReadCloser)
+	if ok {
+		_ = r.(flate.Resetter).Reset(src, nil)
+	} else {
+		r = flate.NewReader(src)
+	}
+	defer websocketFlateReaders.Put(r)
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)*2))
+	n, err := buf.ReadFrom(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, &WebsocketCloseError{
+			WebsocketCloseInvalidFramePayloadData, err.Error(),
+		}
+	}
+	if n > limit {
+		return nil, websocketErrorTooBig
+	}
+	return buf.Bytes(), nil
+}
+
+func (err *WebsocketCloseError) Error() string {
+	if err.Text == "" {
+		return fmt.Sprintf("websocket: close %d", err.Code)
+	}
+	return fmt.Sprintf("websocket: close %d %s", err.Code, err.Text)
+}